@@ -1,12 +1,28 @@
 package alizer
 
 import (
-	"reflect"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
+	"github.com/redhat-developer/alizer/go/pkg/apis/language"
 	"github.com/redhat-developer/alizer/go/pkg/apis/recognizer"
+	"github.com/redhat-developer/alizer/go/pkg/utils/langfiles"
 	"github.com/redhat-developer/odo/pkg/registry"
 )
 
+// Weights used to compute the score of a devfile stack for a language.
+// recognizer.SelectDevFileFromTypes only returns the best devfile for the first language matching a devfile,
+// without its score, so the scoring of alizer (selectDevFileByLanguage) is reproduced with the same weights
+// to rank all the devfile stacks matching the languages detected in the directory and its sub-directories
+const (
+	languageWeight  = 1
+	frameworkWeight = 10
+	toolWeight      = 5
+)
+
 type Alizer struct {
 	registryClient registry.Client
 }
@@ -20,34 +36,114 @@ func NewAlizerClient(registryClient registry.Client) *Alizer {
 // DetectFramework uses the alizer library in order to detect the devfile
 // to use depending on the files in the path
 func (o *Alizer) DetectFramework(path string) (recognizer.DevFileType, registry.Registry, error) {
-	types := []recognizer.DevFileType{}
-	components, err := o.registryClient.ListDevfileStacks("")
+	candidates, err := o.DetectFrameworks(path)
 	if err != nil {
 		return recognizer.DevFileType{}, registry.Registry{}, err
 	}
-	for _, component := range components.Items {
-		types = append(types, recognizer.DevFileType{
-			Name:        component.Name,
-			Language:    component.Language,
-			ProjectType: component.ProjectType,
-			Tags:        component.Tags,
-		})
+	return candidates[0].Type, candidates[0].Registry, nil
+}
+
+// DetectFrameworks uses the alizer library in order to detect all the devfiles
+// that can be used depending on the files in the path and in its sub-directories.
+// The candidates are ranked, the most relevant first:
+// - by the usage of the language in the whole directory,
+// - then by the score of the devfile stack for this language,
+// - then by the order of the devfile stacks in the registries.
+func (o *Alizer) DetectFrameworks(path string) ([]DetectedFramework, error) {
+	stacks, err := o.registryClient.ListDevfileStacks("")
+	if err != nil {
+		return nil, err
 	}
-	typ, err := recognizer.SelectDevFileFromTypes(path, types)
+
+	languages, err := recognizer.Analyze(path)
 	if err != nil {
-		return recognizer.DevFileType{}, registry.Registry{}, err
+		return nil, err
+	}
+
+	components, err := detectSubDirectories(path)
+	if err != nil {
+		return nil, err
+	}
+	// The languages detected for the whole directory are considered as found at the root,
+	// except the languages found only in the sub-directories
+	rootLanguages, err := getRootLanguages(path, languages, components)
+	if err != nil {
+		return nil, err
+	}
+	components = append([]recognizer.Component{{Path: path, Languages: rootLanguages}}, components...)
+
+	type match struct {
+		langRank  int
+		candidate DetectedFramework
+	}
+	matches := map[int]*match{}
+
+	for _, component := range components {
+		dir, err := filepath.Rel(path, component.Path)
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.ToSlash(dir)
+		for _, lang := range component.Languages {
+			langRank := getLanguageRank(languages, lang)
+			for i, stack := range stacks.Items {
+				score := getScore(lang, stack)
+				if score == 0 {
+					continue
+				}
+				m, found := matches[i]
+				if !found {
+					m = &match{
+						langRank: langRank,
+						candidate: DetectedFramework{
+							Type: recognizer.DevFileType{
+								Name:        stack.Name,
+								Language:    stack.Language,
+								ProjectType: stack.ProjectType,
+								Tags:        stack.Tags,
+							},
+							Registry: stack.Registry,
+							Score:    score,
+						},
+					}
+					matches[i] = m
+				} else if langRank < m.langRank || (langRank == m.langRank && score > m.candidate.Score) {
+					m.langRank = langRank
+					m.candidate.Score = score
+				}
+				m.candidate.Languages = appendUnique(m.candidate.Languages, lang.Name)
+				m.candidate.Frameworks = appendUnique(m.candidate.Frameworks, lang.Frameworks...)
+				m.candidate.Paths = appendUnique(m.candidate.Paths, dir)
+			}
+		}
 	}
 
-	// TODO(feloy): This part won't be necessary when SelectDevFileFromTypes returns the index
-	var indexOfDetected int
-	for i, typeFromList := range types {
-		if reflect.DeepEqual(typeFromList, typ) {
-			indexOfDetected = i
-			break
+	if len(matches) == 0 {
+		return nil, errors.New("No valid devfile found for project in " + path)
+	}
+
+	indexes := make([]int, 0, len(matches))
+	for i := range matches {
+		indexes = append(indexes, i)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		mi, mj := matches[indexes[i]], matches[indexes[j]]
+		if mi.langRank != mj.langRank {
+			return mi.langRank < mj.langRank
 		}
+		if mi.candidate.Score != mj.candidate.Score {
+			return mi.candidate.Score > mj.candidate.Score
+		}
+		return indexes[i] < indexes[j]
+	})
+
+	result := make([]DetectedFramework, 0, len(indexes))
+	for _, i := range indexes {
+		candidate := matches[i].candidate
+		sort.Strings(candidate.Paths)
+		result = append(result, candidate)
 	}
-	registry := components.Items[indexOfDetected].Registry
-	return typ, registry, nil
+	return result, nil
 }
 
 func GetDevfileLocationFromDetection(typ recognizer.DevFileType, registry registry.Registry) *DevfileLocation {
@@ -56,3 +152,155 @@ func GetDevfileLocationFromDetection(typ recognizer.DevFileType, registry regist
 		DevfileRegistry: registry.Name,
 	}
 }
+
+// GetDetectionResult returns the result of a detection as displayed to the user.
+// The location of the most relevant candidate is returned at the top level
+func GetDetectionResult(candidates []DetectedFramework) *DetectionResult {
+	if len(candidates) == 0 {
+		return &DetectionResult{}
+	}
+	result := &DetectionResult{
+		DevfileLocation: *GetDevfileLocationFromDetection(candidates[0].Type, candidates[0].Registry),
+		Candidates:      make([]DevfileCandidate, 0, len(candidates)),
+	}
+	for _, candidate := range candidates {
+		result.Candidates = append(result.Candidates, DevfileCandidate{
+			DevfileLocation: *GetDevfileLocationFromDetection(candidate.Type, candidate.Registry),
+			Language:        candidate.Type.Language,
+			ProjectType:     candidate.Type.ProjectType,
+			Languages:       candidate.Languages,
+			Frameworks:      candidate.Frameworks,
+			Paths:           candidate.Paths,
+			Score:           candidate.Score,
+		})
+	}
+	return result
+}
+
+// detectSubDirectories returns the languages detected in each sub-directory of path containing
+// the configuration file of a language (pom.xml, package.json, etc).
+// The language of the configuration file is moved first in the list of languages of the sub-directory
+func detectSubDirectories(path string) ([]recognizer.Component, error) {
+	configurationPerLanguage := langfiles.Get().GetConfigurationPerLanguageMapping()
+	var components []recognizer.Component
+	done := map[string]bool{}
+	err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if file != path && ignoredDirectories[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		dir := filepath.Dir(file)
+		configLanguage, isConfig := configurationPerLanguage[info.Name()]
+		if !isConfig || dir == filepath.Clean(path) || done[dir] {
+			return nil
+		}
+		done[dir] = true
+		languages, err := recognizer.Analyze(dir)
+		if err != nil {
+			return err
+		}
+		for i, lang := range languages {
+			if strings.EqualFold(lang.Name, configLanguage) {
+				languages = append([]language.Language{lang}, append(languages[:i:i], languages[i+1:]...)...)
+				break
+			}
+		}
+		components = append(components, recognizer.Component{
+			Path:      dir,
+			Languages: languages,
+		})
+		return nil
+	})
+	return components, err
+}
+
+// getRootLanguages returns the languages detected for the whole directory at path which are found at its root:
+// the languages of the configuration files at the root, and the languages not detected in any of the subDirectories
+func getRootLanguages(path string, languages []language.Language, subDirectories []recognizer.Component) ([]language.Language, error) {
+	configurationPerLanguage := langfiles.Get().GetConfigurationPerLanguageMapping()
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	configured := map[string]bool{}
+	for _, entry := range entries {
+		if configLanguage, isConfig := configurationPerLanguage[entry.Name()]; isConfig && !entry.IsDir() {
+			configured[strings.ToLower(configLanguage)] = true
+		}
+	}
+	inSubDirectories := map[string]bool{}
+	for _, component := range subDirectories {
+		for _, lang := range component.Languages {
+			inSubDirectories[strings.ToLower(lang.Name)] = true
+		}
+	}
+	var result []language.Language
+	for _, lang := range languages {
+		name := strings.ToLower(lang.Name)
+		if configured[name] || !inSubDirectories[name] {
+			result = append(result, lang)
+		}
+	}
+	return result, nil
+}
+
+// getScore returns the score of a devfile stack for a detected language, or 0 if the stack does not match the language
+func getScore(lang language.Language, stack registry.DevfileStack) int {
+	if !strings.EqualFold(stack.Language, lang.Name) && !matches(lang.Aliases, stack.Language) {
+		return 0
+	}
+	score := languageWeight
+	if matches(lang.Frameworks, stack.ProjectType) {
+		score += frameworkWeight
+	}
+	for _, tag := range stack.Tags {
+		if matches(lang.Frameworks, tag) {
+			score += frameworkWeight
+		}
+		if matches(lang.Tools, tag) {
+			score += toolWeight
+		}
+	}
+	return score
+}
+
+// getLanguageRank returns the position of the language in the list of languages detected for the whole directory,
+// or the length of the list if the language is not part of it
+func getLanguageRank(languages []language.Language, lang language.Language) int {
+	for i, l := range languages {
+		if l.Name == lang.Name {
+			return i
+		}
+	}
+	return len(languages)
+}
+
+func matches(values []string, valueToFind string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, valueToFind) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(values []string, newValues ...string) []string {
+	for _, newValue := range newValues {
+		found := false
+		for _, value := range values {
+			if value == newValue {
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, newValue)
+		}
+	}
+	return values
+}
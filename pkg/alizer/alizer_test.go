@@ -1,7 +1,9 @@
 package alizer
 
 import (
+	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"testing"
 
@@ -131,3 +133,138 @@ func TestDetectFramework(t *testing.T) {
 		})
 	}
 }
+
+// copyTestProject copies the files of a test project into dest
+func copyTestProject(t *testing.T, folder string, dest string) {
+	src := GetTestProjectPath(folder)
+	err := filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dest, rel), 0755)
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dest, rel), content, 0644)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDetectFrameworks(t *testing.T) {
+	tests := []struct {
+		name           string
+		projects       map[string]string
+		wantedDevfiles []string
+		wantedPaths    map[string][]string
+		wantErr        bool
+	}{
+		{
+			name: "Detect java and Node.JS in a monorepo",
+			projects: map[string]string{
+				"backend":  "openjdk",
+				"frontend": "nodejs",
+			},
+			wantedDevfiles: []string{"java-maven", "java-quarkus", "java-wildfly", "nodejs"},
+			wantedPaths: map[string][]string{
+				"java-maven": {"backend"},
+				"nodejs":     {"frontend"},
+			},
+		},
+		{
+			name: "Detect Node.JS at the root and in a sub-directory",
+			projects: map[string]string{
+				".":        "nodejs",
+				"frontend": "nodejs",
+			},
+			wantedDevfiles: []string{"nodejs"},
+			wantedPaths: map[string][]string{
+				"nodejs": {".", "frontend"},
+			},
+		},
+		{
+			name: "Detect python example",
+			projects: map[string]string{
+				".": "python",
+			},
+			wantedDevfiles: []string{"python"},
+			wantedPaths: map[string][]string{
+				"python": {"."},
+			},
+		},
+		{
+			name:     "No devfile found in empty directory",
+			projects: map[string]string{},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for subdir, project := range tt.projects {
+				copyTestProject(t, project, filepath.Join(dir, subdir))
+			}
+
+			ctrl := gomock.NewController(t)
+			registryClient := registry.NewMockClient(ctrl)
+			registryClient.EXPECT().ListDevfileStacks("").Return(list, nil)
+			alizerClient := NewAlizerClient(registryClient)
+
+			candidates, err := alizerClient.DetectFrameworks(dir)
+			if tt.wantErr != (err != nil) {
+				t.Errorf("unexpected error %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			var devfiles []string
+			for _, candidate := range candidates {
+				devfiles = append(devfiles, candidate.Type.Name)
+				if wantedPaths, ok := tt.wantedPaths[candidate.Type.Name]; ok && !reflect.DeepEqual(candidate.Paths, wantedPaths) {
+					t.Errorf("unexpected paths %v for devfile %q, wantedPaths %v", candidate.Paths, candidate.Type.Name, wantedPaths)
+				}
+			}
+			if !reflect.DeepEqual(devfiles, tt.wantedDevfiles) {
+				t.Errorf("unexpected devfiles %v, wantedDevfiles %v", devfiles, tt.wantedDevfiles)
+			}
+		})
+	}
+}
+
+func TestDetectSubDirectories(t *testing.T) {
+	dir := t.TempDir()
+	for _, file := range []string{
+		filepath.Join("frontend", "package.json"),
+		filepath.Join("frontend", "node_modules", "express", "package.json"),
+		filepath.Join("vendor", "lib", "package.json"),
+	} {
+		err := os.MkdirAll(filepath.Join(dir, filepath.Dir(file)), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(dir, file), []byte("{}"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	components, err := detectSubDirectories(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var paths []string
+	for _, component := range components {
+		paths = append(paths, component.Path)
+	}
+	want := []string{filepath.Join(dir, "frontend")}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("got paths %v, want %v", paths, want)
+	}
+}
@@ -0,0 +1,332 @@
+package alizer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/ghodss/yaml"
+)
+
+// ignoredDirectories are the directories not scanned when detecting the configuration of an application,
+// and the languages of its sub-directories
+var ignoredDirectories = map[string]bool{
+	".git":         true,
+	".odo":         true,
+	"node_modules": true,
+	"vendor":       true,
+	"target":       true,
+	"build":        true,
+	"dist":         true,
+	"__pycache__":  true,
+	"venv":         true,
+	".venv":        true,
+}
+
+// sourceExtensions are the extensions of the source files scanned for environment variables reads and ports
+var sourceExtensions = map[string]bool{
+	".js":   true,
+	".mjs":  true,
+	".ts":   true,
+	".java": true,
+	".kt":   true,
+	".go":   true,
+	".py":   true,
+}
+
+// packageJSONScripts are the scripts of a package.json file scanned for ports and environment variables
+var packageJSONScripts = []string{"start", "dev", "serve", "debug"}
+
+var (
+	dockerfileExposeRegexp = regexp.MustCompile(`(?im)^\s*EXPOSE\s+(.+)$`)
+	dockerfileEnvRegexp    = regexp.MustCompile(`(?im)^\s*ENV\s+(.+)$`)
+	dockerfileEnvKeyValue  = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*)=("[^"]*"|'[^']*'|\S*)`)
+	envNameRegexp          = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	springPlaceholder      = regexp.MustCompile(`\$\{([A-Z_][A-Z0-9_]*)(?::([^}]*))?\}`)
+	scriptEnvRegexp        = regexp.MustCompile(`(?:^|[\s;&])([A-Z_][A-Z0-9_]*)=("[^"]*"|'[^']*'|\S+)`)
+	scriptPortRegexp       = regexp.MustCompile(`(?:--port[=\s]|-p\s)\s*(\d+)`)
+	listenRegexp           = regexp.MustCompile(`\.listen\(\s*(\d+)\s*[,)]`)
+	portDefaultRegexp      = regexp.MustCompile(`process\.env\.PORT\s*\|\|\s*(\d+)`)
+	// secretEnvNameRegexp matches the names of the environment variables whose values are secrets
+	secretEnvNameRegexp = regexp.MustCompile(`(?i)(PASSWORD|PASSWD|SECRET|TOKEN|API_?KEY|PRIVATE_?KEY|CREDENTIAL)`)
+	envReadRegexps      = []*regexp.Regexp{
+		// JavaScript / TypeScript
+		regexp.MustCompile(`process\.env\.([A-Za-z_][A-Za-z0-9_]*)`),
+		regexp.MustCompile(`process\.env\[\s*['"]([A-Za-z_][A-Za-z0-9_]*)['"]\s*\]`),
+		// Java / Kotlin
+		regexp.MustCompile(`System\.getenv\(\s*"([A-Za-z_][A-Za-z0-9_]*)"\s*\)`),
+		// Go
+		regexp.MustCompile(`os\.(?:Getenv|LookupEnv)\(\s*"([A-Za-z_][A-Za-z0-9_]*)"\s*\)`),
+		// Python
+		regexp.MustCompile(`os\.environ\[\s*['"]([A-Za-z_][A-Za-z0-9_]*)['"]\s*\]`),
+		regexp.MustCompile(`os\.(?:environ\.get|getenv)\(\s*['"]([A-Za-z_][A-Za-z0-9_]*)['"]`),
+	}
+)
+
+// DetectConfiguration scans the files in path and its sub-directories, and returns the ports the application
+// listens on and the environment variables it reads.
+// The following sources are scanned:
+// - Dockerfile: EXPOSE and ENV instructions,
+// - .env files: names of the environment variables only, as their values are usually secrets, and the PORT value,
+// - Spring application.properties and application.yaml files: server.port and ${VAR:default} placeholders,
+// - package.json files: ports and environment variables set in the start scripts,
+// - source files: environment variables reads and ports passed to listen()
+func (o *Alizer) DetectConfiguration(path string) (DetectedConfiguration, error) {
+	detector := newConfigurationDetector()
+	err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if file != path && ignoredDirectories[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		parse := getConfigurationParser(info.Name())
+		if parse == nil {
+			return nil
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		parse(detector, string(content))
+		return nil
+	})
+	if err != nil {
+		return DetectedConfiguration{}, err
+	}
+	return detector.result(), nil
+}
+
+// configurationDetector accumulates the ports and environment variables detected in the files
+type configurationDetector struct {
+	ports map[int]bool
+	// envNames are the names of all the environment variables detected, in the order of detection
+	envNames []string
+	// envs are the known values of the environment variables, indexed by their names
+	envs map[string]string
+}
+
+func newConfigurationDetector() *configurationDetector {
+	return &configurationDetector{
+		ports: map[int]bool{},
+		envs:  map[string]string{},
+	}
+}
+
+func (o *configurationDetector) addPort(port string) {
+	p, err := strconv.Atoi(strings.TrimSpace(port))
+	if err != nil || p <= 0 || p > 65535 {
+		return
+	}
+	o.ports[p] = true
+}
+
+// addEnv adds an environment variable with its value. The value is not kept if it is empty, or if the name
+// of the variable denotes a secret. The first known value is kept
+func (o *configurationDetector) addEnv(name string, value string) {
+	if !o.addEnvName(name) {
+		return
+	}
+	value = strings.Trim(value, `"'`)
+	if value == "" || secretEnvNameRegexp.MatchString(name) {
+		return
+	}
+	if _, found := o.envs[name]; !found {
+		o.envs[name] = value
+	}
+	if name == "PORT" {
+		o.addPort(value)
+	}
+}
+
+// addEnvName adds the name of an environment variable used by the application, whose value is not known.
+// It returns false if the name is not a valid name of an environment variable
+func (o *configurationDetector) addEnvName(name string) bool {
+	if !envNameRegexp.MatchString(name) {
+		return false
+	}
+	for _, envName := range o.envNames {
+		if envName == name {
+			return true
+		}
+	}
+	o.envNames = append(o.envNames, name)
+	return true
+}
+
+func (o *configurationDetector) result() DetectedConfiguration {
+	result := DetectedConfiguration{}
+	for port := range o.ports {
+		result.Ports = append(result.Ports, port)
+	}
+	sort.Ints(result.Ports)
+	for _, name := range o.envNames {
+		value, known := o.envs[name]
+		if !known {
+			result.UnsetEnvs = append(result.UnsetEnvs, name)
+			continue
+		}
+		result.Envs = append(result.Envs, v1alpha2.EnvVar{
+			Name:  name,
+			Value: value,
+		})
+	}
+	return result
+}
+
+// getConfigurationParser returns the function to use to parse a file, depending on its name,
+// or nil if the file is not to be parsed
+func getConfigurationParser(name string) func(*configurationDetector, string) {
+	lower := strings.ToLower(name)
+	switch {
+	case lower == "dockerfile" || lower == "containerfile" || strings.HasSuffix(lower, ".dockerfile"):
+		return parseDockerfile
+	case lower == ".env" || strings.HasPrefix(lower, ".env."):
+		return parseDotEnv
+	case strings.HasPrefix(lower, "application") && strings.HasSuffix(lower, ".properties"):
+		return parseSpringProperties
+	case strings.HasPrefix(lower, "application") && (strings.HasSuffix(lower, ".yaml") || strings.HasSuffix(lower, ".yml")):
+		return parseSpringYaml
+	case lower == "package.json":
+		return parsePackageJSON
+	case sourceExtensions[filepath.Ext(lower)]:
+		return parseSource
+	}
+	return nil
+}
+
+func parseDockerfile(o *configurationDetector, content string) {
+	for _, match := range dockerfileExposeRegexp.FindAllStringSubmatch(content, -1) {
+		for _, port := range strings.Fields(match[1]) {
+			o.addPort(strings.SplitN(port, "/", 2)[0])
+		}
+	}
+	for _, match := range dockerfileEnvRegexp.FindAllStringSubmatch(content, -1) {
+		line := strings.TrimSpace(match[1])
+		fields := strings.SplitN(line, " ", 2)
+		if !strings.Contains(fields[0], "=") {
+			// legacy form: ENV KEY value
+			if len(fields) == 2 {
+				o.addEnv(fields[0], strings.TrimSpace(fields[1]))
+			}
+			continue
+		}
+		for _, pair := range dockerfileEnvKeyValue.FindAllStringSubmatch(line, -1) {
+			o.addEnv(pair[1], pair[2])
+		}
+	}
+}
+
+func parseDotEnv(o *configurationDetector, content string) {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		// the values of .env files are usually secrets, and are never copied.
+		// The value of PORT is only used to detect the port the application listens on
+		name := strings.TrimSpace(kv[0])
+		o.addEnvName(name)
+		if name == "PORT" {
+			o.addPort(strings.Trim(strings.TrimSpace(kv[1]), `"'`))
+		}
+	}
+}
+
+func parseSpringProperties(o *configurationDetector, content string) {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			kv = strings.SplitN(line, ":", 2)
+		}
+		if len(kv) == 2 && strings.TrimSpace(kv[0]) == "server.port" {
+			o.addPort(springValue(strings.TrimSpace(kv[1])))
+		}
+	}
+	parseSpringPlaceholders(o, content)
+}
+
+func parseSpringYaml(o *configurationDetector, content string) {
+	var config struct {
+		Server struct {
+			Port interface{} `json:"port"`
+		} `json:"server"`
+	}
+	// application.yaml files can contain several documents, only the first one is parsed
+	doc := strings.Split(content, "\n---")[0]
+	if err := yaml.Unmarshal([]byte(doc), &config); err == nil && config.Server.Port != nil {
+		switch port := config.Server.Port.(type) {
+		case float64:
+			o.addPort(strconv.Itoa(int(port)))
+		case string:
+			o.addPort(springValue(port))
+		}
+	}
+	parseSpringPlaceholders(o, content)
+}
+
+// springValue returns the value of a property, resolving a ${VAR:default} placeholder to its default value
+func springValue(value string) string {
+	if match := springPlaceholder.FindStringSubmatch(value); match != nil {
+		return match[2]
+	}
+	return value
+}
+
+func parseSpringPlaceholders(o *configurationDetector, content string) {
+	for _, match := range springPlaceholder.FindAllStringSubmatch(content, -1) {
+		o.addEnv(match[1], match[2])
+	}
+}
+
+func parsePackageJSON(o *configurationDetector, content string) {
+	var pkg struct {
+		Scripts map[string]string `json:"scripts"`
+	}
+	if err := json.Unmarshal([]byte(content), &pkg); err != nil {
+		return
+	}
+	for _, name := range packageJSONScripts {
+		script, ok := pkg.Scripts[name]
+		if !ok {
+			continue
+		}
+		for _, match := range scriptEnvRegexp.FindAllStringSubmatch(script, -1) {
+			o.addEnv(match[1], match[2])
+		}
+		for _, match := range scriptPortRegexp.FindAllStringSubmatch(script, -1) {
+			o.addPort(match[1])
+		}
+	}
+}
+
+func parseSource(o *configurationDetector, content string) {
+	for _, match := range portDefaultRegexp.FindAllStringSubmatch(content, -1) {
+		o.addEnv("PORT", match[1])
+	}
+	for _, match := range listenRegexp.FindAllStringSubmatch(content, -1) {
+		o.addPort(match[1])
+	}
+	for _, re := range envReadRegexps {
+		for _, match := range re.FindAllStringSubmatch(content, -1) {
+			o.addEnvName(match[1])
+		}
+	}
+}
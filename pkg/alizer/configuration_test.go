@@ -0,0 +1,116 @@
+package alizer
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+func TestDetectConfiguration(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		wantPorts []int
+		wantEnvs  []v1alpha2.EnvVar
+		// wantUnsetEnvs are the names of the environment variables detected without a known value which is not a secret
+		wantUnsetEnvs []string
+	}{
+		{
+			name: "Dockerfile with EXPOSE and ENV",
+			files: map[string]string{
+				"Dockerfile": "FROM node:14\nENV NODE_ENV=production LOG_LEVEL=\"debug\"\nENV JAVA_OPTS -Dfoo=bar\nEXPOSE 8080 8443/tcp\n",
+			},
+			wantPorts: []int{8080, 8443},
+			wantEnvs: []v1alpha2.EnvVar{
+				{Name: "NODE_ENV", Value: "production"},
+				{Name: "LOG_LEVEL", Value: "debug"},
+				{Name: "JAVA_OPTS", Value: "-Dfoo=bar"},
+			},
+		},
+		{
+			name: "Spring application.properties",
+			files: map[string]string{
+				"src/main/resources/application.properties": "# comment\nserver.port=${SERVER_PORT:8081}\nspring.datasource.url=${DATABASE_URL:jdbc:postgresql://localhost/db}\nspring.datasource.password=${DB_PASSWORD}\n",
+			},
+			wantPorts: []int{8081},
+			wantEnvs: []v1alpha2.EnvVar{
+				{Name: "SERVER_PORT", Value: "8081"},
+				{Name: "DATABASE_URL", Value: "jdbc:postgresql://localhost/db"},
+			},
+			wantUnsetEnvs: []string{"DB_PASSWORD"},
+		},
+		{
+			name: "Spring application.yaml",
+			files: map[string]string{
+				"application.yaml": "server:\n  port: 9090\n",
+			},
+			wantPorts: []int{9090},
+		},
+		{
+			name: "package.json scripts and source files",
+			files: map[string]string{
+				"package.json":              `{"scripts": {"start": "PORT=3000 node server.js", "dev": "nodemon --port 3001", "test": "PORT=4000 jest"}}`,
+				"server.js":                 "const db = process.env.DATABASE_URL;\nconst port = process.env.PORT || 3000;\napp.listen(port);\n",
+				"node_modules/dep/index.js": "process.env.IGNORED;\n",
+			},
+			wantPorts: []int{3000, 3001},
+			wantEnvs: []v1alpha2.EnvVar{
+				{Name: "PORT", Value: "3000"},
+			},
+			wantUnsetEnvs: []string{"DATABASE_URL"},
+		},
+		{
+			name: "values of .env files and secrets not kept",
+			files: map[string]string{
+				".env":       "# comment\nexport API_KEY='secret'\nPORT=5000\n",
+				"Dockerfile": "FROM golang\nENV GITHUB_TOKEN=abc LOG_LEVEL=info\n",
+				"main.go":    "package main\nvar key = os.Getenv(\"API_KEY\")\nvar other = os.Getenv(\"OTHER\")\n",
+			},
+			// the port is detected from the value of PORT in the .env file, which is not copied
+			wantPorts: []int{5000},
+			wantEnvs: []v1alpha2.EnvVar{
+				{Name: "LOG_LEVEL", Value: "info"},
+			},
+			wantUnsetEnvs: []string{"API_KEY", "PORT", "GITHUB_TOKEN", "OTHER"},
+		},
+		{
+			name: "nothing detected",
+			files: map[string]string{
+				"README.md": "EXPOSE 8080",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				file := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			alizerClient := NewAlizerClient(nil)
+			got, err := alizerClient.DetectConfiguration(dir)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got.Ports, tt.wantPorts) {
+				t.Errorf("unexpected ports %v, wantPorts %v", got.Ports, tt.wantPorts)
+			}
+			if !reflect.DeepEqual(got.Envs, tt.wantEnvs) {
+				t.Errorf("unexpected envs %v, wantEnvs %v", got.Envs, tt.wantEnvs)
+			}
+			if !reflect.DeepEqual(got.UnsetEnvs, tt.wantUnsetEnvs) {
+				t.Errorf("unexpected unset envs %v, wantUnsetEnvs %v", got.UnsetEnvs, tt.wantUnsetEnvs)
+			}
+		})
+	}
+}
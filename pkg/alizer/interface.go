@@ -6,5 +6,11 @@ import (
 )
 
 type Client interface {
+	// DetectFramework returns the most relevant devfile stack for the files in path, and its registry
 	DetectFramework(path string) (recognizer.DevFileType, registry.Registry, error)
+	// DetectFrameworks returns all the devfile stacks that can be used for the files in path
+	// and its sub-directories, the most relevant first
+	DetectFrameworks(path string) ([]DetectedFramework, error)
+	// DetectConfiguration returns the ports and environment variables used by the application in path
+	DetectConfiguration(path string) (DetectedConfiguration, error)
 }
@@ -35,6 +35,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DetectConfiguration mocks base method.
+func (m *MockClient) DetectConfiguration(path string) (DetectedConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectConfiguration", path)
+	ret0, _ := ret[0].(DetectedConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectConfiguration indicates an expected call of DetectConfiguration.
+func (mr *MockClientMockRecorder) DetectConfiguration(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectConfiguration", reflect.TypeOf((*MockClient)(nil).DetectConfiguration), path)
+}
+
 // DetectFramework mocks base method.
 func (m *MockClient) DetectFramework(path string) (recognizer.DevFileType, registry.Registry, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +66,21 @@ func (mr *MockClientMockRecorder) DetectFramework(path interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectFramework", reflect.TypeOf((*MockClient)(nil).DetectFramework), path)
 }
 
+// DetectFrameworks mocks base method.
+func (m *MockClient) DetectFrameworks(path string) ([]DetectedFramework, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectFrameworks", path)
+	ret0, _ := ret[0].([]DetectedFramework)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectFrameworks indicates an expected call of DetectFrameworks.
+func (mr *MockClientMockRecorder) DetectFrameworks(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectFrameworks", reflect.TypeOf((*MockClient)(nil).DetectFrameworks), path)
+}
+
 // GetDevfileLocationFromDetection mocks base method.
 func (m *MockClient) GetDevfileLocationFromDetection(arg0 recognizer.DevFileType, arg1 registry.Registry) *DevfileLocation {
 	m.ctrl.T.Helper()
@@ -1,5 +1,11 @@
 package alizer
 
+import (
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/redhat-developer/alizer/go/pkg/apis/recognizer"
+	"github.com/redhat-developer/odo/pkg/registry"
+)
+
 type DevfileLocation struct {
 	// name of the Devfile in Devfile registry (required if DevfilePath is not defined)
 	Devfile string `json:"devfile,omitempty"`
@@ -10,3 +16,48 @@ type DevfileLocation struct {
 	// path to a devfile. This is alternative to using devfile from Devfile registry. It can be local filesystem path or http(s) URL (required if Devfile is not defined)
 	DevfilePath string `json:"devfile-path,omitempty"`
 }
+
+// DetectedFramework is a devfile stack detected as a candidate for the analyzed directory
+type DetectedFramework struct {
+	// Type of the devfile stack
+	Type recognizer.DevFileType
+	// Registry containing the devfile stack
+	Registry registry.Registry
+	// Languages detected in the directory and matching the devfile stack
+	Languages []string
+	// Frameworks detected for these languages
+	Frameworks []string
+	// Paths of the sub-directories, relative to the analyzed directory, in which the languages have been detected
+	Paths []string
+	// Score of the devfile stack for the most relevant detected language. The higher the better
+	Score int
+}
+
+// DetectionResult is the result of a detection.
+// The location of the most relevant candidate is inlined
+type DetectionResult struct {
+	DevfileLocation
+	// Candidates are all the devfiles detected, the most relevant first
+	Candidates []DevfileCandidate `json:"candidates"`
+}
+
+// DevfileCandidate is a devfile detected as a candidate
+type DevfileCandidate struct {
+	DevfileLocation
+	Language    string   `json:"language,omitempty"`
+	ProjectType string   `json:"project-type,omitempty"`
+	Languages   []string `json:"languages,omitempty"`
+	Frameworks  []string `json:"frameworks,omitempty"`
+	Paths       []string `json:"paths,omitempty"`
+	Score       int      `json:"score"`
+}
+
+// DetectedConfiguration is the configuration of an application detected from its source files
+type DetectedConfiguration struct {
+	// Ports the application listens on
+	Ports []int
+	// Envs are the environment variables used by the application, with known values which are not secrets
+	Envs []v1alpha2.EnvVar
+	// UnsetEnvs are the names of the environment variables used by the application, whose values are not known or are secrets
+	UnsetEnvs []string
+}
@@ -0,0 +1,91 @@
+package asker
+
+import (
+	"sort"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+const (
+	// NamingStrategyDefault lets the Service Binding library choose the naming strategy
+	NamingStrategyDefault = "DEFAULT"
+	// NamingStrategyNone keeps the names of the binding information
+	NamingStrategyNone = "none"
+	// NamingStrategyLowercase changes the names of the binding information to lowercase
+	NamingStrategyLowercase = "lowercase"
+	// NamingStrategyUppercase changes the names of the binding information to uppercase
+	NamingStrategyUppercase = "uppercase"
+	// NamingStrategyCustom asks for a custom naming strategy
+	NamingStrategyCustom = "CUSTOM"
+)
+
+type Survey struct{}
+
+func NewSurveyAsker() *Survey {
+	return &Survey{}
+}
+
+func (o *Survey) AskServiceInstance(serviceInstances []string) (string, error) {
+	sort.Strings(serviceInstances)
+	question := &survey.Select{
+		Message: "Select service instance you want to bind to:",
+		Options: serviceInstances,
+	}
+	var answer string
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return "", err
+	}
+	return answer, nil
+}
+
+func (o *Survey) AskServiceBindingName(defaultName string) (string, error) {
+	question := &survey.Input{
+		Message: "Enter the Binding's name:",
+		Default: defaultName,
+	}
+	var answer string
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return "", err
+	}
+	return answer, nil
+}
+
+func (o *Survey) AskBindAsFiles() (bool, error) {
+	question := &survey.Select{
+		Message: "How do you want to bind the service?",
+		Options: []string{"Bind as Files", "Bind as Environment Variables"},
+	}
+	var answer int
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return false, err
+	}
+	return answer == 0, nil
+}
+
+func (o *Survey) AskNamingStrategy() (string, error) {
+	question := &survey.Select{
+		Message: "Select naming strategy for binding names:",
+		Options: []string{NamingStrategyDefault, NamingStrategyNone, NamingStrategyLowercase, NamingStrategyUppercase, NamingStrategyCustom},
+	}
+	var answer string
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return "", err
+	}
+	switch answer {
+	case NamingStrategyDefault:
+		return "", nil
+	case NamingStrategyCustom:
+		customQuestion := &survey.Input{
+			Message: "Enter the naming strategy (for example {{ .service.kind | upper }}_{{ .name | upper }}):",
+		}
+		err = survey.AskOne(customQuestion, &answer, survey.WithValidator(survey.Required))
+		if err != nil {
+			return "", err
+		}
+	}
+	return answer, nil
+}
@@ -0,0 +1,20 @@
+// package asker uses the Survey library to interact with the user and ask various information
+// needed to bind a component to a service.
+package asker
+
+// Asker interactively asks for information to the user
+type Asker interface {
+	// AskServiceInstance asks for a service instance, from a list of services with the format <kind>/<name>.
+	// The selected service is returned
+	AskServiceInstance(serviceInstances []string) (string, error)
+
+	// AskServiceBindingName asks for the name of the ServiceBinding
+	AskServiceBindingName(defaultName string) (string, error)
+
+	// AskBindAsFiles asks whether the binding information should be injected as files or as environment variables
+	AskBindAsFiles() (bool, error)
+
+	// AskNamingStrategy asks for the naming strategy of the binding information.
+	// An empty string is returned for the default naming strategy
+	AskNamingStrategy() (string, error)
+}
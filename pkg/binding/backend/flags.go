@@ -0,0 +1,81 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	dfutil "github.com/devfile/library/pkg/util"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/redhat-developer/odo/pkg/service"
+)
+
+const (
+	FLAG_SERVICE         = "service"
+	FLAG_NAME            = "name"
+	FLAG_BIND_AS_FILES   = "bind-as-files"
+	FLAG_NAMING_STRATEGY = "naming-strategy"
+)
+
+// FlagsBackend is a backend that will extract all needed information from flags passed to the command
+type FlagsBackend struct{}
+
+func NewFlagsBackend() *FlagsBackend {
+	return &FlagsBackend{}
+}
+
+func (o *FlagsBackend) Validate(flags map[string]string) error {
+	if flags[FLAG_SERVICE] == "" {
+		return errors.New("missing --service parameter: please add --service <kind>/<name> to specify the service instance to bind to")
+	}
+	if _, _, err := service.SplitServiceKindName(flags[FLAG_SERVICE]); err != nil {
+		return fmt.Errorf("invalid --service parameter %q: the service instance must have the format <kind>/<name>", flags[FLAG_SERVICE])
+	}
+	if flags[FLAG_NAME] != "" {
+		if err := dfutil.ValidateK8sResourceName("name", flags[FLAG_NAME]); err != nil {
+			return err
+		}
+	}
+	if flags[FLAG_BIND_AS_FILES] != "" {
+		if _, err := strconv.ParseBool(flags[FLAG_BIND_AS_FILES]); err != nil {
+			return fmt.Errorf("invalid --bind-as-files parameter %q: the value must be true or false", flags[FLAG_BIND_AS_FILES])
+		}
+	}
+	return nil
+}
+
+func (o *FlagsBackend) SelectServiceInstance(flags map[string]string, serviceMap map[string]unstructured.Unstructured) (string, error) {
+	serviceName := flags[FLAG_SERVICE]
+	if _, ok := serviceMap[serviceName]; ok {
+		return serviceName, nil
+	}
+	var names []string
+	for name := range serviceMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return "", fmt.Errorf("service instance %q not found: no bindable service instance found in the namespace", serviceName)
+	}
+	return "", fmt.Errorf("service instance %q not found, the bindable service instances are: %v", serviceName, names)
+}
+
+func (o *FlagsBackend) AskBindingName(defaultName string, flags map[string]string) (string, error) {
+	if flags[FLAG_NAME] != "" {
+		return flags[FLAG_NAME], nil
+	}
+	return defaultName, nil
+}
+
+func (o *FlagsBackend) AskBindAsFiles(flags map[string]string) (bool, error) {
+	if flags[FLAG_BIND_AS_FILES] == "" {
+		return true, nil
+	}
+	return strconv.ParseBool(flags[FLAG_BIND_AS_FILES])
+}
+
+func (o *FlagsBackend) AskNamingStrategy(flags map[string]string) (string, error) {
+	return flags[FLAG_NAMING_STRATEGY], nil
+}
@@ -0,0 +1,108 @@
+package backend
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFlagsBackend_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		wantErr bool
+	}{
+		{
+			name: "all flags are valid",
+			flags: map[string]string{
+				FLAG_SERVICE:         "Redis/myredis",
+				FLAG_NAME:            "redis-binding",
+				FLAG_BIND_AS_FILES:   "false",
+				FLAG_NAMING_STRATEGY: "uppercase",
+			},
+		},
+		{
+			name: "missing service",
+			flags: map[string]string{
+				FLAG_NAME: "redis-binding",
+			},
+			wantErr: true,
+		},
+		{
+			name: "service without kind",
+			flags: map[string]string{
+				FLAG_SERVICE: "myredis",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid name",
+			flags: map[string]string{
+				FLAG_SERVICE: "Redis/myredis",
+				FLAG_NAME:    "Redis_Binding",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid bind-as-files",
+			flags: map[string]string{
+				FLAG_SERVICE:       "Redis/myredis",
+				FLAG_BIND_AS_FILES: "maybe",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &FlagsBackend{}
+			if err := o.Validate(tt.flags); (err != nil) != tt.wantErr {
+				t.Errorf("FlagsBackend.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlagsBackend_SelectServiceInstance(t *testing.T) {
+	serviceMap := map[string]unstructured.Unstructured{
+		"Redis/myredis": {},
+	}
+	tests := []struct {
+		name       string
+		flags      map[string]string
+		serviceMap map[string]unstructured.Unstructured
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "service found",
+			flags:      map[string]string{FLAG_SERVICE: "Redis/myredis"},
+			serviceMap: serviceMap,
+			want:       "Redis/myredis",
+		},
+		{
+			name:       "service not found",
+			flags:      map[string]string{FLAG_SERVICE: "Redis/other"},
+			serviceMap: serviceMap,
+			wantErr:    true,
+		},
+		{
+			name:       "no service in namespace",
+			flags:      map[string]string{FLAG_SERVICE: "Redis/myredis"},
+			serviceMap: map[string]unstructured.Unstructured{},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &FlagsBackend{}
+			got, err := o.SelectServiceInstance(tt.flags, tt.serviceMap)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FlagsBackend.SelectServiceInstance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FlagsBackend.SelectServiceInstance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// package backend provides different backends to bind a component to a service.
+// - `Flags` backend gets needed information from command line flags.
+// - `Interactive` backend interacts with the user to get needed information.
+package backend
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// AddBindingBackend is a specialized backend for steps of binding a component to a service, based on various input (either from CLI flags or interactively from user)
+type AddBindingBackend interface {
+	// Validate returns an error if it does not validate the flags
+	Validate(flags map[string]string) error
+
+	// SelectServiceInstance selects the service instance to bind to, from a map of services indexed by <kind>/<name>.
+	// The key of the selected service is returned
+	SelectServiceInstance(flags map[string]string, serviceMap map[string]unstructured.Unstructured) (string, error)
+
+	// AskBindingName returns the name of the ServiceBinding, defaulting to defaultName
+	AskBindingName(defaultName string, flags map[string]string) (string, error)
+
+	// AskBindAsFiles returns true if the binding information should be injected as files
+	AskBindAsFiles(flags map[string]string) (bool, error)
+
+	// AskNamingStrategy returns the naming strategy of the binding information,
+	// or an empty string to use the default naming strategy
+	AskNamingStrategy(flags map[string]string) (string, error)
+}
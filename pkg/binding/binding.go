@@ -0,0 +1,212 @@
+package binding
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/ghodss/yaml"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	sboApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+
+	"github.com/redhat-developer/odo/pkg/binding/asker"
+	"github.com/redhat-developer/odo/pkg/binding/backend"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/service"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// serviceBindingKind is the kind of the resource describing a binding
+const serviceBindingKind = "ServiceBinding"
+
+type BindingClient struct {
+	// Backends
+	flagsBackend       *backend.FlagsBackend
+	interactiveBackend *backend.InteractiveBackend
+
+	// Clients
+	kubernetesClient kclient.ClientInterface
+}
+
+func NewBindingClient(kubernetesClient kclient.ClientInterface) *BindingClient {
+	return &BindingClient{
+		flagsBackend:       backend.NewFlagsBackend(),
+		interactiveBackend: backend.NewInteractiveBackend(asker.NewSurveyAsker()),
+		kubernetesClient:   kubernetesClient,
+	}
+}
+
+// GetFlags gets the flag specific to add binding operation so that it can correctly decide on the backend to be used
+// It ignores all the flags except the ones specific to add binding operation, for e.g. verbosity flag
+func (o *BindingClient) GetFlags(flags map[string]string) map[string]string {
+	bindingFlags := map[string]string{}
+	for flag, value := range flags {
+		switch flag {
+		case backend.FLAG_SERVICE, backend.FLAG_NAME, backend.FLAG_BIND_AS_FILES, backend.FLAG_NAMING_STRATEGY:
+			bindingFlags[flag] = value
+		}
+	}
+	return bindingFlags
+}
+
+// Validate calls Validate method of the adequate backend
+func (o *BindingClient) Validate(flags map[string]string) error {
+	return o.getBackend(flags).Validate(flags)
+}
+
+// GetServiceInstances returns the instances of the Operator backed services of the current namespace, indexed by <kind>/<name>
+func (o *BindingClient) GetServiceInstances() (map[string]unstructured.Unstructured, error) {
+	csvSupport, err := o.kubernetesClient.IsCSVSupported()
+	if err != nil {
+		return nil, err
+	}
+	if !csvSupport {
+		return nil, fmt.Errorf("no bindable service instance found: Operators are not supported by the cluster")
+	}
+
+	instances, _, err := service.ListOperatorServices(o.kubernetesClient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the service instances: %w", err)
+	}
+
+	serviceMap := map[string]unstructured.Unstructured{}
+	for _, instance := range instances {
+		if instance.GetKind() == serviceBindingKind {
+			continue
+		}
+		serviceMap[instance.GetKind()+"/"+instance.GetName()] = instance
+	}
+	return serviceMap, nil
+}
+
+// SelectServiceInstance calls SelectServiceInstance method of the adequate backend
+func (o *BindingClient) SelectServiceInstance(flags map[string]string, serviceMap map[string]unstructured.Unstructured) (string, error) {
+	return o.getBackend(flags).SelectServiceInstance(flags, serviceMap)
+}
+
+// AskBindingName calls AskBindingName method of the adequate backend, with <component>-<service> as default name
+func (o *BindingClient) AskBindingName(serviceName, componentName string, flags map[string]string) (string, error) {
+	defaultName := fmt.Sprintf("%s-%s", componentName, serviceName)
+	return o.getBackend(flags).AskBindingName(defaultName, flags)
+}
+
+// AskBindAsFiles calls AskBindAsFiles method of the adequate backend
+func (o *BindingClient) AskBindAsFiles(flags map[string]string) (bool, error) {
+	return o.getBackend(flags).AskBindAsFiles(flags)
+}
+
+// AskNamingStrategy calls AskNamingStrategy method of the adequate backend
+func (o *BindingClient) AskNamingStrategy(flags map[string]string) (string, error) {
+	return o.getBackend(flags).AskNamingStrategy(flags)
+}
+
+// AddBinding adds a ServiceBinding between the component and the service instance to the devfile, as a Kubernetes component
+func (o *BindingClient) AddBinding(bindingName string, bindAsFiles bool, namingStrategy string, serviceInstance unstructured.Unstructured, obj parser.DevfileObj, appName string) (parser.DevfileObj, error) {
+	deploymentName, err := util.NamespaceKubernetesObject(obj.GetMetadataName(), appName)
+	if err != nil {
+		return obj, err
+	}
+
+	deploymentGVR, err := o.kubernetesClient.GetDeploymentAPIVersion()
+	if err != nil {
+		return obj, err
+	}
+
+	inlined, err := getServiceBindingYAML(bindingName, bindAsFiles, namingStrategy, serviceInstance, deploymentName, deploymentGVR)
+	if err != nil {
+		return obj, err
+	}
+
+	return devfile.AddKubernetesComponentToDevfile(inlined, bindingName, obj)
+}
+
+// RemoveBinding removes the Kubernetes component containing the ServiceBinding named bindingName from the devfile
+func (o *BindingClient) RemoveBinding(bindingName string, obj parser.DevfileObj) (parser.DevfileObj, error) {
+	components, err := obj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.KubernetesComponentType},
+	})
+	if err != nil {
+		return obj, err
+	}
+	for _, component := range components {
+		if libdevfile.IsK8sComponentRendered(component.Kubernetes, filepath.Dir(obj.Ctx.GetAbsPath()), devfilefs.DefaultFs{}) {
+			// the bindings are not defined in Helm charts or Kustomize directories
+			continue
+		}
+		u, err := libdevfile.GetK8sComponentAsUnstructured(component.Kubernetes, filepath.Dir(obj.Ctx.GetAbsPath()), devfilefs.DefaultFs{})
+		if err != nil {
+			return obj, err
+		}
+		if u.GetKind() != serviceBindingKind || (component.Name != bindingName && u.GetName() != bindingName) {
+			continue
+		}
+		return obj, obj.Data.DeleteComponent(component.Name)
+	}
+	return obj, fmt.Errorf("binding %q not found in the devfile", bindingName)
+}
+
+func (o *BindingClient) getBackend(flags map[string]string) backend.AddBindingBackend {
+	if len(flags) == 0 {
+		return o.interactiveBackend
+	}
+	return o.flagsBackend
+}
+
+// getServiceBindingYAML returns the YAML definition of a ServiceBinding between the deployment of a component and a service instance
+func getServiceBindingYAML(bindingName string, bindAsFiles bool, namingStrategy string, serviceInstance unstructured.Unstructured, deploymentName string, deploymentGVR metav1.GroupVersionResource) (string, error) {
+	serviceGVK := serviceInstance.GroupVersionKind()
+	serviceBinding := sboApi.ServiceBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: sboApi.GroupVersion.String(),
+			Kind:       serviceBindingKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bindingName,
+		},
+		Spec: sboApi.ServiceBindingSpec{
+			DetectBindingResources: true,
+			BindAsFiles:            bindAsFiles,
+			NamingStrategy:         namingStrategy,
+			Application: sboApi.Application{
+				Ref: sboApi.Ref{
+					Name:     deploymentName,
+					Group:    deploymentGVR.Group,
+					Version:  deploymentGVR.Version,
+					Resource: deploymentGVR.Resource,
+				},
+			},
+			Services: []sboApi.Service{{
+				NamespacedRef: sboApi.NamespacedRef{
+					Ref: sboApi.Ref{
+						Group:   serviceGVK.Group,
+						Version: serviceGVK.Version,
+						Kind:    serviceGVK.Kind,
+						Name:    serviceInstance.GetName(),
+					},
+				},
+			}},
+		},
+	}
+
+	// the status and the creation timestamp are not part of the definition of the binding
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&serviceBinding)
+	if err != nil {
+		return "", err
+	}
+	unstructured.RemoveNestedField(content, "status")
+	unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+
+	out, err := yaml.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -0,0 +1,203 @@
+package binding
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/ghodss/yaml"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	sboApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odoTestingUtil "github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+func getServiceInstance() unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion("redis.redis.opstreelabs.in/v1beta1")
+	u.SetKind("Redis")
+	u.SetName("myredis")
+	return u
+}
+
+func TestBindingClient_AddBinding(t *testing.T) {
+	tests := []struct {
+		name           string
+		bindingName    string
+		bindAsFiles    bool
+		namingStrategy string
+		wantErr        bool
+	}{
+		{
+			name:        "bind as files",
+			bindingName: "my-nodejs-app-myredis",
+			bindAsFiles: true,
+		},
+		{
+			name:           "bind as environment variables with a naming strategy",
+			bindingName:    "redis-binding",
+			bindAsFiles:    false,
+			namingStrategy: "uppercase",
+		},
+		{
+			name:        "a component with the same name already exists",
+			bindingName: "runtime",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+			kubeClient.EXPECT().GetDeploymentAPIVersion().Return(metav1.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, nil).AnyTimes()
+			o := &BindingClient{
+				kubernetesClient: kubeClient,
+			}
+
+			obj := odoTestingUtil.GetTestDevfileObj(devfilefs.NewFakeFs())
+			metadata := obj.Data.GetMetadata()
+			metadata.Name = "my-nodejs-app"
+			obj.Data.SetMetadata(metadata)
+
+			got, err := o.AddBinding(tt.bindingName, tt.bindAsFiles, tt.namingStrategy, getServiceInstance(), obj, "app")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BindingClient.AddBinding() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			components, err := got.Data.GetComponents(parsercommon.DevfileOptions{
+				ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.KubernetesComponentType},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(components) != 1 || components[0].Name != tt.bindingName {
+				t.Fatalf("BindingClient.AddBinding() expected a single Kubernetes component named %q, got %v", tt.bindingName, components)
+			}
+			inlined := components[0].Kubernetes.Inlined
+			if strings.Contains(inlined, "status") || strings.Contains(inlined, "creationTimestamp") {
+				t.Errorf("BindingClient.AddBinding() unexpected fields in binding definition:\n%s", inlined)
+			}
+
+			var sb sboApi.ServiceBinding
+			if err = yaml.Unmarshal([]byte(inlined), &sb); err != nil {
+				t.Fatal(err)
+			}
+			if sb.Kind != "ServiceBinding" || sb.Name != tt.bindingName {
+				t.Errorf("BindingClient.AddBinding() got %s/%s, want ServiceBinding/%s", sb.Kind, sb.Name, tt.bindingName)
+			}
+			if sb.Spec.BindAsFiles != tt.bindAsFiles {
+				t.Errorf("BindingClient.AddBinding() bindAsFiles = %v, want %v", sb.Spec.BindAsFiles, tt.bindAsFiles)
+			}
+			if sb.Spec.NamingStrategy != tt.namingStrategy {
+				t.Errorf("BindingClient.AddBinding() namingStrategy = %q, want %q", sb.Spec.NamingStrategy, tt.namingStrategy)
+			}
+			if sb.Spec.Application.Name != "my-nodejs-app-app" || sb.Spec.Application.Resource != "deployments" {
+				t.Errorf("BindingClient.AddBinding() unexpected application %+v", sb.Spec.Application)
+			}
+			wantService := sboApi.Ref{Group: "redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "Redis", Name: "myredis"}
+			if len(sb.Spec.Services) != 1 || sb.Spec.Services[0].Ref != wantService {
+				t.Errorf("BindingClient.AddBinding() services = %+v, want %+v", sb.Spec.Services, wantService)
+			}
+		})
+	}
+}
+
+func TestBindingClient_RemoveBinding(t *testing.T) {
+	const otherResource = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+`
+	tests := []struct {
+		name           string
+		bindingName    string
+		wantErr        bool
+		wantComponents []string
+	}{
+		{
+			name:           "remove binding by component name",
+			bindingName:    "redis-binding",
+			wantComponents: []string{"runtime", "loadbalancer", "my-config"},
+		},
+		{
+			name:           "remove binding by ServiceBinding name",
+			bindingName:    "redis-binding-resource",
+			wantComponents: []string{"runtime", "loadbalancer", "my-config"},
+		},
+		{
+			name:        "not a binding",
+			bindingName: "my-config",
+			wantErr:     true,
+		},
+		{
+			name:        "binding not found",
+			bindingName: "unknown",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &BindingClient{}
+
+			obj := odoTestingUtil.GetTestDevfileObj(devfilefs.NewFakeFs())
+			inlined, err := getServiceBindingYAML("redis-binding-resource", true, "", getServiceInstance(), "my-app", metav1.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = obj.Data.AddComponents([]v1alpha2.Component{
+				getKubernetesComponent("redis-binding", inlined),
+				getKubernetesComponent("my-config", otherResource),
+			})
+
+			got, err := o.RemoveBinding(tt.bindingName, obj)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BindingClient.RemoveBinding() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			assertComponentNames(t, got, tt.wantComponents)
+		})
+	}
+}
+
+func getKubernetesComponent(name string, inlined string) v1alpha2.Component {
+	return v1alpha2.Component{
+		Name: name,
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Kubernetes: &v1alpha2.KubernetesComponent{
+				K8sLikeComponent: v1alpha2.K8sLikeComponent{
+					K8sLikeComponentLocation: v1alpha2.K8sLikeComponentLocation{
+						Inlined: inlined,
+					},
+				},
+			},
+		},
+	}
+}
+
+func assertComponentNames(t *testing.T, obj parser.DevfileObj, want []string) {
+	components, err := obj.Data.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range components {
+		got = append(got, c.Name)
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("components = %v, want %v", got, want)
+	}
+}
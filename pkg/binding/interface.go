@@ -0,0 +1,48 @@
+// Package binding provides methods to bind a component to a service.
+// The binding is written in the devfile as a ServiceBinding inlined in a Kubernetes component,
+// and is applied on the cluster by `odo dev`.
+// Most of the methods of the package get a `flags` parameter
+// representing the flags passed from the user through the command line.
+// Several backends are available to complete the operations, the backend
+// being chosen depending on the flags content:
+// - if no flags are passed, the `interactive` backend will be used
+// - if some flags are passed, the `flags` backend will be used.
+package binding
+
+import (
+	"github.com/devfile/library/pkg/devfile/parser"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type Client interface {
+	// GetFlags gets the flag specific to add binding operation so that it can correctly decide on the backend to be used
+	// It ignores all the flags except the ones specific to add binding operation, for e.g. verbosity flag
+	GetFlags(flags map[string]string) map[string]string
+	// Validate calls Validate method of the adequate backend
+	Validate(flags map[string]string) error
+
+	// GetServiceInstances returns the bindable service instances of the current namespace, indexed by <kind>/<name>
+	GetServiceInstances() (map[string]unstructured.Unstructured, error)
+	// SelectServiceInstance returns the key of the service instance to bind to, from the map returned by GetServiceInstances
+	SelectServiceInstance(flags map[string]string, serviceMap map[string]unstructured.Unstructured) (string, error)
+	// AskBindingName returns the name of the ServiceBinding, defaulting to <component>-<service>
+	AskBindingName(serviceName, componentName string, flags map[string]string) (string, error)
+	// AskBindAsFiles returns true if the binding information should be injected as files into the component
+	AskBindAsFiles(flags map[string]string) (bool, error)
+	// AskNamingStrategy returns the naming strategy of the binding information, or an empty string for the default strategy
+	AskNamingStrategy(flags map[string]string) (string, error)
+
+	// AddBinding adds a ServiceBinding between the component and the service instance to the devfile,
+	// as a Kubernetes component. The devfile is not written to disk
+	AddBinding(bindingName string, bindAsFiles bool, namingStrategy string, serviceInstance unstructured.Unstructured, obj parser.DevfileObj, appName string) (parser.DevfileObj, error)
+	// RemoveBinding removes the Kubernetes component containing the ServiceBinding named bindingName from the devfile.
+	// The devfile is not written to disk
+	RemoveBinding(bindingName string, obj parser.DevfileObj) (parser.DevfileObj, error)
+
+	// ListServices returns the Operator backed service instances of the current namespace, or of all namespaces,
+	// with the components bound to them. The bindings defined in devfileObj, if not nil, are taken into account
+	ListServices(devfileObj *parser.DevfileObj, allNamespaces bool) ([]ServiceInstance, error)
+	// ListBindings returns the bindings of the current namespace, or of all namespaces,
+	// including the bindings defined in devfileObj, if not nil, and not yet created on the cluster
+	ListBindings(devfileObj *parser.DevfileObj, allNamespaces bool) ([]ServiceBinding, error)
+}
@@ -0,0 +1,338 @@
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+
+	sboApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/service"
+)
+
+// ListServices returns the instances of the Operator backed services of the current namespace, or of all the namespaces,
+// with the components bound to them.
+// The bindings defined in the devfile, if not nil, are considered for the current namespace
+func (o *BindingClient) ListServices(devfileObj *parser.DevfileObj, allNamespaces bool) ([]ServiceInstance, error) {
+	bindings, err := o.ListBindings(devfileObj, allNamespaces)
+	if err != nil {
+		return nil, err
+	}
+	boundComponents := map[string][]string{}
+	for _, binding := range bindings {
+		key := binding.Namespace + "/" + binding.Service
+		boundComponents[key] = appendUnique(boundComponents[key], binding.Component)
+	}
+
+	var result []ServiceInstance
+	err = o.forEachNamespace(allNamespaces, func(namespace string) error {
+		csvSupport, err := o.kubernetesClient.IsCSVSupported()
+		if err != nil {
+			return err
+		}
+		if !csvSupport {
+			return nil
+		}
+		services, failed, err := service.ListOperatorServicesWithOperator(o.kubernetesClient)
+		if err != nil {
+			return err
+		}
+		for _, f := range failed {
+			klog.V(2).Infof("unable to list the instances of %s in namespace %q", f, namespace)
+		}
+		for _, svc := range services {
+			instance := svc.Instance
+			if instance.GetKind() == serviceBindingKind {
+				continue
+			}
+			kindName := instance.GetKind() + "/" + instance.GetName()
+			components := boundComponents[namespace+"/"+kindName]
+			sort.Strings(components)
+			result = append(result, ServiceInstance{
+				Name:            instance.GetName(),
+				Kind:            instance.GetKind(),
+				APIVersion:      instance.GetAPIVersion(),
+				Namespace:       namespace,
+				Operator:        svc.Operator,
+				BoundComponents: components,
+				Status:          getStatus(instance),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		if result[i].Kind != result[j].Kind {
+			return result[i].Kind < result[j].Kind
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// ListBindings returns the bindings of the current namespace, or of all the namespaces.
+// The bindings are created on the cluster either by the Service Binding Operator, or by odo without the operator.
+// The bindings defined in the devfile, if not nil, are also returned for the current namespace, even if they are not created on the cluster yet
+func (o *BindingClient) ListBindings(devfileObj *parser.DevfileObj, allNamespaces bool) ([]ServiceBinding, error) {
+	currentNamespace := o.kubernetesClient.GetCurrentNamespace()
+
+	var devfileBindings []devfileBinding
+	if devfileObj != nil {
+		var err error
+		devfileBindings, err = getDevfileBindings(*devfileObj, currentNamespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var result []ServiceBinding
+	err := o.forEachNamespace(allNamespaces, func(namespace string) error {
+		clusterBindings, err := o.listClusterBindings(namespace)
+		if err != nil {
+			return err
+		}
+		result = append(result, clusterBindings...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// complete the bindings of the cluster with the information of the devfile, and add the bindings not pushed yet
+	for _, local := range devfileBindings {
+		found := false
+		for i := range result {
+			if !local.matches(result[i]) {
+				continue
+			}
+			found = true
+			result[i].InDevfile = true
+			// the binding mode is not known for the bindings created without the Service Binding Operator
+			if result[i].BindingMode == "" {
+				result[i].BindingMode = local.BindingMode
+				result[i].NamingStrategy = local.NamingStrategy
+			}
+		}
+		if !found {
+			result = append(result, local.ServiceBinding)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		if result[i].Component != result[j].Component {
+			return result[i].Component < result[j].Component
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// forEachNamespace calls fn for the current namespace, or for each namespace of the cluster if allNamespaces is true.
+// The namespaces the user does not have access to are ignored
+func (o *BindingClient) forEachNamespace(allNamespaces bool, fn func(namespace string) error) error {
+	currentNamespace := o.kubernetesClient.GetCurrentNamespace()
+	if !allNamespaces {
+		return fn(currentNamespace)
+	}
+
+	namespaces, err := o.kubernetesClient.GetNamespaces()
+	if err != nil {
+		return fmt.Errorf("unable to list the namespaces: %w", err)
+	}
+	defer o.kubernetesClient.SetNamespace(currentNamespace)
+	for _, namespace := range namespaces {
+		o.kubernetesClient.SetNamespace(namespace)
+		err = fn(namespace)
+		if err != nil {
+			klog.V(2).Infof("ignoring namespace %q: %s", namespace, err)
+		}
+	}
+	return nil
+}
+
+// listClusterBindings returns the bindings created on the cluster in the current namespace
+func (o *BindingClient) listClusterBindings(namespace string) ([]ServiceBinding, error) {
+	var result []ServiceBinding
+
+	// bindings created by the Service Binding Operator
+	sboSupport, err := o.kubernetesClient.IsServiceBindingSupported()
+	if err != nil {
+		return nil, err
+	}
+	if sboSupport {
+		list, err := o.kubernetesClient.ListDynamicResources(sboApi.GroupVersionResource)
+		if err != nil {
+			return nil, err
+		}
+		if list != nil {
+			for _, u := range list.Items {
+				sb, err := toServiceBinding(u)
+				if err != nil {
+					return nil, err
+				}
+				binding := newServiceBinding(sb, namespace)
+				if component, ok := u.GetLabels()[componentlabels.KubernetesInstanceLabel]; ok {
+					binding.Component = component
+				}
+				binding.Status = getStatus(u)
+				result = append(result, binding)
+			}
+		}
+	}
+
+	// bindings created by odo without the Service Binding Operator, materialized by a secret
+	secrets, err := o.kubernetesClient.ListSecrets(service.LinkLabel)
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets {
+		labels := secret.GetLabels()
+		kind := labels[service.ServiceKind]
+		name := labels[service.ServiceLabel]
+		if kind != "" && kind != "Service" {
+			// the service name is stored as kind-name
+			name = strings.TrimPrefix(name, kind+"-")
+		}
+		result = append(result, ServiceBinding{
+			Name:      labels[service.LinkLabel],
+			Namespace: namespace,
+			Component: labels[componentlabels.KubernetesInstanceLabel],
+			Service:   kind + "/" + name,
+			Status:    StatusReady,
+		})
+	}
+	return result, nil
+}
+
+// devfileBinding is a binding defined in the devfile, inlined in the Kubernetes component componentName
+type devfileBinding struct {
+	ServiceBinding
+	componentName string
+}
+
+// matches returns true if the binding created on the cluster has been created from the binding of the devfile.
+// The Service Binding Operator uses the name of the ServiceBinding resource,
+// when odo, without the operator, uses the name of the devfile component
+func (o devfileBinding) matches(binding ServiceBinding) bool {
+	return binding.Namespace == o.Namespace &&
+		binding.Component == o.Component &&
+		(binding.Name == o.Name || binding.Name == o.componentName)
+}
+
+// getDevfileBindings returns the bindings defined in the devfile
+func getDevfileBindings(devfileObj parser.DevfileObj, namespace string) ([]devfileBinding, error) {
+	components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.KubernetesComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result []devfileBinding
+	for _, component := range components {
+		if libdevfile.IsK8sComponentRendered(component.Kubernetes, filepath.Dir(devfileObj.Ctx.GetAbsPath()), devfilefs.DefaultFs{}) {
+			// the bindings are not defined in Helm charts or Kustomize directories
+			continue
+		}
+		u, err := libdevfile.GetK8sComponentAsUnstructured(component.Kubernetes, filepath.Dir(devfileObj.Ctx.GetAbsPath()), devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		if u.GetKind() != serviceBindingKind {
+			continue
+		}
+		sb, err := toServiceBinding(u)
+		if err != nil {
+			return nil, err
+		}
+		binding := newServiceBinding(sb, namespace)
+		binding.Component = devfileObj.GetMetadataName()
+		binding.Status = StatusNotPushed
+		binding.InDevfile = true
+		result = append(result, devfileBinding{
+			ServiceBinding: binding,
+			componentName:  component.Name,
+		})
+	}
+	return result, nil
+}
+
+func toServiceBinding(u unstructured.Unstructured) (sboApi.ServiceBinding, error) {
+	var sb sboApi.ServiceBinding
+	js, err := u.MarshalJSON()
+	if err != nil {
+		return sb, err
+	}
+	err = json.Unmarshal(js, &sb)
+	return sb, err
+}
+
+func newServiceBinding(sb sboApi.ServiceBinding, namespace string) ServiceBinding {
+	mode := BindingModeEnv
+	if sb.Spec.BindAsFiles {
+		mode = BindingModeFiles
+	}
+	var services []string
+	for _, svc := range sb.Spec.Services {
+		services = append(services, svc.Kind+"/"+svc.Name)
+	}
+	return ServiceBinding{
+		Name:           sb.Name,
+		Namespace:      namespace,
+		Component:      sb.Spec.Application.Name,
+		Service:        strings.Join(services, ","),
+		BindingMode:    mode,
+		NamingStrategy: sb.Spec.NamingStrategy,
+	}
+}
+
+// getStatus returns the health of a resource, based on its Ready condition, or on its phase
+func getStatus(u unstructured.Unstructured) string {
+	conditions, found, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if found {
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok || (condition["type"] != "Ready" && condition["type"] != "Available") {
+				continue
+			}
+			if condition["status"] == "True" {
+				return StatusReady
+			}
+			return StatusNotReady
+		}
+	}
+	for _, field := range []string{"phase", "state", "status"} {
+		if value, found, _ := unstructured.NestedString(u.Object, "status", field); found && value != "" {
+			return value
+		}
+	}
+	return StatusUnknown
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
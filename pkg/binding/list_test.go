@@ -0,0 +1,194 @@
+package binding
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/ghodss/yaml"
+	"github.com/golang/mock/gomock"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	sboApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/service"
+	odoTestingUtil "github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+func getClusterServiceBinding(t *testing.T, name string, component string, ready bool) unstructured.Unstructured {
+	inlined, err := getServiceBindingYAML(name, true, "", getServiceInstance(), component+"-app", metav1.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var u unstructured.Unstructured
+	err = yaml.Unmarshal([]byte(inlined), &u.Object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u.SetLabels(map[string]string{componentlabels.KubernetesInstanceLabel: component})
+	status := "False"
+	if ready {
+		status = "True"
+	}
+	_ = unstructured.SetNestedSlice(u.Object, []interface{}{
+		map[string]interface{}{"type": "Ready", "status": status},
+	}, "status", "conditions")
+	return u
+}
+
+func TestBindingClient_ListBindings(t *testing.T) {
+	tests := []struct {
+		name           string
+		sboSupported   bool
+		clusterSBs     []unstructured.Unstructured
+		secrets        []corev1.Secret
+		devfileBinding bool
+		want           []ServiceBinding
+	}{
+		{
+			name:         "binding created by the Service Binding Operator",
+			sboSupported: true,
+			clusterSBs:   []unstructured.Unstructured{getClusterServiceBinding(t, "my-nodejs-app-myredis", "my-nodejs-app", true)},
+			want: []ServiceBinding{{
+				Name:        "my-nodejs-app-myredis",
+				Namespace:   "project",
+				Component:   "my-nodejs-app",
+				Service:     "Redis/myredis",
+				BindingMode: BindingModeFiles,
+				Status:      StatusReady,
+			}},
+		},
+		{
+			name: "binding created without the Service Binding Operator, and defined in the devfile",
+			secrets: []corev1.Secret{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-secret",
+					Labels: map[string]string{
+						service.LinkLabel:                       "redis-binding",
+						service.ServiceLabel:                    "Redis-myredis",
+						service.ServiceKind:                     "Redis",
+						componentlabels.KubernetesInstanceLabel: "my-nodejs-app",
+					},
+				},
+			}},
+			devfileBinding: true,
+			want: []ServiceBinding{{
+				Name:        "redis-binding",
+				Namespace:   "project",
+				Component:   "my-nodejs-app",
+				Service:     "Redis/myredis",
+				BindingMode: BindingModeFiles,
+				Status:      StatusReady,
+				InDevfile:   true,
+			}},
+		},
+		{
+			name:           "binding defined in the devfile only",
+			sboSupported:   true,
+			devfileBinding: true,
+			want: []ServiceBinding{{
+				Name:        "redis-binding-resource",
+				Namespace:   "project",
+				Component:   "my-nodejs-app",
+				Service:     "Redis/myredis",
+				BindingMode: BindingModeFiles,
+				Status:      StatusNotPushed,
+				InDevfile:   true,
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+			kubeClient.EXPECT().GetCurrentNamespace().Return("project").AnyTimes()
+			kubeClient.EXPECT().IsServiceBindingSupported().Return(tt.sboSupported, nil)
+			if tt.sboSupported {
+				kubeClient.EXPECT().ListDynamicResources(sboApi.GroupVersionResource).Return(&unstructured.UnstructuredList{Items: tt.clusterSBs}, nil)
+			}
+			kubeClient.EXPECT().ListSecrets(service.LinkLabel).Return(tt.secrets, nil)
+
+			obj := odoTestingUtil.GetTestDevfileObj(devfilefs.NewFakeFs())
+			metadata := obj.Data.GetMetadata()
+			metadata.Name = "my-nodejs-app"
+			obj.Data.SetMetadata(metadata)
+			if tt.devfileBinding {
+				inlined, err := getServiceBindingYAML("redis-binding-resource", true, "", getServiceInstance(), "my-nodejs-app-app", metav1.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				_ = obj.Data.AddComponents([]v1alpha2.Component{getKubernetesComponent("redis-binding", inlined)})
+			}
+
+			o := &BindingClient{kubernetesClient: kubeClient}
+			got, err := o.ListBindings(&obj, false)
+			if err != nil {
+				t.Fatalf("BindingClient.ListBindings() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BindingClient.ListBindings() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBindingClient_ListServices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().GetCurrentNamespace().Return("project").AnyTimes()
+	kubeClient.EXPECT().GetNamespaces().Return([]string{"project", "other"}, nil).Times(2)
+	kubeClient.EXPECT().SetNamespace(gomock.Any()).AnyTimes()
+
+	// bindings
+	kubeClient.EXPECT().IsServiceBindingSupported().Return(true, nil).Times(2)
+	kubeClient.EXPECT().ListDynamicResources(sboApi.GroupVersionResource).Return(&unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{getClusterServiceBinding(t, "my-nodejs-app-myredis", "my-nodejs-app", true)},
+	}, nil)
+	kubeClient.EXPECT().ListDynamicResources(sboApi.GroupVersionResource).Return(&unstructured.UnstructuredList{}, nil)
+	kubeClient.EXPECT().ListSecrets(service.LinkLabel).Return(nil, nil).Times(2)
+
+	// services
+	redis := getServiceInstance()
+	_ = unstructured.SetNestedField(redis.Object, "Running", "status", "phase")
+	crd := olm.CRDDescription{Name: "redis.redis.redis.opstreelabs.in", Kind: "Redis", Version: "v1beta1"}
+	csv := olm.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: "redis-operator.v0.8.0"}}
+	kubeClient.EXPECT().IsCSVSupported().Return(true, nil).Times(2)
+	kubeClient.EXPECT().ListClusterServiceVersions().Return(&olm.ClusterServiceVersionList{Items: []olm.ClusterServiceVersion{csv}}, nil).Times(2)
+	kubeClient.EXPECT().GetCustomResourcesFromCSV(gomock.Any()).Return(&[]olm.CRDDescription{crd}).Times(2)
+	kubeClient.EXPECT().ListDynamicResources(kclient.GetGVRFromCR(&crd)).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{redis}}, nil)
+	kubeClient.EXPECT().ListDynamicResources(kclient.GetGVRFromCR(&crd)).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{getServiceInstance()}}, nil)
+
+	o := &BindingClient{kubernetesClient: kubeClient}
+	got, err := o.ListServices(nil, true)
+	if err != nil {
+		t.Fatalf("BindingClient.ListServices() error = %v", err)
+	}
+	want := []ServiceInstance{
+		{
+			Name:       "myredis",
+			Kind:       "Redis",
+			APIVersion: "redis.redis.opstreelabs.in/v1beta1",
+			Namespace:  "other",
+			Operator:   "redis-operator.v0.8.0",
+			Status:     StatusUnknown,
+		},
+		{
+			Name:            "myredis",
+			Kind:            "Redis",
+			APIVersion:      "redis.redis.opstreelabs.in/v1beta1",
+			Namespace:       "project",
+			Operator:        "redis-operator.v0.8.0",
+			BoundComponents: []string{"my-nodejs-app"},
+			Status:          "Running",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BindingClient.ListServices() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,42 @@
+package binding
+
+const (
+	// StatusReady indicates the service or the binding is ready
+	StatusReady = "Ready"
+	// StatusNotReady indicates the service or the binding is not ready
+	StatusNotReady = "NotReady"
+	// StatusNotPushed indicates the binding is defined in the devfile, but not created on the cluster yet
+	StatusNotPushed = "NotPushed"
+	// StatusUnknown indicates the health of the service or binding cannot be determined
+	StatusUnknown = "Unknown"
+
+	// BindingModeFiles indicates the binding information is injected as files
+	BindingModeFiles = "files"
+	// BindingModeEnv indicates the binding information is injected as environment variables
+	BindingModeEnv = "env"
+)
+
+// ServiceInstance describes an instance of an Operator backed service, and the components bound to it
+type ServiceInstance struct {
+	Name            string   `json:"name"`
+	Kind            string   `json:"kind"`
+	APIVersion      string   `json:"apiVersion"`
+	Namespace       string   `json:"namespace"`
+	Operator        string   `json:"operator"`
+	BoundComponents []string `json:"boundComponents,omitempty"`
+	Status          string   `json:"status"`
+}
+
+// ServiceBinding describes a binding between a component and a service
+type ServiceBinding struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Component string `json:"component"`
+	// Service is the service bound to the component, with the format <kind>/<name>
+	Service        string `json:"service"`
+	BindingMode    string `json:"bindingMode"`
+	NamingStrategy string `json:"namingStrategy,omitempty"`
+	Status         string `json:"status"`
+	// InDevfile is true if the binding is defined in the devfile of the current directory
+	InDevfile bool `json:"inDevfile"`
+}
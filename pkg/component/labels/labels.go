@@ -29,6 +29,15 @@ const OdoModeLabel = "odo.dev/mode"
 // OdoProjectTypeAnnotation ...
 const OdoProjectTypeAnnotation = "odo.dev/project-type"
 
+// OdoDevfileComponentAnnotation is the name of the Kubernetes component of the devfile defining a resource created by odo deploy
+const OdoDevfileComponentAnnotation = "odo.dev/devfile-component"
+
+// OdoDeployCommandAnnotation is the name of the Deploy command of the devfile that created a resource with odo deploy
+const OdoDeployCommandAnnotation = "odo.dev/deploy-command"
+
+// OdoDeployRevisionLabel is the number of the revision recorded by odo deploy in a Secret
+const OdoDeployRevisionLabel = "odo.dev/deploy-revision"
+
 // GetLabels return labels that should be applied to every object for given component in active application
 // additional labels are used only for creating object
 // if you are creating something use additional=true
@@ -3,15 +3,21 @@ package deploy
 import (
 	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/component"
+	_delete "github.com/redhat-developer/odo/pkg/component/delete"
 	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
@@ -20,18 +26,79 @@ import (
 )
 
 type DeployClient struct {
-	kubeClient kclient.ClientInterface
+	kubeClient   kclient.ClientInterface
+	deleteClient _delete.Client
 }
 
-func NewDeployClient(kubeClient kclient.ClientInterface) *DeployClient {
+func NewDeployClient(kubeClient kclient.ClientInterface, deleteClient _delete.Client) *DeployClient {
 	return &DeployClient{
-		kubeClient: kubeClient,
+		kubeClient:   kubeClient,
+		deleteClient: deleteClient,
 	}
 }
 
-func (o *DeployClient) Deploy(devfileObj parser.DevfileObj, path string, appName string) error {
+func (o *DeployClient) Deploy(devfileObj parser.DevfileObj, path string, appName string, options Options) error {
 	deployHandler := newDeployHandler(devfileObj, path, o.kubeClient, appName)
-	return libdevfile.Deploy(devfileObj, deployHandler)
+	deployHandler.tagStrategy = options.TagStrategy
+	deployHandler.buildOptions = options.BuildOptions
+	err := deployHandler.setCommand(options.CommandName)
+	if err != nil {
+		return err
+	}
+	err = deployHandler.applyServices()
+	if err != nil {
+		return err
+	}
+	err = libdevfile.Deploy(devfileObj, deployHandler.command, deployHandler)
+	if err != nil {
+		return err
+	}
+	err = deployHandler.applyLinks()
+	if err != nil {
+		return err
+	}
+	if options.Prune {
+		err = o.prune(deployHandler)
+		if err != nil {
+			return err
+		}
+	}
+	return o.waitAndRecordRevision(deployHandler, getCurrentRevision(deployHandler), options.WaitTimeout)
+}
+
+// waitForResources waits for the resources applied by the handler to be ready, if waitTimeout is not zero
+func (o *DeployClient) waitForResources(handler *deployHandler, waitTimeout time.Duration) error {
+	if waitTimeout == 0 || len(handler.appliedResources) == 0 {
+		return nil
+	}
+	log.Section("Waiting for the resources to be ready")
+	selector := componentlabels.GetSelector(handler.devfileObj.Data.GetMetadata().Name, handler.appName)
+	return o.kubeClient.WaitForResourcesReady(handler.appliedResources, selector, waitTimeout)
+}
+
+func (o *DeployClient) DryRun(devfileObj parser.DevfileObj, path string, appName string, options Options, out io.Writer) error {
+	deployHandler := newDeployHandler(devfileObj, path, o.kubeClient, appName)
+	deployHandler.tagStrategy = options.TagStrategy
+	deployHandler.buildOptions = options.BuildOptions
+	deployHandler.dryRun = true
+	deployHandler.out = out
+	err := deployHandler.setCommand(options.CommandName)
+	if err != nil {
+		return err
+	}
+	err = deployHandler.applyServices()
+	if err != nil {
+		return err
+	}
+	err = libdevfile.Deploy(devfileObj, deployHandler.command, deployHandler)
+	if err != nil {
+		return err
+	}
+	err = deployHandler.previewLinks()
+	if err != nil || !options.Prune {
+		return err
+	}
+	return o.previewPrune(deployHandler)
 }
 
 type deployHandler struct {
@@ -39,41 +106,120 @@ type deployHandler struct {
 	path       string
 	kubeClient kclient.ClientInterface
 	appName    string
+	// command is the name of the Deploy command run by the handler
+	command string
+	// deployments are the names of the Deployments applied by the deploy command
+	deployments []string
+	// applied are the keys of the resources applied from the Kubernetes components, see getResourceKey
+	applied map[string]bool
+	// appliedResources are the resources applied from the Kubernetes components, with the labels and annotations injected
+	appliedResources []unstructured.Unstructured
+	// images are the images built and pushed
+	images []RevisionImage
+	// tagStrategy defines the tags of the images built
+	tagStrategy image.TagStrategy
+	// buildOptions are the options of the builds of the images
+	buildOptions image.BuildOptions
+	// pinnedImages are the references to the images built, by digest when known, indexed by the names of the image components
+	pinnedImages map[string]string
+	// dryRun is true when the changes are only previewed and written to out, without building images or modifying the cluster
+	dryRun bool
+	out    io.Writer
 }
 
 func newDeployHandler(devfileObj parser.DevfileObj, path string, kubeClient kclient.ClientInterface, appName string) *deployHandler {
 	return &deployHandler{
-		devfileObj: devfileObj,
-		path:       path,
-		kubeClient: kubeClient,
-		appName:    appName,
+		devfileObj:   devfileObj,
+		path:         path,
+		kubeClient:   kubeClient,
+		appName:      appName,
+		applied:      map[string]bool{},
+		pinnedImages: map[string]string{},
+	}
+}
+
+// setCommand sets the Deploy command run by the handler, the default Deploy command if commandName is empty
+func (o *deployHandler) setCommand(commandName string) error {
+	command, err := libdevfile.GetDeployCommand(o.devfileObj, commandName)
+	if err != nil {
+		return err
 	}
+	o.command = command.Id
+	return nil
 }
 
-// ApplyImage builds and pushes the OCI image to be used on Kubernetes
+// ApplyImage builds and pushes the OCI image to be used on Kubernetes, tagged with the tag strategy of the handler.
+// The Kubernetes components applied afterwards reference the image pushed by its digest
 func (o *deployHandler) ApplyImage(img v1alpha2.Component) error {
-	return image.BuildPushSpecificImage(o.devfileObj, o.path, img, true)
+	if img.Image == nil {
+		return fmt.Errorf("component %q is not an image component", img.Name)
+	}
+	imageName, err := image.GetTaggedImageName(o.tagStrategy, img.Image, o.path)
+	if err != nil {
+		return err
+	}
+	if o.dryRun {
+		o.pinnedImages[img.Name] = imageName
+		return o.previewImage(img, imageName)
+	}
+
+	tagged := *img.DeepCopy()
+	tagged.Image.ImageName = imageName
+	err = image.BuildPushSpecificImage(o.devfileObj, o.path, tagged, true, o.buildOptions)
+	if err != nil {
+		return err
+	}
+	digest, err := image.GetPushedImageDigest(imageName)
+	if err != nil {
+		log.Warningf("Unable to get the digest of the image %s, the image is referenced by its tag: %v", imageName, err)
+		o.pinnedImages[img.Name] = imageName
+	} else {
+		o.pinnedImages[img.Name] = image.GetImageRepository(imageName) + "@" + digest
+	}
+	o.images = append(o.images, RevisionImage{Name: imageName, Digest: digest})
+	return nil
 }
 
-// ApplyKubernetes applies inline Kubernetes YAML from the devfile.yaml file
+// ApplyKubernetes applies inline Kubernetes YAML from the devfile.yaml file,
+// or the resources rendered from the Helm chart or Kustomize directory referenced by the component
 func (o *deployHandler) ApplyKubernetes(kubernetes v1alpha2.Component) error {
+	components, err := o.renderK8sComponents([]v1alpha2.Component{kubernetes})
+	if err != nil {
+		return err
+	}
+	for _, component := range components {
+		err = o.applyK8sComponent(component)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyK8sComponent applies the resource of a Kubernetes component, inlined or referenced by its URI
+func (o *deployHandler) applyK8sComponent(kubernetes v1alpha2.Component) error {
+	kubernetes, err := o.pinImages(kubernetes)
+	if err != nil {
+		return err
+	}
 
 	// Validate if the GVRs represented by Kubernetes inlined components are supported by the underlying cluster
-	_, err := service.ValidateResourceExist(o.kubeClient, kubernetes, o.path)
+	_, err = service.ValidateResourceExist(o.kubeClient, kubernetes, o.path)
 	if err != nil {
 		return err
 	}
 
-	// Get the most common labels that's applicable to all resources being deployed.
-	// Set the mode to DEPLOY. Regardless of what Kubernetes resource we are deploying.
-	labels := componentlabels.GetLabels(o.devfileObj.Data.GetMetadata().Name, o.appName, true)
-	labels[componentlabels.OdoModeLabel] = componentlabels.ComponentDeployName
+	labels := o.getLabels()
 	klog.V(4).Infof("Injecting labels: %+v into k8s artifact", labels)
 
 	// Create the annotations
 	// Retrieve the component type from the devfile and also inject it into the list of annotations
 	annotations := make(map[string]string)
 	annotations[componentlabels.OdoProjectTypeAnnotation] = component.GetComponentTypeFromDevfileMetadata(o.devfileObj.Data.GetMetadata())
+	// The annotation identifies the resources to prune when the component is removed from the devfile
+	annotations[componentlabels.OdoDevfileComponentAnnotation] = kubernetes.Name
+	// The annotation restricts the pruning to the resources deployed by the same Deploy command
+	annotations[componentlabels.OdoDeployCommandAnnotation] = o.command
 
 	// Get the Kubernetes component
 	u, err := libdevfile.GetK8sComponentAsUnstructured(kubernetes.Kubernetes, o.path, devfilefs.DefaultFs{})
@@ -81,6 +227,15 @@ func (o *deployHandler) ApplyKubernetes(kubernetes v1alpha2.Component) error {
 		return err
 	}
 
+	// The bindings are applied after all the Deployments, see applyLinks
+	if service.IsLinkResource(u.GetKind()) {
+		return nil
+	}
+
+	if o.dryRun {
+		return o.previewKubernetes(u, labels, annotations)
+	}
+
 	// Deploy the actual Kubernetes component and error out if there's an issue.
 	log.Sectionf("Deploying Kubernetes Component: %s", u.GetName())
 	isOperatorBackedService, err := service.PushKubernetesResource(o.kubeClient, u, labels, annotations)
@@ -88,13 +243,134 @@ func (o *deployHandler) ApplyKubernetes(kubernetes v1alpha2.Component) error {
 		return fmt.Errorf("failed to create service(s) associated with the component: %w", err)
 	}
 
+	o.applied[getResourceKey(u)] = true
+	service.SetLabelsAndAnnotations(&u, labels, annotations)
+	o.appliedResources = append(o.appliedResources, u)
+	if u.GetKind() == kclient.DeploymentKind {
+		o.deployments = append(o.deployments, u.GetName())
+	}
+
 	if isOperatorBackedService {
-		log.Successf("Kubernetes resource %q on the cluster; refer %q to know how to link it to the component", strings.Join([]string{u.GetKind(), u.GetName()}, "/"), "odo link -h")
+		log.Successf("Kubernetes resource %q on the cluster; refer %q to know how to bind it to the component", strings.Join([]string{u.GetKind(), u.GetName()}, "/"), "odo add binding -h")
 
 	}
 	return nil
 }
 
+// applyServices applies the Operator backed services defined in the Kubernetes components
+// not referenced by any command, as they are also created by `odo dev`
+func (o *deployHandler) applyServices() error {
+	k8sComponents, err := devfile.GetKubernetesComponentsToPush(o.devfileObj)
+	if err != nil {
+		return fmt.Errorf("error while trying to fetch service(s) from devfile: %w", err)
+	}
+	k8sComponents, err = o.renderK8sComponents(k8sComponents)
+	if err != nil {
+		return err
+	}
+	for _, component := range k8sComponents {
+		u, err := libdevfile.GetK8sComponentAsUnstructured(component.Kubernetes, o.path, devfilefs.DefaultFs{})
+		if err != nil {
+			return err
+		}
+		if service.IsLinkResource(u.GetKind()) {
+			continue
+		}
+		isOperatorBackedService, err := service.IsOperatorBackedService(o.kubeClient, u)
+		if err != nil || !isOperatorBackedService {
+			// the resource is not a service, or it is not supported by the cluster
+			klog.V(4).Infof("skipping Kubernetes component %q: not an Operator backed service", component.Name)
+			continue
+		}
+		err = o.applyK8sComponent(component)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyLinks binds the Deployments applied by the deploy command to the services
+// of the ServiceBinding Kubernetes components, as `odo dev` does for the component
+func (o *deployHandler) applyLinks() error {
+	links, err := o.getLinks()
+	if err != nil {
+		return err
+	}
+	return service.PushDeployLinks(o.kubeClient, links, o.getLabels(), o.deployments)
+}
+
+// getLinks returns the ServiceBinding Kubernetes components of the devfile
+func (o *deployHandler) getLinks() ([]service.DeployLink, error) {
+	k8sComponents, err := o.devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.KubernetesComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	k8sComponents, err = o.renderK8sComponents(k8sComponents)
+	if err != nil {
+		return nil, err
+	}
+	return service.GetDeployLinks(k8sComponents, o.path)
+}
+
+// renderK8sComponents replaces the Kubernetes components referencing a Helm chart or a Kustomize directory
+// by the resources rendered, with the values computed by odo for the current namespace
+func (o *deployHandler) renderK8sComponents(components []v1alpha2.Component) ([]v1alpha2.Component, error) {
+	values, err := libdevfile.GetK8sRenderValues(o.devfileObj, o.kubeClient.GetCurrentNamespace())
+	if err != nil {
+		return nil, err
+	}
+	for name, pinned := range o.pinnedImages {
+		values.Images[name] = pinned
+	}
+	return libdevfile.RenderK8sComponents(components, o.path, devfilefs.DefaultFs{}, values)
+}
+
+// pinImages returns the component with the references {{<image component name>}} in its manifest
+// replaced by the references to the images built by the handler, or by the names of the images
+// of the image components not built by the Deploy command
+func (o *deployHandler) pinImages(kubernetes v1alpha2.Component) (v1alpha2.Component, error) {
+	imageComponents, err := o.devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.ImageComponentType},
+	})
+	if err != nil {
+		return kubernetes, err
+	}
+	if len(imageComponents) == 0 {
+		return kubernetes, nil
+	}
+	manifest, err := libdevfile.GetK8sComponentManifest(kubernetes.Kubernetes, o.path, devfilefs.DefaultFs{})
+	if err != nil {
+		return kubernetes, err
+	}
+	pinned := manifest
+	for _, component := range imageComponents {
+		reference, ok := o.pinnedImages[component.Name]
+		if !ok {
+			reference = component.Image.ImageName
+		}
+		pinned = strings.ReplaceAll(pinned, "{{"+component.Name+"}}", reference)
+	}
+	if pinned == manifest {
+		return kubernetes, nil
+	}
+	result := *kubernetes.DeepCopy()
+	result.Kubernetes.Uri = ""
+	result.Kubernetes.Inlined = pinned
+	return result, nil
+}
+
+// getLabels returns the labels of the resources deployed by the deploy command
+func (o *deployHandler) getLabels() map[string]string {
+	// Get the most common labels that's applicable to all resources being deployed.
+	// Set the mode to DEPLOY. Regardless of what Kubernetes resource we are deploying.
+	labels := componentlabels.GetLabels(o.devfileObj.Data.GetMetadata().Name, o.appName, true)
+	labels[componentlabels.OdoModeLabel] = componentlabels.ComponentDeployName
+	return labels
+}
+
 // Execute will deploy the listed information in the `exec` section of devfile.yaml
 // We currently do NOT support this in `odo deploy`.
 func (o *deployHandler) Execute(command v1alpha2.Command) error {
@@ -0,0 +1,82 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/testingutil/filesystem"
+
+	odoTestingUtil "github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+func getImageComponent(name string, imageName string) v1alpha2.Component {
+	return v1alpha2.Component{
+		Name: name,
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Image: &v1alpha2.ImageComponent{
+				Image: v1alpha2.Image{
+					ImageName: imageName,
+				},
+			},
+		},
+	}
+}
+
+func TestDeployHandler_pinImages(t *testing.T) {
+	getComponent := func(inlined string) v1alpha2.Component {
+		return v1alpha2.Component{
+			Name: "deployment",
+			ComponentUnion: v1alpha2.ComponentUnion{
+				Kubernetes: &v1alpha2.KubernetesComponent{
+					K8sLikeComponent: v1alpha2.K8sLikeComponent{
+						K8sLikeComponentLocation: v1alpha2.K8sLikeComponentLocation{Inlined: inlined},
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name   string
+		pinned map[string]string
+		in     string
+		want   string
+	}{
+		{
+			name: "no image built",
+			in:   "image: {{prod-image}}",
+			want: "image: quay.io/user/image:latest",
+		},
+		{
+			name:   "image built",
+			pinned: map[string]string{"prod-image": "quay.io/user/image@sha256:aaa"},
+			in:     "image: {{prod-image}}\nother: {{other-image}}\nunknown: {{unknown}}",
+			want:   "image: quay.io/user/image@sha256:aaa\nother: quay.io/user/other:v1\nunknown: {{unknown}}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileObj := odoTestingUtil.GetTestDevfileObj(filesystem.NewFakeFs())
+			err := devfileObj.Data.AddComponents([]v1alpha2.Component{
+				getImageComponent("prod-image", "quay.io/user/image:latest"),
+				getImageComponent("other-image", "quay.io/user/other:v1"),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			handler := newDeployHandler(devfileObj, "", nil, "app")
+			for name, reference := range tt.pinned {
+				handler.pinnedImages[name] = reference
+			}
+			got, err := handler.pinImages(getComponent(tt.in))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Kubernetes.Inlined != tt.want {
+				t.Errorf("got %q, want %q", got.Kubernetes.Inlined, tt.want)
+			}
+			if got.Name != "deployment" {
+				t.Errorf("the name of the component changed to %q", got.Name)
+			}
+		})
+	}
+}
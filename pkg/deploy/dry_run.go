@@ -0,0 +1,150 @@
+package deploy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/ghodss/yaml"
+	"github.com/pmezard/go-difflib/difflib"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/service"
+)
+
+// ignoredDiffFields are the fields set by the cluster, not compared when previewing the changes of a resource
+var ignoredDiffFields = [][]string{
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "uid"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "selfLink"},
+	{"status"},
+}
+
+// previewImage lists the image that would be built and pushed by the deploy command, with the name imageName
+func (o *deployHandler) previewImage(img v1alpha2.Component, imageName string) error {
+	if img.Image == nil {
+		return fmt.Errorf("component %q is not an image component", img.Name)
+	}
+	dockerfile := ""
+	if img.Image.Dockerfile != nil {
+		dockerfile = img.Image.Dockerfile.Uri
+	}
+	platforms := ""
+	if len(o.buildOptions.Platforms) > 0 {
+		platforms = " for the platforms " + strings.Join(o.buildOptions.Platforms, ", ")
+	}
+	log.Sectionf("Image %s would be built from %q%s and pushed", imageName, dockerfile, platforms)
+	if len(o.buildOptions.CacheFrom) > 0 {
+		log.Printf("The build cache would be imported from %s", strings.Join(o.buildOptions.CacheFrom, ", "))
+	}
+	if o.buildOptions.CacheTo != "" {
+		log.Printf("The build cache would be exported to %s", o.buildOptions.CacheTo)
+	}
+	return nil
+}
+
+// previewKubernetes runs a server-side apply of the resource in dry-run mode, and writes the differences
+// between the resource in the cluster and the resource as it would be after the apply
+func (o *deployHandler) previewKubernetes(u unstructured.Unstructured, labels map[string]string, annotations map[string]string) error {
+	service.SetLabelsAndAnnotations(&u, labels, annotations)
+
+	log.Sectionf("Kubernetes Component: %s/%s", u.GetKind(), u.GetName())
+	applied, err := o.kubeClient.DryRunApplyDynamicResource(u)
+	if err != nil {
+		return fmt.Errorf("dry-run apply of %s %q failed: %w", u.GetKind(), u.GetName(), err)
+	}
+
+	restMapping, err := o.kubeClient.GetRestMappingFromUnstructured(u)
+	if err != nil {
+		return err
+	}
+	live, err := o.kubeClient.GetDynamicResource(restMapping.Resource, u.GetName())
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+		live = nil
+	}
+
+	diff, err := getResourceDiff(live, applied)
+	if err != nil {
+		return err
+	}
+	if diff == "" {
+		fmt.Fprintln(o.out, "No changes")
+	} else {
+		fmt.Fprint(o.out, diff)
+	}
+
+	o.applied[getResourceKey(u)] = true
+	if u.GetKind() == kclient.DeploymentKind {
+		o.deployments = append(o.deployments, u.GetName())
+	}
+	return nil
+}
+
+// previewLinks lists the ServiceBindings that would be created by the deploy command, with the Deployments they would bind
+func (o *deployHandler) previewLinks() error {
+	links, err := o.getLinks()
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		target, err := service.GetDeployLinkTarget(link, o.deployments)
+		if err != nil {
+			return err
+		}
+		log.Sectionf("ServiceBinding %s would bind the Deployment %q", link.Binding.Name+service.DeployBindingSuffix, target)
+	}
+	return nil
+}
+
+// getResourceDiff returns a unified diff between the live resource and the resource after the apply,
+// or an empty string if they are the same. The live resource is nil if it does not exist yet
+func getResourceDiff(live, applied *unstructured.Unstructured) (string, error) {
+	liveYAML, err := getComparableYAML(live)
+	if err != nil {
+		return "", err
+	}
+	appliedYAML, err := getComparableYAML(applied)
+	if err != nil {
+		return "", err
+	}
+	if liveYAML == appliedYAML {
+		return "", nil
+	}
+	resource := strings.Join([]string{applied.GetKind(), applied.GetName()}, "/")
+	fromFile := "live/" + resource
+	if live == nil {
+		fromFile = "/dev/null"
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYAML),
+		B:        difflib.SplitLines(appliedYAML),
+		FromFile: fromFile,
+		ToFile:   "deploy/" + resource,
+		Context:  3,
+	})
+}
+
+// getComparableYAML returns the YAML definition of the resource, without the fields set by the cluster
+func getComparableYAML(u *unstructured.Unstructured) (string, error) {
+	if u == nil {
+		return "", nil
+	}
+	u = u.DeepCopy()
+	for _, field := range ignoredDiffFields {
+		unstructured.RemoveNestedField(u.Object, field...)
+	}
+	content, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
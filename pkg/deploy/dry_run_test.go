@@ -0,0 +1,82 @@
+package deploy
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newConfigMap(data map[string]interface{}, clusterFields bool) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name": "my-config",
+		},
+		"data": data,
+	}}
+	if clusterFields {
+		u.SetResourceVersion("1234")
+		u.SetUID("0a1b2c")
+		u.SetGeneration(2)
+	}
+	return u
+}
+
+func TestGetResourceDiff(t *testing.T) {
+	tests := []struct {
+		name         string
+		live         *unstructured.Unstructured
+		applied      *unstructured.Unstructured
+		wantEmpty    bool
+		wantContains []string
+	}{
+		{
+			name:      "same resource, only fields set by the cluster differ",
+			live:      newConfigMap(map[string]interface{}{"key": "value"}, true),
+			applied:   newConfigMap(map[string]interface{}{"key": "value"}, false),
+			wantEmpty: true,
+		},
+		{
+			name:    "modified resource",
+			live:    newConfigMap(map[string]interface{}{"key": "value"}, true),
+			applied: newConfigMap(map[string]interface{}{"key": "other"}, true),
+			wantContains: []string{
+				"--- live/ConfigMap/my-config",
+				"+++ deploy/ConfigMap/my-config",
+				"-  key: value",
+				"+  key: other",
+			},
+		},
+		{
+			name:    "new resource",
+			live:    nil,
+			applied: newConfigMap(map[string]interface{}{"key": "value"}, true),
+			wantContains: []string{
+				"--- /dev/null",
+				"+++ deploy/ConfigMap/my-config",
+				"+kind: ConfigMap",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getResourceDiff(tt.live, tt.applied)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantEmpty != (got == "") {
+				t.Fatalf("got diff %q, want empty: %v", got, tt.wantEmpty)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(got, want) {
+					t.Errorf("diff %q should contain %q", got, want)
+				}
+			}
+			if strings.Contains(got, "resourceVersion") || strings.Contains(got, "uid") {
+				t.Errorf("diff %q should not contain fields set by the cluster", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package deploy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/service"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// maxRevisions is the number of revisions of a deployment kept in the namespace
+const maxRevisions = 10
+
+const (
+	revisionDataKey  = "revision.json"
+	manifestsDataKey = "manifests.yaml"
+)
+
+// Status of the resources of a revision, when the revision is recorded
+const (
+	// RevisionApplied is the status of a revision whose resources have been applied, without waiting for them to be ready
+	RevisionApplied = "Applied"
+	// RevisionReady is the status of a revision whose resources are ready
+	RevisionReady = "Ready"
+	// RevisionFailed is the status of a revision whose resources have not been ready in time
+	RevisionFailed = "Failed"
+)
+
+// Revision is a deployment recorded by odo deploy
+type Revision struct {
+	Number    int       `json:"revision"`
+	Timestamp time.Time `json:"timestamp"`
+	// Command is the name of the Deploy command of the devfile run by odo deploy
+	Command string `json:"command,omitempty"`
+	// GitCommit is the commit checked out in the directory of the devfile, if any
+	GitCommit string `json:"gitCommit,omitempty"`
+	// DevfileHash is the SHA-256 hash of the devfile
+	DevfileHash string          `json:"devfileHash,omitempty"`
+	Images      []RevisionImage `json:"images,omitempty"`
+	// RollbackOf is the revision re-applied by odo deploy rollback, if any
+	RollbackOf int `json:"rollbackOf,omitempty"`
+	// Status is the status of the resources when the revision has been recorded, after waiting for them to be ready
+	Status string `json:"status,omitempty"`
+	// Manifests are the resources applied, with the labels and annotations injected by odo
+	Manifests []unstructured.Unstructured `json:"-"`
+
+	secretName string
+}
+
+// RevisionImage is an image built and pushed by odo deploy
+type RevisionImage struct {
+	Name   string `json:"name"`
+	Digest string `json:"digest,omitempty"`
+}
+
+// History returns the revisions recorded for the component and application, the most recent first
+func (o *DeployClient) History(componentName string, appName string) ([]Revision, error) {
+	selector := fmt.Sprintf("%s,%s", componentlabels.GetSelector(componentName, appName), componentlabels.OdoDeployRevisionLabel)
+	secrets, err := o.kubeClient.ListSecrets(selector)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]Revision, 0, len(secrets))
+	for _, secret := range secrets {
+		revision, err := getRevisionFromSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Number > revisions[j].Number
+	})
+	return revisions, nil
+}
+
+// Rollback applies again the resources of a recorded revision, or of the previous revision if revision is 0,
+// and records the rollback as a new revision
+func (o *DeployClient) Rollback(devfileObj parser.DevfileObj, appName string, revision int, prune bool, waitTimeout time.Duration) error {
+	revisions, err := o.History(devfileObj.GetMetadataName(), appName)
+	if err != nil {
+		return err
+	}
+	target, err := selectRollbackRevision(revisions, revision)
+	if err != nil {
+		return err
+	}
+
+	handler := newDeployHandler(devfileObj, "", o.kubeClient, appName)
+	handler.command = target.Command
+	for _, u := range target.Manifests {
+		log.Sectionf("Deploying %s/%s from revision %d", u.GetKind(), u.GetName(), target.Number)
+		_, err = service.PushKubernetesResource(o.kubeClient, u, u.GetLabels(), u.GetAnnotations())
+		if err != nil {
+			return fmt.Errorf("failed to apply %s/%s: %w", u.GetKind(), u.GetName(), err)
+		}
+		handler.applied[getResourceKey(u)] = true
+		handler.appliedResources = append(handler.appliedResources, u)
+	}
+	if prune {
+		err = o.prune(handler)
+		if err != nil {
+			return err
+		}
+	}
+	return o.waitAndRecordRevision(handler, Revision{
+		GitCommit:   target.GitCommit,
+		DevfileHash: target.DevfileHash,
+		Images:      target.Images,
+		RollbackOf:  target.Number,
+	}, waitTimeout)
+}
+
+// selectRollbackRevision returns the revision with the given number, or the most recent revision before the current one
+// whose resources have not failed to be ready if number is 0.
+// The revisions must be sorted, the most recent first
+func selectRollbackRevision(revisions []Revision, number int) (Revision, error) {
+	if len(revisions) == 0 {
+		return Revision{}, errors.New("no deployment recorded for the component, run odo deploy first")
+	}
+	if number == 0 {
+		for _, revision := range revisions[1:] {
+			if revision.Status != RevisionFailed {
+				return revision, nil
+			}
+		}
+		return Revision{}, fmt.Errorf("no successful revision before the current revision %d", revisions[0].Number)
+	}
+	for _, revision := range revisions {
+		if revision.Number == number {
+			return revision, nil
+		}
+	}
+	return Revision{}, fmt.Errorf("revision %d not found, run odo deploy history to list the revisions", number)
+}
+
+// waitAndRecordRevision waits for the resources applied by the handler to be ready, if waitTimeout is not zero,
+// and records the revision with the status of the resources.
+// The revision is recorded even if the resources are not ready, so that the failed deployment can be rolled back
+func (o *DeployClient) waitAndRecordRevision(handler *deployHandler, revision Revision, waitTimeout time.Duration) error {
+	waitErr := o.waitForResources(handler, waitTimeout)
+	switch {
+	case waitErr != nil:
+		revision.Status = RevisionFailed
+	case waitTimeout == 0:
+		revision.Status = RevisionApplied
+	default:
+		revision.Status = RevisionReady
+	}
+	err := o.recordRevision(handler, revision)
+	if err != nil {
+		if waitErr != nil {
+			return fmt.Errorf("%w, and %v", waitErr, err)
+		}
+		return err
+	}
+	return waitErr
+}
+
+// recordRevision records the resources applied by the handler as a new revision in a Secret,
+// and deletes the oldest revisions to keep maxRevisions revisions
+func (o *DeployClient) recordRevision(handler *deployHandler, revision Revision) error {
+	componentName := handler.devfileObj.GetMetadataName()
+	previous, err := o.History(componentName, handler.appName)
+	if err != nil {
+		return err
+	}
+	revision.Number = 1
+	if len(previous) > 0 {
+		revision.Number = previous[0].Number + 1
+	}
+	revision.Timestamp = time.Now().UTC()
+	revision.Command = handler.command
+	revision.Manifests = handler.appliedResources
+
+	data, err := getRevisionData(revision)
+	if err != nil {
+		return err
+	}
+	labels := handler.getLabels()
+	labels[componentlabels.OdoDeployRevisionLabel] = strconv.Itoa(revision.Number)
+	objectMeta := metav1.ObjectMeta{
+		Name:   fmt.Sprintf("%s-%s-deploy-%d", componentName, handler.appName, revision.Number),
+		Labels: labels,
+	}
+	err = o.kubeClient.CreateSecret(objectMeta, data, metav1.OwnerReference{})
+	if err != nil {
+		return fmt.Errorf("unable to record the revision %d: %w", revision.Number, err)
+	}
+	log.Successf("Recorded the deployment as revision %d", revision.Number)
+
+	if len(previous) < maxRevisions {
+		return nil
+	}
+	for _, old := range previous[maxRevisions-1:] {
+		err = o.kubeClient.DeleteSecret(old.secretName, o.kubeClient.GetCurrentNamespace())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getRevisionData returns the content of the Secret recording the revision
+func getRevisionData(revision Revision) (map[string]string, error) {
+	content, err := json.Marshal(revision)
+	if err != nil {
+		return nil, err
+	}
+	var manifests bytes.Buffer
+	for _, u := range revision.Manifests {
+		manifest, err := yaml.Marshal(u.Object)
+		if err != nil {
+			return nil, err
+		}
+		manifests.WriteString("---\n")
+		manifests.Write(manifest)
+	}
+	return map[string]string{
+		revisionDataKey:  string(content),
+		manifestsDataKey: manifests.String(),
+	}, nil
+}
+
+// getRevisionFromSecret returns the revision recorded in the Secret
+func getRevisionFromSecret(secret corev1.Secret) (Revision, error) {
+	var revision Revision
+	err := json.Unmarshal(secret.Data[revisionDataKey], &revision)
+	if err != nil {
+		return Revision{}, fmt.Errorf("unable to read the revision recorded in the secret %q: %w", secret.Name, err)
+	}
+	revision.secretName = secret.Name
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(secret.Data[manifestsDataKey]), 4096)
+	for {
+		var obj map[string]interface{}
+		err = decoder.Decode(&obj)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Revision{}, fmt.Errorf("unable to read the manifests recorded in the secret %q: %w", secret.Name, err)
+		}
+		if obj == nil {
+			continue
+		}
+		revision.Manifests = append(revision.Manifests, unstructured.Unstructured{Object: obj})
+	}
+	return revision, nil
+}
+
+// getDevfileHash returns the SHA-256 hash of the content of the devfile
+func getDevfileHash(devfileObj parser.DevfileObj) string {
+	content, err := os.ReadFile(devfileObj.Ctx.GetAbsPath())
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
+
+// getCurrentRevision returns the revision to record for the resources and images of the handler
+func getCurrentRevision(handler *deployHandler) Revision {
+	return Revision{
+		GitCommit:   util.GetGitCommit(handler.path),
+		DevfileHash: getDevfileHash(handler.devfileObj),
+		Images:      handler.images,
+	}
+}
@@ -0,0 +1,249 @@
+package deploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odoTestingUtil "github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+// getRevisionSecret returns the Secret recording the revision, as returned by the cluster
+func getRevisionSecret(t *testing.T, revision Revision) corev1.Secret {
+	data, err := getRevisionData(revision)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("my-component-app-deploy-%d", revision.Number)},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func TestGetRevisionFromSecret(t *testing.T) {
+	deployment := getDeployedResource("Deployment", "my-deployment", componentlabels.ComponentDeployName, "deploy")
+	service := getDeployedResource("Service", "my-service", componentlabels.ComponentDeployName, "service")
+	revision := Revision{
+		Number:      3,
+		Timestamp:   time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC),
+		GitCommit:   "0123456789abcdef",
+		DevfileHash: "abcdef",
+		Images:      []RevisionImage{{Name: "quay.io/user/image", Digest: "sha256:aaa"}},
+		RollbackOf:  1,
+		Manifests:   []unstructured.Unstructured{deployment, service},
+	}
+
+	got, err := getRevisionFromSecret(getRevisionSecret(t, revision))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	revision.secretName = "my-component-app-deploy-3"
+	if !reflect.DeepEqual(got, revision) {
+		t.Errorf("got %+v, want %+v", got, revision)
+	}
+}
+
+func TestSelectRollbackRevision(t *testing.T) {
+	revisions := []Revision{{Number: 3}, {Number: 2}, {Number: 1}}
+	tests := []struct {
+		name      string
+		revisions []Revision
+		number    int
+		want      int
+		wantErr   bool
+	}{
+		{
+			name:      "previous revision",
+			revisions: revisions,
+			want:      2,
+		},
+		{
+			name:      "specific revision",
+			revisions: revisions,
+			number:    1,
+			want:      1,
+		},
+		{
+			name:      "unknown revision",
+			revisions: revisions,
+			number:    4,
+			wantErr:   true,
+		},
+		{
+			name:      "previous revision skipping the failed revisions",
+			revisions: []Revision{{Number: 4, Status: RevisionFailed}, {Number: 3, Status: RevisionFailed}, {Number: 2, Status: RevisionReady}, {Number: 1}},
+			want:      2,
+		},
+		{
+			name:      "no previous revision",
+			revisions: revisions[:1],
+			wantErr:   true,
+		},
+		{
+			name:      "only failed previous revisions",
+			revisions: []Revision{{Number: 2, Status: RevisionReady}, {Number: 1, Status: RevisionFailed}},
+			wantErr:   true,
+		},
+		{
+			name:    "no revision",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectRollbackRevision(tt.revisions, tt.number)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("selectRollbackRevision() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got.Number != tt.want {
+				t.Errorf("selectRollbackRevision() = %d, want %d", got.Number, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeployClient_recordRevision(t *testing.T) {
+	tests := []struct {
+		name        string
+		previous    int
+		wantNumber  int
+		wantDeleted []string
+	}{
+		{
+			name:       "first revision",
+			wantNumber: 1,
+		},
+		{
+			name:       "next revision",
+			previous:   2,
+			wantNumber: 3,
+		},
+		{
+			name:        "oldest revisions deleted",
+			previous:    maxRevisions + 1,
+			wantNumber:  maxRevisions + 2,
+			wantDeleted: []string{"my-component-app-deploy-2", "my-component-app-deploy-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+
+			var secrets []corev1.Secret
+			for i := 1; i <= tt.previous; i++ {
+				secrets = append(secrets, getRevisionSecret(t, Revision{Number: i}))
+			}
+			selector := componentlabels.GetSelector("my-component", "app") + "," + componentlabels.OdoDeployRevisionLabel
+			kubeClient.EXPECT().ListSecrets(selector).Return(secrets, nil)
+			kubeClient.EXPECT().CreateSecret(gomock.Any(), gomock.Any(), metav1.OwnerReference{}).
+				DoAndReturn(func(objectMeta metav1.ObjectMeta, data map[string]string, _ metav1.OwnerReference) error {
+					wantName := fmt.Sprintf("my-component-app-deploy-%d", tt.wantNumber)
+					if objectMeta.Name != wantName {
+						t.Errorf("secret name is %q, want %q", objectMeta.Name, wantName)
+					}
+					if objectMeta.Labels[componentlabels.OdoDeployRevisionLabel] != fmt.Sprint(tt.wantNumber) {
+						t.Errorf("revision label is %q, want %d", objectMeta.Labels[componentlabels.OdoDeployRevisionLabel], tt.wantNumber)
+					}
+					if objectMeta.Labels[componentlabels.OdoModeLabel] != componentlabels.ComponentDeployName {
+						t.Errorf("mode label is %q, want %q", objectMeta.Labels[componentlabels.OdoModeLabel], componentlabels.ComponentDeployName)
+					}
+					return nil
+				})
+			kubeClient.EXPECT().GetCurrentNamespace().Return("my-ns").AnyTimes()
+			for _, name := range tt.wantDeleted {
+				kubeClient.EXPECT().DeleteSecret(name, "my-ns").Return(nil)
+			}
+
+			devfileObj := odoTestingUtil.GetTestDevfileObj(filesystem.NewFakeFs())
+			metadata := devfileObj.Data.GetMetadata()
+			metadata.Name = "my-component"
+			devfileObj.Data.SetMetadata(metadata)
+			handler := newDeployHandler(devfileObj, "", kubeClient, "app")
+
+			o := NewDeployClient(kubeClient, nil)
+			err := o.recordRevision(handler, Revision{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDeployClient_waitAndRecordRevision(t *testing.T) {
+	tests := []struct {
+		name        string
+		waitTimeout time.Duration
+		waitErr     error
+		wantStatus  string
+		wantErr     bool
+	}{
+		{
+			name:       "without waiting",
+			wantStatus: RevisionApplied,
+		},
+		{
+			name:        "resources ready",
+			waitTimeout: time.Minute,
+			wantStatus:  RevisionReady,
+		},
+		{
+			name:        "resources not ready",
+			waitTimeout: time.Minute,
+			waitErr:     errors.New("timeout"),
+			wantStatus:  RevisionFailed,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+
+			devfileObj := odoTestingUtil.GetTestDevfileObj(filesystem.NewFakeFs())
+			resources := []unstructured.Unstructured{{Object: map[string]interface{}{"kind": "Deployment"}}}
+			if tt.waitTimeout != 0 {
+				selector := componentlabels.GetSelector(devfileObj.Data.GetMetadata().Name, "app")
+				kubeClient.EXPECT().WaitForResourcesReady(resources, selector, tt.waitTimeout).Return(tt.waitErr)
+			}
+			kubeClient.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+			kubeClient.EXPECT().CreateSecret(gomock.Any(), gomock.Any(), metav1.OwnerReference{}).
+				DoAndReturn(func(objectMeta metav1.ObjectMeta, data map[string]string, _ metav1.OwnerReference) error {
+					var revision Revision
+					err := json.Unmarshal([]byte(data[revisionDataKey]), &revision)
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					if revision.Status != tt.wantStatus {
+						t.Errorf("revision status is %q, want %q", revision.Status, tt.wantStatus)
+					}
+					return nil
+				})
+
+			handler := newDeployHandler(devfileObj, "", kubeClient, "app")
+			handler.appliedResources = resources
+
+			o := NewDeployClient(kubeClient, nil)
+			err := o.waitAndRecordRevision(handler, Revision{}, tt.waitTimeout)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("waitAndRecordRevision() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,8 +1,41 @@
 package deploy
 
-import "github.com/devfile/library/pkg/devfile/parser"
+import (
+	"io"
+	"time"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+
+	"github.com/redhat-developer/odo/pkg/devfile/image"
+)
+
+// Options are the options of the deployment of the resources of a devfile
+type Options struct {
+	// CommandName is the name of the Deploy command to run, the default Deploy command if empty
+	CommandName string
+	// Prune is true to delete the resources deployed from Kubernetes components not applied anymore
+	Prune bool
+	// WaitTimeout is the time to wait for the applied resources to be ready, the resources are not waited for if zero.
+	// It is not used by DryRun
+	WaitTimeout time.Duration
+	// TagStrategy is the strategy of the tags of the images built
+	TagStrategy image.TagStrategy
+	// BuildOptions are the options of the builds of the images
+	BuildOptions image.BuildOptions
+}
 
 type Client interface {
-	// Deploy resources from a devfile located in path, for the specified appName
-	Deploy(devfileObj parser.DevfileObj, path string, appName string) error
+	// Deploy resources from a devfile located in path, for the specified appName, with the options.
+	// If options.WaitTimeout is not zero, returns an error if the applied resources are not ready after the timeout.
+	// The Kubernetes components reference the images pushed by their digests
+	Deploy(devfileObj parser.DevfileObj, path string, appName string, options Options) error
+	// DryRun previews the deployment of the resources from a devfile located in path, for the specified appName, with the options.
+	// The images that would be built and the differences between the resources and the ones in the cluster are written to out,
+	// without modifying the cluster. If options.Prune is true, the resources that would be pruned by Deploy are listed
+	DryRun(devfileObj parser.DevfileObj, path string, appName string, options Options, out io.Writer) error
+	// History returns the revisions recorded by Deploy and Rollback for the component and application, the most recent first
+	History(componentName string, appName string) ([]Revision, error)
+	// Rollback applies again the resources of a revision recorded for the component of the devfile, or of the previous revision if revision is 0.
+	// The prune and waitTimeout parameters have the same meaning as for Deploy
+	Rollback(devfileObj parser.DevfileObj, appName string, revision int, prune bool, waitTimeout time.Duration) error
 }
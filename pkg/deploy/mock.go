@@ -5,7 +5,9 @@
 package deploy
 
 import (
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	parser "github.com/devfile/library/pkg/devfile/parser"
 	gomock "github.com/golang/mock/gomock"
@@ -35,15 +37,58 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 }
 
 // Deploy mocks base method.
-func (m *MockClient) Deploy(devfileObj parser.DevfileObj, path, appName string) error {
+func (m *MockClient) Deploy(devfileObj parser.DevfileObj, path, appName string, options Options) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Deploy", devfileObj, path, appName)
+	ret := m.ctrl.Call(m, "Deploy", devfileObj, path, appName, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Deploy indicates an expected call of Deploy.
-func (mr *MockClientMockRecorder) Deploy(devfileObj, path, appName interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) Deploy(devfileObj, path, appName, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockClient)(nil).Deploy), devfileObj, path, appName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockClient)(nil).Deploy), devfileObj, path, appName, options)
+}
+
+// DryRun mocks base method.
+func (m *MockClient) DryRun(devfileObj parser.DevfileObj, path, appName string, options Options, out io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRun", devfileObj, path, appName, options, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DryRun indicates an expected call of DryRun.
+func (mr *MockClientMockRecorder) DryRun(devfileObj, path, appName, options, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRun", reflect.TypeOf((*MockClient)(nil).DryRun), devfileObj, path, appName, options, out)
+}
+
+// History mocks base method.
+func (m *MockClient) History(componentName, appName string) ([]Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", componentName, appName)
+	ret0, _ := ret[0].([]Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockClientMockRecorder) History(componentName, appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockClient)(nil).History), componentName, appName)
+}
+
+// Rollback mocks base method.
+func (m *MockClient) Rollback(devfileObj parser.DevfileObj, appName string, revision int, prune bool, waitTimeout time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", devfileObj, appName, revision, prune, waitTimeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockClientMockRecorder) Rollback(devfileObj, appName, revision, prune, waitTimeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockClient)(nil).Rollback), devfileObj, appName, revision, prune, waitTimeout)
 }
@@ -0,0 +1,89 @@
+package deploy
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	applabels "github.com/redhat-developer/odo/pkg/application/labels"
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// prune deletes the resources deployed from Kubernetes components of the devfile by a previous deploy command
+// and not applied by the deploy command anymore
+func (o *DeployClient) prune(handler *deployHandler) error {
+	resources, err := o.listResourcesToPrune(handler)
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+
+	log.Section("Pruning resources removed from the devfile")
+	failed := o.deleteClient.DeleteResources(resources, false)
+	failedKeys := map[string]bool{}
+	var failedNames []string
+	for _, resource := range failed {
+		failedKeys[getResourceKey(resource)] = true
+		failedNames = append(failedNames, resource.GetKind()+"/"+resource.GetName())
+	}
+	for _, resource := range resources {
+		if !failedKeys[getResourceKey(resource)] {
+			log.Successf("Deleted %s/%s, defined by the component %q", resource.GetKind(), resource.GetName(), resource.GetAnnotations()[componentlabels.OdoDevfileComponentAnnotation])
+		}
+	}
+	if len(failedNames) > 0 {
+		return fmt.Errorf("failed to delete the resources removed from the devfile: %s", strings.Join(failedNames, ", "))
+	}
+	return nil
+}
+
+// previewPrune lists the resources that would be deleted by prune
+func (o *DeployClient) previewPrune(handler *deployHandler) error {
+	resources, err := o.listResourcesToPrune(handler)
+	if err != nil {
+		return err
+	}
+	for _, resource := range resources {
+		log.Sectionf("%s/%s would be deleted, the component %q is not deployed anymore", resource.GetKind(), resource.GetName(), resource.GetAnnotations()[componentlabels.OdoDevfileComponentAnnotation])
+	}
+	return nil
+}
+
+// listResourcesToPrune lists the resources of the component in deploy mode, deployed from Kubernetes components
+// by the Deploy command of the handler, and not applied by the handler. The resources owned by another resource of the component are left to the garbage collector
+func (o *DeployClient) listResourcesToPrune(handler *deployHandler) ([]unstructured.Unstructured, error) {
+	componentName := handler.devfileObj.GetMetadataName()
+	list, err := o.deleteClient.ListClusterResourcesToDelete(componentName, o.kubeClient.GetCurrentNamespace())
+	if err != nil {
+		return nil, err
+	}
+	var result []unstructured.Unstructured
+	for _, resource := range list {
+		labels := resource.GetLabels()
+		if labels[componentlabels.OdoModeLabel] != componentlabels.ComponentDeployName || labels[applabels.ApplicationLabel] != handler.appName {
+			continue
+		}
+		if _, ok := resource.GetAnnotations()[componentlabels.OdoDevfileComponentAnnotation]; !ok {
+			// not deployed from a Kubernetes component, or deployed before the annotation was introduced
+			continue
+		}
+		if command, ok := resource.GetAnnotations()[componentlabels.OdoDeployCommandAnnotation]; ok && command != handler.command {
+			// deployed by another Deploy command of the devfile
+			continue
+		}
+		if handler.applied[getResourceKey(resource)] {
+			continue
+		}
+		result = append(result, resource)
+	}
+	return result, nil
+}
+
+// getResourceKey returns a key identifying a resource by its group, kind and name, regardless of its version
+func getResourceKey(u unstructured.Unstructured) string {
+	return u.GroupVersionKind().GroupKind().String() + "/" + u.GetName()
+}
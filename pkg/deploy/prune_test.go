@@ -0,0 +1,96 @@
+package deploy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/golang/mock/gomock"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	applabels "github.com/redhat-developer/odo/pkg/application/labels"
+	_delete "github.com/redhat-developer/odo/pkg/component/delete"
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odoTestingUtil "github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+func getDeployedResource(kind, name, mode, devfileComponent string) unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind(kind)
+	u.SetName(name)
+	u.SetLabels(map[string]string{
+		componentlabels.OdoModeLabel: mode,
+		applabels.ApplicationLabel:   "app",
+	})
+	if devfileComponent != "" {
+		u.SetAnnotations(map[string]string{componentlabels.OdoDevfileComponentAnnotation: devfileComponent})
+	}
+	return u
+}
+
+func TestDeployClient_listResourcesToPrune(t *testing.T) {
+	applied := getDeployedResource("ConfigMap", "applied", componentlabels.ComponentDeployName, "config")
+	removed := getDeployedResource("ConfigMap", "removed", componentlabels.ComponentDeployName, "old-config")
+	devMode := getDeployedResource("Service", "dev", componentlabels.ComponentDevName, "")
+	binding := getDeployedResource("Secret", "binding", componentlabels.ComponentDeployName, "")
+	otherCommand := getDeployedResource("ConfigMap", "staging", componentlabels.ComponentDeployName, "staging-config")
+	otherCommand.SetAnnotations(map[string]string{
+		componentlabels.OdoDevfileComponentAnnotation: "staging-config",
+		componentlabels.OdoDeployCommandAnnotation:    "deploy-staging",
+	})
+	sameCommand := getDeployedResource("ConfigMap", "production", componentlabels.ComponentDeployName, "old-production-config")
+	sameCommand.SetAnnotations(map[string]string{
+		componentlabels.OdoDevfileComponentAnnotation: "old-production-config",
+		componentlabels.OdoDeployCommandAnnotation:    "deploy-production",
+	})
+
+	tests := []struct {
+		name    string
+		cluster []unstructured.Unstructured
+		want    []unstructured.Unstructured
+	}{
+		{
+			name:    "no resource removed",
+			cluster: []unstructured.Unstructured{applied, devMode, binding},
+		},
+		{
+			name:    "resource removed from the devfile",
+			cluster: []unstructured.Unstructured{applied, removed, devMode, binding},
+			want:    []unstructured.Unstructured{removed},
+		},
+		{
+			name:    "resources deployed by another Deploy command",
+			cluster: []unstructured.Unstructured{applied, otherCommand, sameCommand},
+			want:    []unstructured.Unstructured{sameCommand},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+			kubeClient.EXPECT().GetCurrentNamespace().Return("my-ns")
+			deleteClient := _delete.NewMockClient(ctrl)
+			deleteClient.EXPECT().ListClusterResourcesToDelete("my-component", "my-ns").Return(tt.cluster, nil)
+
+			devfileObj := odoTestingUtil.GetTestDevfileObj(filesystem.NewFakeFs())
+			metadata := devfileObj.Data.GetMetadata()
+			metadata.Name = "my-component"
+			devfileObj.Data.SetMetadata(metadata)
+
+			handler := newDeployHandler(devfileObj, "", kubeClient, "app")
+			handler.command = "deploy-production"
+			handler.applied[getResourceKey(applied)] = true
+
+			o := NewDeployClient(kubeClient, deleteClient)
+			got, err := o.listResourcesToPrune(handler)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
 	dfutil "github.com/devfile/library/pkg/util"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -196,6 +197,22 @@ func (a Adapter) Push(parameters common.PushParameters) (err error) {
 		return fmt.Errorf("error while trying to fetch service(s) from devfile: %w", err)
 	}
 
+	// the components referencing a Helm chart or a Kustomize directory are replaced by the resources rendered
+	renderValues, err := libdevfile.GetK8sRenderValues(a.Devfile, a.Client.GetCurrentNamespace())
+	if err != nil {
+		return err
+	}
+	k8sComponents, err = libdevfile.RenderK8sComponents(k8sComponents, a.Context, devfilefs.DefaultFs{}, renderValues)
+	if err != nil {
+		return err
+	}
+
+	// the services not supported by the cluster are replaced by their dev substitute, if any
+	k8sComponents, substituted, err := service.SplitSubstitutedComponents(a.Client, k8sComponents, a.Context)
+	if err != nil {
+		return err
+	}
+
 	// validate if the GVRs represented by Kubernetes inlined components are supported by the underlying cluster
 	err = service.ValidateResourcesExist(a.Client, k8sComponents, a.Context)
 	if err != nil {
@@ -253,8 +270,14 @@ func (a Adapter) Push(parameters common.PushParameters) (err error) {
 		return err
 	}
 
+	// run the dev substitutes of the services and delete the ones not used anymore
+	substitutes, err := service.PushSubstitutes(a.Client, substituted, a.ComponentName, a.AppName, ownerReference)
+	if err != nil {
+		return err
+	}
+
 	// create the Kubernetes objects from the manifest and delete the ones not in the devfile
-	needRestart, err := service.PushLinks(a.Client, k8sComponents, labels, a.deployment, a.Context)
+	needRestart, err := service.PushLinks(a.Client, k8sComponents, substitutes, labels, a.deployment, a.Context)
 	if err != nil {
 		return fmt.Errorf("failed to create service(s) associated with the component: %w", err)
 	}
@@ -289,6 +312,12 @@ func (a Adapter) Push(parameters common.PushParameters) (err error) {
 	if err != nil {
 		return fmt.Errorf("error while retrieving container from pod %s with a mounted project volume: %w", podName, err)
 	}
+
+	// copy the seed directories into the volumes created for the first time
+	err = storagepkg.SeedVolumes(a.Client, a, a.Devfile, a.ComponentName, pod.GetName())
+	if err != nil {
+		return err
+	}
 	s.End(true)
 
 	s = log.Spinner("Syncing files into the container")
@@ -407,6 +436,8 @@ func (a *Adapter) createOrUpdateComponent(componentExists bool, ei envinfo.EnvSp
 	storageClient := storagepkg.NewClient(storagepkg.ClientOptions{
 		Client:              a.Client,
 		LocalConfigProvider: &ei,
+		StorageClass:        a.prefClient.GetStorageClass(),
+		AccessMode:          a.prefClient.GetVolumeAccessMode(),
 	})
 
 	// handle the ephemeral storage
@@ -415,8 +446,13 @@ func (a *Adapter) createOrUpdateComponent(componentExists bool, ei envinfo.EnvSp
 		return err
 	}
 
+	dependencyCache, err := storagepkg.GetDependencyCacheLanguage(a.Devfile, a.prefClient.GetDependencyCache())
+	if err != nil {
+		return err
+	}
+
 	// From devfile info, create PVCs and return ephemeral storages
-	ephemerals, err := storagepkg.Push(storageClient, &ei)
+	ephemerals, err := storagepkg.Push(storageClient, &ei, dependencyCache)
 	if err != nil {
 		return err
 	}
@@ -479,6 +515,10 @@ func (a *Adapter) createOrUpdateComponent(componentExists bool, ei envinfo.EnvSp
 	}
 	allVolumes = append(allVolumes, ephemeralVolumes...)
 
+	if dependencyCache != "" {
+		allVolumes = append(allVolumes, storage.GetDependencyCacheVolumeAndVolumeMounts(containers, dependencyCache))
+	}
+
 	odoMandatoryVolumes := utils.GetOdoContainerVolumes(odoSourcePVCName)
 	allVolumes = append(allVolumes, odoMandatoryVolumes...)
 
@@ -138,7 +138,12 @@ func TestCreateOrUpdateComponent(t *testing.T) {
 				Name:    testComponentName,
 				AppName: testAppName,
 			})
-			componentAdapter := New(adapterCtx, fkclient, nil)
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetStorageClass().Return("").AnyTimes()
+			prefClient.EXPECT().GetVolumeAccessMode().Return(preference.DefaultVolumeAccessModeSetting).AnyTimes()
+			prefClient.EXPECT().GetDependencyCache().Return(false).AnyTimes()
+			componentAdapter := New(adapterCtx, fkclient, prefClient)
 			err := componentAdapter.createOrUpdateComponent(tt.running, tt.envInfo, false)
 
 			// Checks for unexpected error cases
@@ -352,7 +357,12 @@ func TestDoesComponentExist(t *testing.T) {
 			})
 
 			// DoesComponentExist requires an already started component, so start it.
-			componentAdapter := New(adapterCtx, fkclient, nil)
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetStorageClass().Return("").AnyTimes()
+			prefClient.EXPECT().GetVolumeAccessMode().Return(preference.DefaultVolumeAccessModeSetting).AnyTimes()
+			prefClient.EXPECT().GetDependencyCache().Return(false).AnyTimes()
+			componentAdapter := New(adapterCtx, fkclient, prefClient)
 			err := componentAdapter.createOrUpdateComponent(false, tt.envInfo, false)
 
 			// Checks for unexpected error cases
@@ -112,6 +112,38 @@ func GetEphemeralVolumesAndVolumeMounts(devfileObj devfileParser.DevfileObj, con
 	return emptydirVols, nil
 }
 
+// GetDependencyCacheVolumeAndVolumeMounts returns the volume of the dependency cache PVC of the language, and updates the containers
+// with the volume mounts of the directories of the cache and the environment variables pointing the build tools to them.
+// The environment variables already defined in a container are not overridden
+func GetDependencyCacheVolumeAndVolumeMounts(containers []corev1.Container, language string) corev1.Volume {
+	dirs := storagepkg.GetDependencyCacheDirs(language)
+	for i := range containers {
+		for _, dir := range dirs {
+			containers[i].VolumeMounts = append(containers[i].VolumeMounts, corev1.VolumeMount{
+				Name:      storagepkg.OdoDependencyCacheVolume,
+				MountPath: dir.MountPath(),
+				SubPath:   dir.Name,
+			})
+			if !hasEnv(containers[i], dir.Env) {
+				containers[i].Env = append(containers[i].Env, corev1.EnvVar{
+					Name:  dir.Env,
+					Value: dir.EnvValue(),
+				})
+			}
+		}
+	}
+	return getPVC(storagepkg.OdoDependencyCacheVolume, storagepkg.GetDependencyCachePVCName(language))
+}
+
+func hasEnv(container corev1.Container, name string) bool {
+	for _, env := range container.Env {
+		if env.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // getPVC gets a pvc type volume with the given volume name and pvc name.
 func getPVC(volumeName, pvcName string) corev1.Volume {
 
@@ -466,3 +466,48 @@ func TestGetVolumesAndVolumeMounts(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDependencyCacheVolumeAndVolumeMounts(t *testing.T) {
+	containers := []corev1.Container{
+		{
+			Name: "runtime",
+		},
+		{
+			Name: "tools",
+			Env: []corev1.EnvVar{
+				{Name: "MAVEN_OPTS", Value: "-Xmx1g"},
+			},
+		},
+	}
+
+	volume := GetDependencyCacheVolumeAndVolumeMounts(containers, "java")
+
+	wantVolume := getPVC("odo-dependency-cache", "odo-dependency-cache-java")
+	if !reflect.DeepEqual(volume, wantVolume) {
+		t.Errorf("expected volume %v, got %v", wantVolume, volume)
+	}
+
+	wantMounts := []corev1.VolumeMount{
+		{Name: "odo-dependency-cache", MountPath: "/opt/odo/cache/m2", SubPath: "m2"},
+		{Name: "odo-dependency-cache", MountPath: "/opt/odo/cache/gradle", SubPath: "gradle"},
+	}
+	wantEnvs := [][]corev1.EnvVar{
+		{
+			{Name: "MAVEN_OPTS", Value: "-Dmaven.repo.local=/opt/odo/cache/m2"},
+			{Name: "GRADLE_USER_HOME", Value: "/opt/odo/cache/gradle"},
+		},
+		{
+			// the environment variables defined in the container are not overridden
+			{Name: "MAVEN_OPTS", Value: "-Xmx1g"},
+			{Name: "GRADLE_USER_HOME", Value: "/opt/odo/cache/gradle"},
+		},
+	}
+	for i, container := range containers {
+		if !reflect.DeepEqual(container.VolumeMounts, wantMounts) {
+			t.Errorf("container %q: expected volume mounts %v, got %v", container.Name, wantMounts, container.VolumeMounts)
+		}
+		if !reflect.DeepEqual(container.Env, wantEnvs[i]) {
+			t.Errorf("container %q: expected env %v, got %v", container.Name, wantEnvs[i], container.Env)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package devfile
 
 import (
+	"fmt"
+
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser"
 	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
@@ -49,3 +51,31 @@ func GetKubernetesComponentsToPush(devfileObj parser.DevfileObj) ([]devfilev1.Co
 
 	return k8sComponents, err
 }
+
+// AddKubernetesComponentToDevfile adds a Kubernetes component named name, inlining the crd definition, to the devfile.
+// The devfile is not written to disk
+func AddKubernetesComponentToDevfile(crd string, name string, devfileObj parser.DevfileObj) (parser.DevfileObj, error) {
+	components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return devfileObj, err
+	}
+	for _, component := range components {
+		if component.Name == name {
+			return devfileObj, fmt.Errorf("a component named %q already exists in the devfile", name)
+		}
+	}
+
+	err = devfileObj.Data.AddComponents([]devfilev1.Component{{
+		Name: name,
+		ComponentUnion: devfilev1.ComponentUnion{
+			Kubernetes: &devfilev1.KubernetesComponent{
+				K8sLikeComponent: devfilev1.K8sLikeComponent{
+					K8sLikeComponentLocation: devfilev1.K8sLikeComponentLocation{
+						Inlined: crd,
+					},
+				},
+			},
+		},
+	}})
+	return devfileObj, err
+}
@@ -125,3 +125,60 @@ func TestGetKubernetesComponentsToPush(t *testing.T) {
 		})
 	}
 }
+
+func TestAddKubernetesComponentToDevfile(t *testing.T) {
+	fs := devfileFileSystem.NewFakeFs()
+
+	tests := []struct {
+		name    string
+		crd     string
+		compo   string
+		wantErr bool
+	}{
+		{
+			name:  "add a new component",
+			crd:   "kind: Redis",
+			compo: "myredis",
+		},
+		{
+			name:    "a component with the same name already exists",
+			crd:     "kind: Redis",
+			compo:   "component1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileObj := parser.DevfileObj{
+				Data: devfiletesting.GetDevfileData(t, []devfiletesting.InlinedComponent{
+					{
+						Name:    "component1",
+						Inlined: "Component 1",
+					},
+				}, nil),
+				Ctx: devfileCtx.FakeContext(fs, parser.OutputDevfileYamlPath),
+			}
+			got, err := AddKubernetesComponentToDevfile(tt.crd, tt.compo, devfileObj)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddKubernetesComponentToDevfile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			components, err := GetKubernetesComponentsToPush(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var found *devfilev1.Component
+			for i := range components {
+				if components[i].Name == tt.compo {
+					found = &components[i]
+				}
+			}
+			if found == nil || found.Kubernetes.Inlined != tt.crd {
+				t.Errorf("AddKubernetesComponentToDevfile() component %q with content %q not found in %v", tt.compo, tt.crd, components)
+			}
+		})
+	}
+}
@@ -2,17 +2,51 @@ package devfile
 
 import (
 	"fmt"
-
+	"sort"
 	"strings"
 
+	"github.com/devfile/api/v2/pkg/validation/variables"
 	"github.com/devfile/library/pkg/devfile"
 	"github.com/devfile/library/pkg/devfile/parser"
+	devfilevalidate "github.com/devfile/library/pkg/devfile/validate"
 	"github.com/redhat-developer/odo/pkg/devfile/validate"
 	"github.com/redhat-developer/odo/pkg/log"
 )
 
 func parseDevfile(args parser.ParserArgs) (parser.DevfileObj, error) {
 	devObj, varWarnings, err := devfile.ParseDevfileAndValidate(args)
+	return validateParsedDevfile(devObj, varWarnings, err)
+}
+
+// parseDevfileWithVariables parses and validates the devfile as devfile.ParseDevfileAndValidate does,
+// with the values of the variables of the devfile overridden by vars before they are replaced
+func parseDevfileWithVariables(args parser.ParserArgs, vars map[string]string) (parser.DevfileObj, variables.VariableWarning, error) {
+	devObj, err := parser.ParseDevfile(args)
+	if err != nil {
+		return parser.DevfileObj{}, variables.VariableWarning{}, err
+	}
+	if devObj.Data.GetSchemaVersion() == "2.0.0" {
+		names := make([]string, 0, len(vars))
+		for name := range vars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		log.Warningf("Variables are not supported in devfile schema version 2.0.0, the values of the variables %s are ignored", strings.Join(names, ", "))
+		return devObj, variables.VariableWarning{}, devfilevalidate.ValidateDevfileData(devObj.Data)
+	}
+	spec := devObj.Data.GetDevfileWorkspaceSpec()
+	if spec.Variables == nil {
+		spec.Variables = map[string]string{}
+	}
+	for name, value := range vars {
+		spec.Variables[name] = value
+	}
+	varWarnings := variables.ValidateAndReplaceGlobalVariable(spec)
+	return devObj, varWarnings, devfilevalidate.ValidateDevfileData(devObj.Data)
+}
+
+// validateParsedDevfile runs the odo specific validations on a parsed devfile, and displays the warnings related to variable substitution
+func validateParsedDevfile(devObj parser.DevfileObj, varWarnings variables.VariableWarning, err error) (parser.DevfileObj, error) {
 	if err != nil {
 		return parser.DevfileObj{}, err
 	}
@@ -46,6 +80,16 @@ func ParseAndValidateFromFile(devfilePath string) (parser.DevfileObj, error) {
 	return parseDevfile(parser.ParserArgs{Path: devfilePath})
 }
 
+// ParseAndValidateFromFileWithVariables reads, parses and validates devfile from a file,
+// with the values of the variables of the devfile overridden by vars
+// if there are warning it logs them on stdout
+func ParseAndValidateFromFileWithVariables(devfilePath string, vars map[string]string) (parser.DevfileObj, error) {
+	if len(vars) == 0 {
+		return ParseAndValidateFromFile(devfilePath)
+	}
+	return validateParsedDevfile(parseDevfileWithVariables(parser.ParserArgs{Path: devfilePath}, vars))
+}
+
 // ParseAndValidateFromURL parses devfile from given url and does all the validation
 // if there are warning it logs them on stdout
 func ParseAndValidateFromURL(url string) (parser.DevfileObj, error) {
@@ -0,0 +1,98 @@
+package devfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+)
+
+const devfileWithVariables = `schemaVersion: 2.2.0
+metadata:
+  name: my-component
+variables:
+  IMAGE: quay.io/user/image:latest
+  REPLICAS: "1"
+components:
+  - name: runtime
+    container:
+      image: "{{IMAGE}}"
+      env:
+        - name: REPLICAS
+          value: "{{REPLICAS}}"
+`
+
+func TestParseAndValidateFromFileWithVariables(t *testing.T) {
+	devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+	err := os.WriteFile(devfilePath, []byte(devfileWithVariables), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		vars         map[string]string
+		wantImage    string
+		wantReplicas string
+	}{
+		{
+			name:         "no override",
+			wantImage:    "quay.io/user/image:latest",
+			wantReplicas: "1",
+		},
+		{
+			name:         "override a variable",
+			vars:         map[string]string{"REPLICAS": "3"},
+			wantImage:    "quay.io/user/image:latest",
+			wantReplicas: "3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devObj, err := ParseAndValidateFromFileWithVariables(devfilePath, tt.vars)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			components, err := devObj.Data.GetComponents(common.DevfileOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			container := components[0].Container
+			if container.Image != tt.wantImage {
+				t.Errorf("got image %q, want %q", container.Image, tt.wantImage)
+			}
+			if container.Env[0].Value != tt.wantReplicas {
+				t.Errorf("got REPLICAS %q, want %q", container.Env[0].Value, tt.wantReplicas)
+			}
+		})
+	}
+}
+
+const devfileSchema200 = `schemaVersion: 2.0.0
+metadata:
+  name: my-component
+components:
+  - name: runtime
+    container:
+      image: quay.io/user/image:v1
+`
+
+func TestParseAndValidateFromFileWithVariables_schema200(t *testing.T) {
+	devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+	err := os.WriteFile(devfilePath, []byte(devfileSchema200), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	devObj, err := ParseAndValidateFromFileWithVariables(devfilePath, map[string]string{"IMAGE": "quay.io/user/image:v2"})
+	if err != nil {
+		t.Fatalf("the variables should be ignored for the schema version 2.0.0, got error: %v", err)
+	}
+	components, err := devObj.Data.GetComponents(common.DevfileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if components[0].Container.Image != "quay.io/user/image:v1" {
+		t.Errorf("got image %q, want %q", components[0].Container.Image, "quay.io/user/image:v1")
+	}
+}
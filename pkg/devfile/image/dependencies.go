@@ -0,0 +1,101 @@
+package image
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+// getBuildWaves groups the image components in waves of images that can be built concurrently:
+// the images of a wave only depend on images of the previous waves, through the FROM instructions
+// and the --from flags of their Dockerfiles
+func getBuildWaves(components []devfile.Component, devfilePath string) ([][]devfile.Component, error) {
+	// the names of the image components, indexed by the repositories of their images
+	repositories := map[string]string{}
+	for _, component := range components {
+		repositories[GetImageRepository(component.Image.ImageName)] = component.Name
+	}
+	dependencies := map[string][]string{}
+	for _, component := range components {
+		references, err := getDockerfileImageReferences(component.Image, devfilePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, reference := range references {
+			if dependency, ok := repositories[GetImageRepository(reference)]; ok && dependency != component.Name {
+				dependencies[component.Name] = append(dependencies[component.Name], dependency)
+			}
+		}
+	}
+
+	var waves [][]devfile.Component
+	built := map[string]bool{}
+	remaining := components
+	for len(remaining) > 0 {
+		var wave, next []devfile.Component
+		for _, component := range remaining {
+			ready := true
+			for _, dependency := range dependencies[component.Name] {
+				if !built[dependency] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				wave = append(wave, component)
+			} else {
+				next = append(next, component)
+			}
+		}
+		if len(wave) == 0 {
+			names := make([]string, 0, len(next))
+			for _, component := range next {
+				names = append(names, component.Name)
+			}
+			return nil, fmt.Errorf("the images of the components %v depend on each other", names)
+		}
+		for _, component := range wave {
+			built[component.Name] = true
+		}
+		waves = append(waves, wave)
+		remaining = next
+	}
+	return waves, nil
+}
+
+// getDockerfileImageReferences returns the images referenced by the FROM instructions and the --from flags of the Dockerfile of the image.
+// No image is returned if the Dockerfile is not a local file
+func getDockerfileImageReferences(image *devfile.ImageComponent, devfilePath string) ([]string, error) {
+	if image.Dockerfile == nil || strings.HasPrefix(image.Dockerfile.Uri, "http") {
+		return nil, nil
+	}
+	content, err := os.ReadFile(filepath.Join(devfilePath, image.Dockerfile.Uri))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the Dockerfile of the image %q: %w", image.ImageName, err)
+	}
+	var references []string
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		if strings.EqualFold(fields[0], "FROM") {
+			for _, field := range fields[1:] {
+				if !strings.HasPrefix(field, "--") {
+					references = append(references, field)
+					break
+				}
+			}
+			continue
+		}
+		for _, field := range fields[1:] {
+			if strings.HasPrefix(field, "--from=") {
+				references = append(references, strings.TrimPrefix(field, "--from="))
+			}
+		}
+	}
+	return references, nil
+}
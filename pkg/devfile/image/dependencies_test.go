@@ -0,0 +1,92 @@
+package image
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+func TestGetBuildWaves(t *testing.T) {
+	getComponent := func(name string, imageName string, dockerfile string) devfile.Component {
+		return devfile.Component{
+			Name: name,
+			ComponentUnion: devfile.ComponentUnion{
+				Image: &devfile.ImageComponent{
+					Image: devfile.Image{
+						ImageName: imageName,
+						ImageUnion: devfile.ImageUnion{
+							Dockerfile: &devfile.DockerfileImage{
+								DockerfileSrc: devfile.DockerfileSrc{Uri: dockerfile},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	dir := t.TempDir()
+	dockerfiles := map[string]string{
+		"Dockerfile.base":     "FROM registry.access.redhat.com/ubi8/ubi-minimal\n",
+		"Dockerfile.backend":  "FROM --platform=linux/amd64 quay.io/user/base:latest\nRUN make\n",
+		"Dockerfile.frontend": "FROM node:16\nCOPY --from=quay.io/user/backend:v1 /app /app\n",
+		"Dockerfile.other":    "from registry.access.redhat.com/ubi8/ubi-minimal AS build\n",
+		"Dockerfile.loop1":    "FROM quay.io/user/loop2\n",
+		"Dockerfile.loop2":    "FROM quay.io/user/loop1\n",
+	}
+	for name, content := range dockerfiles {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	base := getComponent("base", "quay.io/user/base:v1", "Dockerfile.base")
+	backend := getComponent("backend", "quay.io/user/backend", "Dockerfile.backend")
+	frontend := getComponent("frontend", "quay.io/user/frontend", "Dockerfile.frontend")
+	other := getComponent("other", "quay.io/user/other", "Dockerfile.other")
+	remote := getComponent("remote", "quay.io/user/remote", "https://example.com/Dockerfile")
+
+	tests := []struct {
+		name       string
+		components []devfile.Component
+		want       [][]devfile.Component
+		wantErr    bool
+	}{
+		{
+			name:       "independent images",
+			components: []devfile.Component{base, other, remote},
+			want:       [][]devfile.Component{{base, other, remote}},
+		},
+		{
+			name:       "images depending on each other",
+			components: []devfile.Component{frontend, backend, other, base},
+			want:       [][]devfile.Component{{other, base}, {backend}, {frontend}},
+		},
+		{
+			name: "cyclic dependencies",
+			components: []devfile.Component{
+				getComponent("loop1", "quay.io/user/loop1", "Dockerfile.loop1"),
+				getComponent("loop2", "quay.io/user/loop2", "Dockerfile.loop2"),
+			},
+			wantErr: true,
+		},
+		{
+			name:       "missing Dockerfile",
+			components: []devfile.Component{getComponent("missing", "quay.io/user/missing", "Dockerfile.missing")},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getBuildWaves(tt.components, dir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getBuildWaves() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getBuildWaves() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
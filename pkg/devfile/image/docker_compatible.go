@@ -1,8 +1,10 @@
 package image
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -17,24 +19,40 @@ import (
 // This backend uses a CLI compatible with the docker CLI (at least docker itself and podman)
 type DockerCompatibleBackend struct {
 	name string
+	// podman is true if the CLI is podman, which supports building the images for several platforms in a manifest list
+	podman bool
 }
 
 func NewDockerCompatibleBackend(name string) *DockerCompatibleBackend {
 	return &DockerCompatibleBackend{name: name}
 }
 
+// NewPodmanBackend creates a backend using the podman CLI with the given name
+func NewPodmanBackend(name string) *DockerCompatibleBackend {
+	return &DockerCompatibleBackend{name: name, podman: true}
+}
+
+// ValidateOptions returns an error if the build options are not supported by the Docker compatible CLI
+func (o *DockerCompatibleBackend) ValidateOptions(options BuildOptions) error {
+	if len(options.Platforms) > 1 && !o.podman {
+		return fmt.Errorf("building images for several platforms is only supported with podman, not with %s", o.name)
+	}
+	return nil
+}
+
 // Build an image, as defined in devfile, using a Docker compatible CLI
-func (o *DockerCompatibleBackend) Build(image *devfile.ImageComponent, devfilePath string) error {
+func (o *DockerCompatibleBackend) Build(image *devfile.ImageComponent, devfilePath string, options BuildOptions, out io.Writer, errOut io.Writer) error {
 
 	if strings.HasPrefix(image.Dockerfile.Uri, "http") {
 		return errors.New("HTTP URL for uri is not supported")
 	}
 
-	// We use a "No Spin" since we are outputting to stdout / stderr
-	buildSpinner := log.SpinnerNoSpin("Building image locally")
+	// We use a "No Spin" since we are outputting to out / errOut
+	buildSpinner := log.NewStatus(out)
+	buildSpinner.Start("Building image locally", true)
 	defer buildSpinner.End(false)
 
-	shell := getShellCommand(o.name, image, devfilePath)
+	shell := getShellCommand(o.name, image, devfilePath, options)
 
 	cmd := exec.Command("bash", "-c", shell)
 	cmdEnv := []string{
@@ -42,13 +60,10 @@ func (o *DockerCompatibleBackend) Build(image *devfile.ImageComponent, devfilePa
 		"PROJECT_SOURCE=" + devfilePath,
 	}
 	cmd.Env = append(os.Environ(), cmdEnv...)
-	cmd.Stdout = log.GetStdout()
-	cmd.Stderr = log.GetStderr()
+	cmd.Stdout = out
+	cmd.Stderr = errOut
 
-	// Set all output as italic when doing a push, then return to normal at the end
-	color.Set(color.Italic)
-	defer color.Unset()
-	err := cmd.Run()
+	err := runWithItalicOutput(cmd, out)
 	if err != nil {
 		return fmt.Errorf("error running %s command: %w", o.name, err)
 	}
@@ -57,13 +72,28 @@ func (o *DockerCompatibleBackend) Build(image *devfile.ImageComponent, devfilePa
 	return nil
 }
 
-func getShellCommand(cmdName string, image *devfile.ImageComponent, devfilePath string) string {
+func getShellCommand(cmdName string, image *devfile.ImageComponent, devfilePath string, options BuildOptions) string {
 	imageName := image.ImageName
 	dockerfile := filepath.Join(devfilePath, image.Dockerfile.Uri)
 	buildpath := image.Dockerfile.BuildContext
 	args := image.Dockerfile.Args
 
-	shell := fmt.Sprintf(`%s build -t "%s" -f "%s" %s`, cmdName, imageName, dockerfile, buildpath)
+	// the images built for several platforms are grouped in a manifest list
+	target := fmt.Sprintf(`-t "%s"`, imageName)
+	if len(options.Platforms) > 1 {
+		target = fmt.Sprintf(`--manifest "%s"`, imageName)
+	}
+	shell := fmt.Sprintf(`%s build %s -f "%s"`, cmdName, target, dockerfile)
+	if len(options.Platforms) > 0 {
+		shell = shell + " --platform " + strings.Join(options.Platforms, ",")
+	}
+	for _, cacheFrom := range options.CacheFrom {
+		shell = shell + fmt.Sprintf(` --cache-from "%s"`, cacheFrom)
+	}
+	if options.CacheTo != "" {
+		shell = shell + fmt.Sprintf(` --cache-to "%s"`, options.CacheTo)
+	}
+	shell = shell + " " + buildpath
 	if len(args) > 0 {
 		shell = shell + " " + strings.Join(args, " ")
 	}
@@ -71,23 +101,27 @@ func getShellCommand(cmdName string, image *devfile.ImageComponent, devfilePath
 	return shell
 }
 
-// Push an image to its registry using a Docker compatible CLI
-func (o *DockerCompatibleBackend) Push(image string) error {
+// Push an image to its registry using a Docker compatible CLI.
+// The manifest list of an image built for several platforms is pushed with the images of all the platforms
+func (o *DockerCompatibleBackend) Push(image string, options BuildOptions, out io.Writer, errOut io.Writer) error {
 
-	// We use a "No Spin" since we are outputting to stdout / stderr
-	pushSpinner := log.SpinnerNoSpin("Pushing image to container registry")
+	// We use a "No Spin" since we are outputting to out / errOut
+	pushSpinner := log.NewStatus(out)
+	pushSpinner.Start("Pushing image to container registry", true)
 	defer pushSpinner.End(false)
-	klog.V(4).Infof("Running command: %s push %s", o.name, image)
 
-	cmd := exec.Command(o.name, "push", image)
+	args := []string{"push", image}
+	if o.podman && len(options.Platforms) > 1 {
+		args = []string{"manifest", "push", "--all", image, "docker://" + image}
+	}
+	klog.V(4).Infof("Running command: %s %s", o.name, strings.Join(args, " "))
+
+	cmd := exec.Command(o.name, args...)
 
-	cmd.Stdout = log.GetStdout()
-	cmd.Stderr = log.GetStderr()
+	cmd.Stdout = out
+	cmd.Stderr = errOut
 
-	// Set all output as italic when doing a push, then return to normal at the end
-	color.Set(color.Italic)
-	defer color.Unset()
-	err := cmd.Run()
+	err := runWithItalicOutput(cmd, out)
 	if err != nil {
 		return fmt.Errorf("error running %s command: %w", o.name, err)
 	}
@@ -96,6 +130,44 @@ func (o *DockerCompatibleBackend) Push(image string) error {
 	return nil
 }
 
+// runWithItalicOutput runs the command, setting all the output as italic if out is a terminal,
+// then returning to normal at the end
+func runWithItalicOutput(cmd *exec.Cmd, out io.Writer) error {
+	if log.IsTerminal(out) {
+		color.Set(color.Italic)
+		defer color.Unset()
+	}
+	return cmd.Run()
+}
+
+// Digest returns the digest of an image pushed to its registry, using a Docker compatible CLI
+func (o *DockerCompatibleBackend) Digest(image string) (string, error) {
+	klog.V(4).Infof("Running command: %s image inspect --format {{json .RepoDigests}} %s", o.name, image)
+	out, err := exec.Command(o.name, "image", "inspect", "--format", "{{json .RepoDigests}}", image).Output()
+	if err != nil {
+		return "", fmt.Errorf("error running %s command: %w", o.name, err)
+	}
+	return getDigestFromRepoDigests(image, out)
+}
+
+// getDigestFromRepoDigests returns the digest of the image from the JSON list of repository digests
+// returned by the inspect command, in the form repository@digest
+func getDigestFromRepoDigests(image string, repoDigests []byte) (string, error) {
+	var digests []string
+	err := json.Unmarshal(repoDigests, &digests)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse the digests of the image %q: %w", image, err)
+	}
+	repository := GetImageRepository(image)
+	for _, repoDigest := range digests {
+		parts := strings.SplitN(repoDigest, "@", 2)
+		if len(parts) == 2 && strings.HasSuffix(parts[0], repository) {
+			return parts[1], nil
+		}
+	}
+	return "", fmt.Errorf("no digest found for the image %q, the image may not have been pushed", image)
+}
+
 // String return the name of the docker compatible CLI used
 func (o *DockerCompatibleBackend) String() string {
 	return o.name
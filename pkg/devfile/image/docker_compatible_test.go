@@ -13,6 +13,7 @@ func TestGetShellCommand(t *testing.T) {
 		cmdName     string
 		image       *devfile.ImageComponent
 		devfilePath string
+		options     BuildOptions
 		want        string
 	}{
 		{
@@ -79,14 +80,170 @@ func TestGetShellCommand(t *testing.T) {
 			devfilePath: filepath.Join("home", "user", "project1"),
 			want:        `cli build -t "registry.io/myimagename:tag" -f "` + filepath.Join("home", "user", "project1", "Dockerfile") + `" ${PROJECTS_ROOT} --flag value`,
 		},
+		{
+			name:    "test with cache options",
+			cmdName: "cli",
+			image: &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: "registry.io/myimagename:tag",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: &devfile.DockerfileImage{
+							DockerfileSrc: devfile.DockerfileSrc{
+								Uri: "Dockerfile",
+							},
+							Dockerfile: devfile.Dockerfile{
+								BuildContext: "${PROJECTS_ROOT}",
+							},
+						},
+					},
+				},
+			},
+			devfilePath: filepath.Join("home", "user", "project1"),
+			options: BuildOptions{
+				CacheFrom: []string{"registry.io/myimagename:cache", "type=local,src=/tmp/cache"},
+				CacheTo:   "registry.io/myimagename:cache",
+			},
+			want: `cli build -t "registry.io/myimagename:tag" -f "` + filepath.Join("home", "user", "project1", "Dockerfile") + `" --cache-from "registry.io/myimagename:cache" --cache-from "type=local,src=/tmp/cache" --cache-to "registry.io/myimagename:cache" ${PROJECTS_ROOT}`,
+		},
+		{
+			name:    "test with one platform",
+			cmdName: "cli",
+			image: &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: "registry.io/myimagename:tag",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: &devfile.DockerfileImage{
+							DockerfileSrc: devfile.DockerfileSrc{
+								Uri: "Dockerfile",
+							},
+							Dockerfile: devfile.Dockerfile{
+								BuildContext: "${PROJECTS_ROOT}",
+							},
+						},
+					},
+				},
+			},
+			devfilePath: filepath.Join("home", "user", "project1"),
+			options:     BuildOptions{Platforms: []string{"linux/arm64"}},
+			want:        `cli build -t "registry.io/myimagename:tag" -f "` + filepath.Join("home", "user", "project1", "Dockerfile") + `" --platform linux/arm64 ${PROJECTS_ROOT}`,
+		},
+		{
+			name:    "test with several platforms",
+			cmdName: "cli",
+			image: &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: "registry.io/myimagename:tag",
+					ImageUnion: devfile.ImageUnion{
+						Dockerfile: &devfile.DockerfileImage{
+							DockerfileSrc: devfile.DockerfileSrc{
+								Uri: "Dockerfile",
+							},
+							Dockerfile: devfile.Dockerfile{
+								BuildContext: "${PROJECTS_ROOT}",
+							},
+						},
+					},
+				},
+			},
+			devfilePath: filepath.Join("home", "user", "project1"),
+			options:     BuildOptions{Platforms: []string{"linux/amd64", "linux/arm64"}},
+			want:        `cli build --manifest "registry.io/myimagename:tag" -f "` + filepath.Join("home", "user", "project1", "Dockerfile") + `" --platform linux/amd64,linux/arm64 ${PROJECTS_ROOT}`,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getShellCommand(tt.cmdName, tt.image, tt.devfilePath)
+			got := getShellCommand(tt.cmdName, tt.image, tt.devfilePath, tt.options)
 			if got != tt.want {
 				t.Errorf("%s:\n  Expected %q,\n       got %q", tt.name, tt.want, got)
 			}
 		})
 	}
 }
+
+func TestDockerCompatibleBackend_ValidateOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend *DockerCompatibleBackend
+		options BuildOptions
+		wantErr bool
+	}{
+		{
+			name:    "docker with one platform",
+			backend: NewDockerCompatibleBackend("docker"),
+			options: BuildOptions{Platforms: []string{"linux/arm64"}},
+		},
+		{
+			name:    "docker with several platforms",
+			backend: NewDockerCompatibleBackend("docker"),
+			options: BuildOptions{Platforms: []string{"linux/amd64", "linux/arm64"}},
+			wantErr: true,
+		},
+		{
+			name:    "podman with several platforms",
+			backend: NewPodmanBackend("podman"),
+			options: BuildOptions{Platforms: []string{"linux/amd64", "linux/arm64"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.backend.ValidateOptions(tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetDigestFromRepoDigests(t *testing.T) {
+	tests := []struct {
+		name        string
+		image       string
+		repoDigests string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "image with tag",
+			image:       "quay.io/user/image:v1",
+			repoDigests: `["quay.io/other/image@sha256:aaa","quay.io/user/image@sha256:bbb"]`,
+			want:        "sha256:bbb",
+		},
+		{
+			name:        "image without tag on a registry with a port",
+			image:       "localhost:5000/image",
+			repoDigests: `["localhost:5000/image@sha256:ccc"]`,
+			want:        "sha256:ccc",
+		},
+		{
+			name:        "image from the default registry",
+			image:       "user/image:latest",
+			repoDigests: `["docker.io/user/image@sha256:ddd"]`,
+			want:        "sha256:ddd",
+		},
+		{
+			name:        "image not pushed",
+			image:       "user/image",
+			repoDigests: `[]`,
+			wantErr:     true,
+		},
+		{
+			name:        "invalid output",
+			image:       "user/image",
+			repoDigests: `<no value>`,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getDigestFromRepoDigests(tt.image, []byte(tt.repoDigests))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getDigestFromRepoDigests() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("getDigestFromRepoDigests() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
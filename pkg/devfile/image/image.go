@@ -2,23 +2,43 @@
 package image
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"sync"
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/util"
 )
 
+// BuildOptions are the options of the builds of the images, common to all the images
+type BuildOptions struct {
+	// CacheFrom are the external sources of the build cache, for example images or registry caches
+	CacheFrom []string
+	// CacheTo is the external destination where the build cache is exported, for example a registry cache
+	CacheTo string
+	// Platforms are the target platforms of the images, as os/arch[/variant]. The platform of the host is used if empty
+	Platforms []string
+}
+
 // Backend is in interface that must be implemented by container runtimes
 type Backend interface {
-	// Build the image as defined in the devfile
-	Build(image *devfile.ImageComponent, devfilePath string) error
-	// Push the image to its registry as defined in the devfile
-	Push(image string) error
+	// Build the image as defined in the devfile, with the build options, writing the output of the build to out and errOut
+	Build(image *devfile.ImageComponent, devfilePath string, options BuildOptions, out io.Writer, errOut io.Writer) error
+	// Push the image to its registry as defined in the devfile, writing the output of the push to out and errOut.
+	// The options are the options used to build the image
+	Push(image string, options BuildOptions, out io.Writer, errOut io.Writer) error
+	// ValidateOptions returns an error if the build options are not supported by the backend
+	ValidateOptions(options BuildOptions) error
+	// Digest returns the digest of the image in its registry, once pushed
+	Digest(image string) (string, error)
 	// Return the name of the backend
 	String() string
 }
@@ -27,13 +47,19 @@ var lookPathCmd = exec.LookPath
 var getEnvFunc = os.Getenv
 
 // BuildPushImages build all images defined in the devfile with the detected backend
-// If push is true, also push the images to their registries
-func BuildPushImages(devfileObj parser.DevfileObj, path string, push bool) error {
+// If push is true, also push the images to their registries.
+// The images are built after the images they depend on, and the images not depending on each other are built concurrently,
+// parallelism images at most at the same time
+func BuildPushImages(devfileObj parser.DevfileObj, path string, push bool, options BuildOptions, parallelism int) error {
 
 	backend, err := selectBackend()
 	if err != nil {
 		return err
 	}
+	err = backend.ValidateOptions(options)
+	if err != nil {
+		return err
+	}
 
 	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
 		ComponentOptions: common.ComponentOptions{ComponentType: devfile.ImageComponentType},
@@ -45,8 +71,28 @@ func BuildPushImages(devfileObj parser.DevfileObj, path string, push bool) error
 		return libdevfile.NewComponentTypeNotFoundError(devfile.ImageComponentType)
 	}
 
+	waves, err := getBuildWaves(components, path)
+	if err != nil {
+		return err
+	}
+	for _, wave := range waves {
+		if parallelism <= 1 || len(wave) == 1 {
+			err = buildPushImagesSequentially(backend, wave, path, push, options)
+		} else {
+			err = buildPushImagesConcurrently(backend, wave, path, push, options, parallelism)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildPushImagesSequentially builds the images one after the other, displaying the output of the builds as they run
+func buildPushImagesSequentially(backend Backend, components []devfile.Component, path string, push bool, options BuildOptions) error {
 	for _, component := range components {
-		err = buildPushImage(backend, component.Image, path, push)
+		log.Sectionf("Building & Pushing Container: %s", component.Image.ImageName)
+		err := buildPushImage(backend, component.Image, path, push, options, log.GetStdout(), log.GetStderr())
 		if err != nil {
 			return err
 		}
@@ -54,30 +100,66 @@ func BuildPushImages(devfileObj parser.DevfileObj, path string, push bool) error
 	return nil
 }
 
-// BuildPushSpecificImage build an image defined in the devfile
+// buildPushImagesConcurrently builds the images concurrently, parallelism images at most at the same time.
+// The output of the build of each image is displayed once the image is built
+func buildPushImagesConcurrently(backend Backend, components []devfile.Component, path string, push bool, options BuildOptions, parallelism int) error {
+	var mu sync.Mutex
+	tasks := util.NewConcurrentTasksWithLimit(len(components), parallelism)
+	for _, component := range components {
+		component := component
+		tasks.Add(util.ConcurrentTask{ToRun: func(errChannel chan error) {
+			var out bytes.Buffer
+			err := buildPushImage(backend, component.Image, path, push, options, &out, &out)
+
+			mu.Lock()
+			log.Sectionf("Building & Pushing Container: %s", component.Image.ImageName)
+			_, _ = log.GetStdout().Write(out.Bytes())
+			mu.Unlock()
+			if err != nil {
+				errChannel <- fmt.Errorf("failed to build the image %q: %w", component.Image.ImageName, err)
+			}
+		}})
+	}
+	return tasks.Run()
+}
+
+// BuildPushSpecificImage build an image defined in the devfile, with the build options
 // If push is true, also push the image to its registry
-func BuildPushSpecificImage(devfileObj parser.DevfileObj, devfilePath string, component devfile.Component, push bool) error {
+func BuildPushSpecificImage(devfileObj parser.DevfileObj, devfilePath string, component devfile.Component, push bool, options BuildOptions) error {
 	backend, err := selectBackend()
 	if err != nil {
 		return err
 	}
+	err = backend.ValidateOptions(options)
+	if err != nil {
+		return err
+	}
+
+	log.Sectionf("Building & Pushing Container: %s", component.Image.ImageName)
+	return buildPushImage(backend, component.Image, devfilePath, push, options, log.GetStdout(), log.GetStderr())
+}
 
-	return buildPushImage(backend, component.Image, devfilePath, push)
+// GetPushedImageDigest returns the digest of an image pushed to its registry, using the detected backend
+func GetPushedImageDigest(imageName string) (string, error) {
+	backend, err := selectBackend()
+	if err != nil {
+		return "", err
+	}
+	return backend.Digest(imageName)
 }
 
-// buildPushImage build an image using the provided backend
+// buildPushImage build an image using the provided backend, writing the output to out and errOut
 // If push is true, also push the image to its registry
-func buildPushImage(backend Backend, image *devfile.ImageComponent, devfilePath string, push bool) error {
+func buildPushImage(backend Backend, image *devfile.ImageComponent, devfilePath string, push bool, options BuildOptions, out io.Writer, errOut io.Writer) error {
 	if image == nil {
 		return errors.New("image should not be nil")
 	}
-	log.Sectionf("Building & Pushing Container: %s", image.ImageName)
-	err := backend.Build(image, devfilePath)
+	err := backend.Build(image, devfilePath, options, out, errOut)
 	if err != nil {
 		return err
 	}
 	if push {
-		err = backend.Push(image.ImageName)
+		err = backend.Push(image.ImageName, options, out, errOut)
 		if err != nil {
 			return err
 		}
@@ -110,7 +192,7 @@ func selectBackend() (Backend, error) {
 			log.Warning("WARNING: Building images on Apple Silicon / M1 is not (yet) supported natively on Podman")
 			log.Warning("There is however a temporary workaround: https://github.com/containers/podman/discussions/12899")
 		}
-		return NewDockerCompatibleBackend(podmanCmd), nil
+		return NewPodmanBackend(podmanCmd), nil
 	}
 
 	dockerCmd := getEnvFunc("DOCKER_CMD")
@@ -2,6 +2,7 @@ package image
 
 import (
 	"errors"
+	"io"
 	"os"
 	"os/exec"
 	"testing"
@@ -95,16 +96,16 @@ func TestBuildPushImage(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			backend := NewMockBackend(ctrl)
 			if tt.wantBuildCalled {
-				backend.EXPECT().Build(tt.image, tt.devfilePath).Return(tt.BuildReturns).Times(1)
+				backend.EXPECT().Build(tt.image, tt.devfilePath, BuildOptions{}, gomock.Any(), gomock.Any()).Return(tt.BuildReturns).Times(1)
 			} else {
-				backend.EXPECT().Build(nil, tt.devfilePath).Times(0)
+				backend.EXPECT().Build(nil, tt.devfilePath, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			}
 			if tt.wantPushCalled {
-				backend.EXPECT().Push(tt.image.ImageName).Return(tt.PushReturns).Times(1)
+				backend.EXPECT().Push(tt.image.ImageName, BuildOptions{}, gomock.Any(), gomock.Any()).Return(tt.PushReturns).Times(1)
 			} else {
-				backend.EXPECT().Push(nil).Times(0)
+				backend.EXPECT().Push(nil, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			}
-			err := buildPushImage(backend, tt.image, "", tt.push)
+			err := buildPushImage(backend, tt.image, "", tt.push, BuildOptions{}, io.Discard, io.Discard)
 
 			if tt.wantErr != (err != nil) {
 				t.Errorf("%s: Error result wanted %v, got %v", tt.name, tt.wantErr, err != nil)
@@ -5,6 +5,7 @@
 package image
 
 import (
+	io "io"
 	reflect "reflect"
 
 	v1alpha2 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -35,31 +36,46 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 }
 
 // Build mocks base method.
-func (m *MockBackend) Build(image *v1alpha2.ImageComponent, devfilePath string) error {
+func (m *MockBackend) Build(image *v1alpha2.ImageComponent, devfilePath string, options BuildOptions, out, errOut io.Writer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Build", image, devfilePath)
+	ret := m.ctrl.Call(m, "Build", image, devfilePath, options, out, errOut)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Build indicates an expected call of Build.
-func (mr *MockBackendMockRecorder) Build(image, devfilePath interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) Build(image, devfilePath, options, out, errOut interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockBackend)(nil).Build), image, devfilePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockBackend)(nil).Build), image, devfilePath, options, out, errOut)
+}
+
+// Digest mocks base method.
+func (m *MockBackend) Digest(image string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Digest", image)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Digest indicates an expected call of Digest.
+func (mr *MockBackendMockRecorder) Digest(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Digest", reflect.TypeOf((*MockBackend)(nil).Digest), image)
 }
 
 // Push mocks base method.
-func (m *MockBackend) Push(image string) error {
+func (m *MockBackend) Push(image string, options BuildOptions, out, errOut io.Writer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Push", image)
+	ret := m.ctrl.Call(m, "Push", image, options, out, errOut)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Push indicates an expected call of Push.
-func (mr *MockBackendMockRecorder) Push(image interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) Push(image, options, out, errOut interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockBackend)(nil).Push), image)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockBackend)(nil).Push), image, options, out, errOut)
 }
 
 // String mocks base method.
@@ -75,3 +91,17 @@ func (mr *MockBackendMockRecorder) String() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "String", reflect.TypeOf((*MockBackend)(nil).String))
 }
+
+// ValidateOptions mocks base method.
+func (m *MockBackend) ValidateOptions(options BuildOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateOptions", options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateOptions indicates an expected call of ValidateOptions.
+func (mr *MockBackendMockRecorder) ValidateOptions(options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateOptions", reflect.TypeOf((*MockBackend)(nil).ValidateOptions), options)
+}
@@ -0,0 +1,142 @@
+package image
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// TagStrategy defines how the tag of the images built is generated
+type TagStrategy string
+
+const (
+	// TagStrategyNone keeps the name of the image as written in the devfile
+	TagStrategyNone TagStrategy = ""
+	// TagStrategyGit tags the image with the commit checked out in the directory of the devfile
+	TagStrategyGit TagStrategy = "git"
+	// TagStrategyTimestamp tags the image with the UTC date and time of the build
+	TagStrategyTimestamp TagStrategy = "timestamp"
+	// TagStrategyContent tags the image with a hash of the Dockerfile and of the content of the build context
+	TagStrategyContent TagStrategy = "content"
+)
+
+// tagLength is the number of characters of the commits and hashes used as tags
+const tagLength = 12
+
+var nowFunc = time.Now
+
+// TagStrategies are the supported strategies to generate tags
+var TagStrategies = []TagStrategy{TagStrategyGit, TagStrategyTimestamp, TagStrategyContent}
+
+// ValidateTagStrategy returns an error if the strategy is not supported
+func ValidateTagStrategy(strategy TagStrategy) error {
+	if strategy == TagStrategyNone {
+		return nil
+	}
+	for _, s := range TagStrategies {
+		if strategy == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported image tag %q, supported values are %v", strategy, TagStrategies)
+}
+
+// GetTaggedImageName returns the name of the image, with its tag replaced by a tag generated with the strategy
+func GetTaggedImageName(strategy TagStrategy, image *devfile.ImageComponent, devfilePath string) (string, error) {
+	var tag string
+	switch strategy {
+	case TagStrategyNone:
+		return image.ImageName, nil
+	case TagStrategyGit:
+		tag = util.GetGitCommit(devfilePath)
+		if tag == "" {
+			return "", fmt.Errorf("unable to tag the image %q with the git commit, %q is not in a git repository", image.ImageName, devfilePath)
+		}
+		tag = tag[:tagLength]
+	case TagStrategyTimestamp:
+		tag = nowFunc().UTC().Format("20060102150405")
+	case TagStrategyContent:
+		hash, err := getBuildContentHash(image, devfilePath)
+		if err != nil {
+			return "", fmt.Errorf("unable to tag the image %q with the hash of its content: %w", image.ImageName, err)
+		}
+		tag = hash[:tagLength]
+	default:
+		return "", ValidateTagStrategy(strategy)
+	}
+	return GetImageRepository(image.ImageName) + ":" + tag, nil
+}
+
+// GetImageRepository returns the name of the image, without its tag or digest,
+// as the references to the images are matched when rendering the Kubernetes components
+func GetImageRepository(imageName string) string {
+	return libdevfile.GetImageRepository(imageName)
+}
+
+// getBuildContentHash returns the SHA-256 hash of the Dockerfile and of the paths and contents of the files of the build context.
+// The .git and .odo directories are ignored
+func getBuildContentHash(image *devfile.ImageComponent, devfilePath string) (string, error) {
+	if image.Dockerfile == nil {
+		return "", errors.New("the image is not built from a Dockerfile")
+	}
+	if strings.HasPrefix(image.Dockerfile.Uri, "http") {
+		return "", errors.New("HTTP URL for uri is not supported")
+	}
+	hash := sha256.New()
+	err := hashFile(hash, filepath.Join(devfilePath, image.Dockerfile.Uri))
+	if err != nil {
+		return "", err
+	}
+
+	buildContext := getBuildContextPath(image.Dockerfile.BuildContext, devfilePath)
+	err = filepath.Walk(buildContext, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && (info.Name() == ".git" || info.Name() == ".odo") {
+			return filepath.SkipDir
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(buildContext, path)
+		if err != nil {
+			return err
+		}
+		_, _ = io.WriteString(hash, filepath.ToSlash(rel)+"\x00")
+		return hashFile(hash, path)
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+// getBuildContextPath returns the path of the build context, resolving the variables set by odo when building the image
+func getBuildContextPath(buildContext string, devfilePath string) string {
+	buildContext = strings.NewReplacer("${PROJECTS_ROOT}", devfilePath, "${PROJECT_SOURCE}", devfilePath).Replace(buildContext)
+	if buildContext == "" || !filepath.IsAbs(buildContext) {
+		return filepath.Join(devfilePath, buildContext)
+	}
+	return buildContext
+}
+
+func hashFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
@@ -0,0 +1,118 @@
+package image
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+)
+
+func getDockerfileImage(imageName string, buildContext string) *devfile.ImageComponent {
+	return &devfile.ImageComponent{
+		Image: devfile.Image{
+			ImageName: imageName,
+			ImageUnion: devfile.ImageUnion{
+				Dockerfile: &devfile.DockerfileImage{
+					DockerfileSrc: devfile.DockerfileSrc{Uri: "Dockerfile"},
+					Dockerfile:    devfile.Dockerfile{BuildContext: buildContext},
+				},
+			},
+		},
+	}
+}
+
+func TestGetTaggedImageName(t *testing.T) {
+	nowFunc = func() time.Time {
+		return time.Date(2022, 3, 1, 10, 20, 30, 0, time.UTC)
+	}
+	defer func() { nowFunc = time.Now }()
+
+	tests := []struct {
+		name     string
+		strategy TagStrategy
+		image    string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "no strategy",
+			strategy: TagStrategyNone,
+			image:    "quay.io/user/image:latest",
+			want:     "quay.io/user/image:latest",
+		},
+		{
+			name:     "timestamp replacing the tag",
+			strategy: TagStrategyTimestamp,
+			image:    "localhost:5000/image:latest",
+			want:     "localhost:5000/image:20220301102030",
+		},
+		{
+			name:     "timestamp on an image without tag",
+			strategy: TagStrategyTimestamp,
+			image:    "localhost:5000/image",
+			want:     "localhost:5000/image:20220301102030",
+		},
+		{
+			name:     "git outside of a git repository",
+			strategy: TagStrategyGit,
+			image:    "quay.io/user/image",
+			wantErr:  true,
+		},
+		{
+			name:     "unknown strategy",
+			strategy: "semver",
+			image:    "quay.io/user/image",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetTaggedImageName(tt.strategy, getDockerfileImage(tt.image, ""), t.TempDir())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetTaggedImageName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetTaggedImageName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTaggedImageNameContent(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	getTag := func() string {
+		got, err := GetTaggedImageName(TagStrategyContent, getDockerfileImage("quay.io/user/image:latest", "${PROJECTS_ROOT}"), dir)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return got
+	}
+
+	write("Dockerfile", "FROM scratch")
+	write("src/main.go", "package main")
+	tag := getTag()
+	if len(tag) != len("quay.io/user/image:")+tagLength {
+		t.Errorf("unexpected tagged image %q", tag)
+	}
+
+	write(".git/HEAD", "ref: refs/heads/main")
+	if got := getTag(); got != tag {
+		t.Errorf("the tag changed when the .git directory changed: %q, was %q", got, tag)
+	}
+
+	write("src/main.go", "package main\n")
+	if got := getTag(); got == tag {
+		t.Errorf("the tag did not change when the build context changed")
+	}
+}
@@ -0,0 +1,466 @@
+package validate
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	yamlv3 "gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog"
+	protovalidation "k8s.io/kube-openapi/pkg/util/proto/validation"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// KubernetesComponentError is an error found in the manifest of a Kubernetes component of the devfile
+type KubernetesComponentError struct {
+	// Component is the name of the Kubernetes component
+	Component string
+	// File is the file containing the manifest: the devfile for an inlined manifest, the URI otherwise
+	File string
+	// Line is the line of the error in File, 0 if unknown
+	Line int
+	Err  error
+}
+
+func (e KubernetesComponentError) Error() string {
+	location := e.File
+	if e.Line > 0 {
+		location = fmt.Sprintf("%s:%d", e.File, e.Line)
+	}
+	return fmt.Sprintf("%s: component %q: %v", location, e.Component, e.Err)
+}
+
+// KubernetesComponentsError is returned when errors are found in the manifests of the Kubernetes components of the devfile
+type KubernetesComponentsError struct {
+	Errors []KubernetesComponentError
+}
+
+func (e *KubernetesComponentsError) Error() string {
+	var lines []string
+	for _, err := range e.Errors {
+		lines = append(lines, " - "+err.Error())
+	}
+	return fmt.Sprintf("%d error(s) found in the Kubernetes components of the devfile:\n%s", len(e.Errors), strings.Join(lines, "\n"))
+}
+
+// fieldError is an error found on a field of a manifest
+type fieldError struct {
+	// path of the field in the manifest, made of field names and indexes of the form [0]
+	path []string
+	err  error
+}
+
+// ValidateKubernetesManifests validates the manifests of the Kubernetes components of the devfile, without creating them.
+// The manifests are validated against the OpenAPI schema of the cluster if client is not nil and the schema can be
+// retrieved, against the definitions of the Kubernetes types known by odo otherwise, as no OpenAPI schema is bundled:
+// only the unknown fields and the values of a wrong type are then detected.
+// A *KubernetesComponentsError listing all the errors found is returned
+func ValidateKubernetesManifests(client kclient.ClientInterface, devfileObj parser.DevfileObj, context string) error {
+	var resources openapi.Resources
+	if client != nil {
+		var err error
+		resources, err = client.GetOpenAPIResources()
+		if err != nil {
+			klog.V(2).Infof("unable to get the OpenAPI schema of the cluster: %v", err)
+			resources = nil
+		}
+	}
+	if resources == nil {
+		log.Info("Validating the Kubernetes components against the schemas of the Kubernetes types known by odo")
+	} else {
+		log.Info("Validating the Kubernetes components against the schema of the cluster")
+	}
+
+	errs, err := ValidateKubernetesComponents(devfileObj, context, resources, devfilefs.DefaultFs{})
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return &KubernetesComponentsError{Errors: errs}
+	}
+	return nil
+}
+
+// ValidateKubernetesComponents validates the manifests of the Kubernetes components of the devfile and returns all the errors found.
+// The manifests are validated against the OpenAPI resources if not nil, against the Kubernetes types of the client-go scheme otherwise;
+// the resources of kinds not defined are only checked for their names and labels
+func ValidateKubernetesComponents(devfileObj parser.DevfileObj, context string, resources openapi.Resources, fs devfilefs.Filesystem) ([]KubernetesComponentError, error) {
+	components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.KubernetesComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	devfilePath := devfileObj.Ctx.GetAbsPath()
+	inlinedLines := getInlinedManifestLines(devfilePath, fs)
+	devfileFile := relativePath(context, devfilePath)
+
+	values, err := libdevfile.GetK8sRenderValues(devfileObj, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []KubernetesComponentError
+	for _, component := range components {
+		file, offset := devfileFile, 0
+		if component.Kubernetes.Uri != "" {
+			file = component.Kubernetes.Uri
+		} else if line, ok := inlinedLines[component.Name]; ok {
+			offset = line
+		}
+		// the lines of the resources rendered from a Helm chart or a Kustomize directory are not reported
+		rendered := libdevfile.IsK8sComponentRendered(component.Kubernetes, context, fs)
+		newError := func(line int, err error) KubernetesComponentError {
+			if line > 0 && !rendered && (offset > 0 || component.Kubernetes.Uri != "") {
+				line += offset
+			} else {
+				line = 0
+			}
+			return KubernetesComponentError{Component: component.Name, File: file, Line: line, Err: err}
+		}
+
+		toValidate := []v1alpha2.Component{component}
+		if rendered {
+			toValidate, err = libdevfile.RenderK8sComponents(toValidate, context, fs, values)
+			if err != nil {
+				errs = append(errs, newError(0, err))
+				continue
+			}
+		}
+		for _, c := range toValidate {
+			errs = append(errs, validateK8sComponent(c, context, fs, resources, newError)...)
+		}
+	}
+	return errs, nil
+}
+
+// validateK8sComponent validates the manifest of a Kubernetes component, and returns the errors created by newError
+// with the line of the manifest where the error is found
+func validateK8sComponent(component v1alpha2.Component, context string, fs devfilefs.Filesystem, resources openapi.Resources, newError func(line int, err error) KubernetesComponentError) []KubernetesComponentError {
+	manifest, err := libdevfile.GetK8sComponentManifest(component.Kubernetes, context, fs)
+	if err != nil {
+		return []KubernetesComponentError{newError(0, err)}
+	}
+
+	u, err := libdevfile.GetK8sComponentAsUnstructured(component.Kubernetes, context, fs)
+	if err != nil {
+		return []KubernetesComponentError{newError(getYAMLErrorLine(err), err)}
+	}
+
+	var root yamlv3.Node
+	_ = yamlv3.Unmarshal([]byte(manifest), &root)
+
+	var fieldErrs []fieldError
+	fieldErrs = append(fieldErrs, validateMetadata(u)...)
+	if u.GetKind() != "" && u.GetAPIVersion() != "" {
+		if resources != nil {
+			fieldErrs = append(fieldErrs, validateWithOpenAPI(u, resources)...)
+		} else {
+			fieldErrs = append(fieldErrs, validateWithScheme(u)...)
+		}
+	}
+	var errs []KubernetesComponentError
+	for _, fieldErr := range fieldErrs {
+		errs = append(errs, newError(getFieldLine(&root, fieldErr.path), fieldErr.err))
+	}
+	return errs
+}
+
+// validateMetadata checks the kind, API version, name and labels of a resource
+func validateMetadata(u unstructured.Unstructured) []fieldError {
+	var errs []fieldError
+	if u.GetAPIVersion() == "" {
+		errs = append(errs, fieldError{err: fmt.Errorf("apiVersion is required")})
+	}
+	if u.GetKind() == "" {
+		errs = append(errs, fieldError{err: fmt.Errorf("kind is required")})
+	}
+
+	name := u.GetName()
+	if name == "" {
+		if u.GetGenerateName() == "" {
+			errs = append(errs, fieldError{path: []string{"metadata"}, err: fmt.Errorf("metadata.name is required")})
+		}
+	} else {
+		// Services names are used as DNS labels, other resources names as DNS subdomains
+		nameErrs := k8svalidation.IsDNS1123Subdomain(name)
+		if u.GetKind() == "Service" {
+			nameErrs = k8svalidation.IsDNS1035Label(name)
+		}
+		for _, nameErr := range nameErrs {
+			errs = append(errs, fieldError{path: []string{"metadata", "name"}, err: fmt.Errorf("invalid name %q: %s", name, nameErr)})
+		}
+	}
+
+	labels := u.GetLabels()
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := labels[key]
+		for _, keyErr := range k8svalidation.IsQualifiedName(key) {
+			errs = append(errs, fieldError{path: []string{"metadata", "labels", key}, err: fmt.Errorf("invalid label key %q: %s", key, keyErr)})
+		}
+		for _, valueErr := range k8svalidation.IsValidLabelValue(value) {
+			errs = append(errs, fieldError{path: []string{"metadata", "labels", key}, err: fmt.Errorf("invalid value %q for label %q: %s", value, key, valueErr)})
+		}
+	}
+	return errs
+}
+
+// validateWithOpenAPI validates a resource against the OpenAPI definition of its kind, if the kind is defined
+func validateWithOpenAPI(u unstructured.Unstructured, resources openapi.Resources) []fieldError {
+	resource := resources.LookupResource(u.GroupVersionKind())
+	if resource == nil {
+		klog.V(4).Infof("no OpenAPI definition for %s, skipping its validation", u.GroupVersionKind())
+		return nil
+	}
+	var errs []fieldError
+	for _, err := range protovalidation.ValidateModel(u.Object, resource, u.GetKind()) {
+		validationErr, ok := err.(protovalidation.ValidationError)
+		if !ok {
+			errs = append(errs, fieldError{err: err})
+			continue
+		}
+		path := parseValidationPath(validationErr.Path)
+		if unknownField, ok := validationErr.Err.(protovalidation.UnknownFieldError); ok {
+			path = append(path, unknownField.Field)
+		}
+		errs = append(errs, fieldError{path: path, err: validationErr})
+	}
+	return errs
+}
+
+// validationPathRegexp matches the fields and indexes of a path of the OpenAPI validation, of the form Kind.field[0].field
+var validationPathRegexp = regexp.MustCompile(`\.([^.\[\]]+)|(\[\d+\])`)
+
+// parseValidationPath returns the fields and indexes of a path of the OpenAPI validation
+func parseValidationPath(path string) []string {
+	var result []string
+	for _, match := range validationPathRegexp.FindAllStringSubmatch(path, -1) {
+		if match[1] != "" {
+			result = append(result, match[1])
+		} else {
+			result = append(result, match[2])
+		}
+	}
+	return result
+}
+
+// validateWithScheme validates a resource against the Go type of its kind in the client-go scheme, if the kind is registered.
+// The resource is converted into its type and back, the fields lost during the conversion are unknown fields
+func validateWithScheme(u unstructured.Unstructured) []fieldError {
+	obj, err := scheme.Scheme.New(u.GroupVersionKind())
+	if err != nil {
+		klog.V(4).Infof("%s is not a kind known by odo, skipping its validation", u.GroupVersionKind())
+		return nil
+	}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
+	if err != nil {
+		return []fieldError{{err: fmt.Errorf("invalid %s: %w", u.GetKind(), err)}}
+	}
+	converted, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return []fieldError{{err: fmt.Errorf("invalid %s: %w", u.GetKind(), err)}}
+	}
+	return getUnknownFields(u.GetKind(), u.Object, converted, nil)
+}
+
+// getUnknownFields returns an error for each field of original not present in converted.
+// The fields with empty values are ignored, as they are omitted during the conversion
+func getUnknownFields(kind string, original, converted interface{}, path []string) []fieldError {
+	var errs []fieldError
+	switch o := original.(type) {
+	case map[string]interface{}:
+		c, ok := converted.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		keys := make([]string, 0, len(o))
+		for key := range o {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := o[key]
+			fieldPath := append(append([]string{}, path...), key)
+			convertedValue, found := c[key]
+			if !found {
+				if !isEmptyValue(value) {
+					errs = append(errs, fieldError{
+						path: fieldPath,
+						err:  fmt.Errorf("unknown field %q in %s", key, formatFieldPath(kind, path)),
+					})
+				}
+				continue
+			}
+			errs = append(errs, getUnknownFields(kind, value, convertedValue, fieldPath)...)
+		}
+	case []interface{}:
+		c, ok := converted.([]interface{})
+		if !ok {
+			return nil
+		}
+		for i := range o {
+			if i >= len(c) {
+				break
+			}
+			errs = append(errs, getUnknownFields(kind, o[i], c[i], append(append([]string{}, path...), fmt.Sprintf("[%d]", i)))...)
+		}
+	}
+	return errs
+}
+
+// formatFieldPath returns the path of a field of a resource of the given kind, of the form Kind.field[0].field
+func formatFieldPath(kind string, path []string) string {
+	result := kind
+	for _, element := range path {
+		if strings.HasPrefix(element, "[") {
+			result += element
+		} else {
+			result += "." + element
+		}
+	}
+	return result
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case int64:
+		return v == 0
+	}
+	return false
+}
+
+// yamlErrorLineRegexp matches the line number of a YAML parsing error
+var yamlErrorLineRegexp = regexp.MustCompile(`yaml: line (\d+):`)
+
+// getYAMLErrorLine returns the line of a YAML parsing error, 0 if unknown
+func getYAMLErrorLine(err error) int {
+	match := yamlErrorLineRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0
+	}
+	line, _ := strconv.Atoi(match[1])
+	return line
+}
+
+// getFieldLine returns the line of the field at path in the YAML document, or the line of its deepest parent found
+func getFieldLine(root *yamlv3.Node, path []string) int {
+	if root.Kind != yamlv3.DocumentNode || len(root.Content) == 0 {
+		return 0
+	}
+	node := root.Content[0]
+	line := node.Line
+	for _, element := range path {
+		var next *yamlv3.Node
+		switch node.Kind {
+		case yamlv3.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == element {
+					line = node.Content[i].Line
+					next = node.Content[i+1]
+					break
+				}
+			}
+		case yamlv3.SequenceNode:
+			if strings.HasPrefix(element, "[") {
+				index, err := strconv.Atoi(strings.Trim(element, "[]"))
+				if err == nil && index < len(node.Content) {
+					next = node.Content[index]
+					line = next.Line
+				}
+			}
+		}
+		if next == nil {
+			break
+		}
+		node = next
+	}
+	return line
+}
+
+// getInlinedManifestLines returns, for each Kubernetes component inlined in the devfile, the line of the devfile preceding
+// its manifest, so the line of the manifest n is the line of the devfile offset+n.
+// Only the manifests written as literal blocks are returned, as the lines of other styles cannot be mapped
+func getInlinedManifestLines(devfilePath string, fs devfilefs.Filesystem) map[string]int {
+	result := map[string]int{}
+	content, err := fs.ReadFile(devfilePath)
+	if err != nil {
+		return result
+	}
+	var root yamlv3.Node
+	if err = yamlv3.Unmarshal(content, &root); err != nil {
+		return result
+	}
+	components := getMappingValue(root.Content, "components")
+	if components == nil || components.Kind != yamlv3.SequenceNode {
+		return result
+	}
+	for _, component := range components.Content {
+		name := getMappingValue([]*yamlv3.Node{component}, "name")
+		kubernetes := getMappingValue([]*yamlv3.Node{component}, "kubernetes")
+		if name == nil || kubernetes == nil {
+			continue
+		}
+		inlined := getMappingValue([]*yamlv3.Node{kubernetes}, "inlined")
+		if inlined == nil || inlined.Style != yamlv3.LiteralStyle {
+			continue
+		}
+		result[name.Value] = inlined.Line
+	}
+	return result
+}
+
+// getMappingValue returns the value of the key in the first mapping of nodes
+func getMappingValue(nodes []*yamlv3.Node, key string) *yamlv3.Node {
+	if len(nodes) == 0 || nodes[0].Kind != yamlv3.MappingNode {
+		return nil
+	}
+	node := nodes[0]
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// relativePath returns the path relative to the context if possible, the path itself otherwise
+func relativePath(context, path string) string {
+	rel, err := filepath.Rel(context, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+	return rel
+}
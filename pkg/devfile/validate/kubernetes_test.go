@@ -0,0 +1,232 @@
+package validate
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	devfileCtx "github.com/devfile/library/pkg/devfile/parser/context"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+)
+
+const validDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-component
+  labels:
+    app: node-app
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: node-app
+  template:
+    metadata:
+      labels:
+        app: node-app
+    spec:
+      containers:
+        - name: main
+          image: quay.io/user/image
+`
+
+const unknownFieldDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-component
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+        - name: main
+          image: quay.io/user/image
+          imagePolicy: Always
+`
+
+const invalidMetadataService = `apiVersion: v1
+kind: Service
+metadata:
+  name: My_Service
+  labels:
+    app: "invalid value!"
+spec:
+  ports:
+    - port: 8080
+`
+
+const invalidYAML = `apiVersion: v1
+kind: Service
+metadata:
+  name: my-service
+ spec: {}
+`
+
+const noKind = `apiVersion: v1
+metadata:
+  name: my-service
+`
+
+// newKubernetesDevfileObj returns a devfile object containing Kubernetes components with the given inlined manifests,
+// written in the devfile of the fake filesystem as literal blocks
+func newKubernetesDevfileObj(t *testing.T, fs devfilefs.Filesystem, contextDir string, manifests map[string]string, uris map[string]string) parser.DevfileObj {
+	devfilePath := filepath.Join(contextDir, "devfile.yaml")
+	devfileContent := "schemaVersion: 2.2.0\nmetadata:\n  name: test\ncomponents:\n"
+
+	var components []devfilev1.Component
+	for _, name := range []string{"first", "second"} {
+		manifest, ok := manifests[name]
+		if !ok {
+			continue
+		}
+		devfileContent += "  - name: " + name + "\n    kubernetes:\n      inlined: |\n"
+		for _, line := range strings.Split(strings.TrimSuffix(manifest, "\n"), "\n") {
+			devfileContent += "        " + line + "\n"
+		}
+		components = append(components, devfilev1.Component{
+			Name: name,
+			ComponentUnion: devfilev1.ComponentUnion{
+				Kubernetes: &devfilev1.KubernetesComponent{
+					K8sLikeComponent: devfilev1.K8sLikeComponent{
+						K8sLikeComponentLocation: devfilev1.K8sLikeComponentLocation{Inlined: manifest},
+					},
+				},
+			},
+		})
+	}
+	for name, uri := range uris {
+		devfileContent += "  - name: " + name + "\n    kubernetes:\n      uri: " + uri + "\n"
+		components = append(components, devfilev1.Component{
+			Name: name,
+			ComponentUnion: devfilev1.ComponentUnion{
+				Kubernetes: &devfilev1.KubernetesComponent{
+					K8sLikeComponent: devfilev1.K8sLikeComponent{
+						K8sLikeComponentLocation: devfilev1.K8sLikeComponentLocation{Uri: uri},
+					},
+				},
+			},
+		})
+	}
+
+	if err := fs.WriteFile(devfilePath, []byte(devfileContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = devfileData.AddComponents(components); err != nil {
+		t.Fatal(err)
+	}
+	return parser.DevfileObj{
+		Ctx:  devfileCtx.FakeContext(fs, devfilePath),
+		Data: devfileData,
+	}
+}
+
+type expectedError struct {
+	component string
+	file      string
+	line      int
+	contains  string
+}
+
+func TestValidateKubernetesComponents(t *testing.T) {
+	contextDir := "/tmp/app"
+
+	tests := []struct {
+		name      string
+		manifests map[string]string
+		uris      map[string]string
+		files     map[string]string
+		want      []expectedError
+	}{
+		{
+			name:      "valid deployment",
+			manifests: map[string]string{"first": validDeployment},
+		},
+		{
+			name:      "unknown field in a container",
+			manifests: map[string]string{"first": validDeployment, "second": unknownFieldDeployment},
+			// line 7 of the devfile is the inlined key of the first component, 29 the one of the second component
+			want: []expectedError{
+				{component: "second", file: "devfile.yaml", line: 29 + 12, contains: `unknown field "imagePolicy"`},
+			},
+		},
+		{
+			name:      "invalid name and label",
+			manifests: map[string]string{"first": invalidMetadataService},
+			want: []expectedError{
+				{component: "first", file: "devfile.yaml", line: 7 + 4, contains: `invalid name "My_Service"`},
+				{component: "first", file: "devfile.yaml", line: 7 + 6, contains: `invalid value "invalid value!" for label "app"`},
+			},
+		},
+		{
+			name:      "invalid YAML",
+			manifests: map[string]string{"first": invalidYAML},
+			want: []expectedError{
+				{component: "first", file: "devfile.yaml", line: 7 + 4, contains: "yaml: line 4"},
+			},
+		},
+		{
+			name:      "missing kind",
+			manifests: map[string]string{"first": noKind},
+			want: []expectedError{
+				{component: "first", file: "devfile.yaml", line: 7 + 1, contains: "kind is required"},
+			},
+		},
+		{
+			name:  "manifest from URI",
+			uris:  map[string]string{"fromuri": "kubernetes/service.yaml"},
+			files: map[string]string{"kubernetes/service.yaml": invalidMetadataService},
+			want: []expectedError{
+				{component: "fromuri", file: "kubernetes/service.yaml", line: 4, contains: `invalid name "My_Service"`},
+				{component: "fromuri", file: "kubernetes/service.yaml", line: 6, contains: `invalid value "invalid value!" for label "app"`},
+			},
+		},
+		{
+			name: "missing URI",
+			uris: map[string]string{"fromuri": "kubernetes/missing.yaml"},
+			want: []expectedError{
+				{component: "fromuri", file: "kubernetes/missing.yaml"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := devfilefs.NewFakeFs()
+			for file, content := range tt.files {
+				if err := fs.WriteFile(filepath.Join(contextDir, file), []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			devfileObj := newKubernetesDevfileObj(t, fs, contextDir, tt.manifests, tt.uris)
+
+			errs, err := ValidateKubernetesComponents(devfileObj, contextDir, nil, fs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []expectedError
+			for _, e := range errs {
+				got = append(got, expectedError{component: e.Component, file: e.File, line: e.Line})
+			}
+			var want []expectedError
+			for _, e := range tt.want {
+				want = append(want, expectedError{component: e.component, file: e.file, line: e.line})
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("got errors %v, want %v (%v)", got, want, errs)
+			}
+			for i, e := range tt.want {
+				if !strings.Contains(errs[i].Err.Error(), e.contains) {
+					t.Errorf("error %q should contain %q", errs[i].Err.Error(), e.contains)
+				}
+			}
+		})
+	}
+}
@@ -305,6 +305,30 @@ func (esi *EnvSpecificInfo) SetRunMode(runMode RUNMode) error {
 	return esi.writeToFile()
 }
 
+// GetVariables returns the default values of the variables of the devfile for the namespace
+func (ei *EnvInfo) GetVariables(namespace string) map[string]string {
+	result := map[string]string{}
+	for name, value := range ei.componentSettings.Variables[namespace] {
+		result[name] = value
+	}
+	return result
+}
+
+// SetVariables sets the default values of the variables of the devfile for the namespace in the env file,
+// keeping the default values of the other variables
+func (esi *EnvSpecificInfo) SetVariables(namespace string, variables map[string]string) error {
+	if esi.componentSettings.Variables == nil {
+		esi.componentSettings.Variables = map[string]map[string]string{}
+	}
+	if esi.componentSettings.Variables[namespace] == nil {
+		esi.componentSettings.Variables[namespace] = map[string]string{}
+	}
+	for name, value := range variables {
+		esi.componentSettings.Variables[namespace][name] = value
+	}
+	return esi.writeToFile()
+}
+
 // GetNamespace returns component namespace
 func (ei *EnvInfo) GetNamespace() string {
 	return ei.componentSettings.Project
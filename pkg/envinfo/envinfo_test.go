@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -260,3 +261,33 @@ func mockEnvSpecificInfo(configDir string, fs filesystem.Filesystem) (*EnvSpecif
 	return esi, nil
 
 }
+
+func TestSetVariables(t *testing.T) {
+	os.Setenv(envInfoEnvName, filepath.Join(t.TempDir(), "env.yaml"))
+	defer os.Unsetenv(envInfoEnvName)
+
+	esi, err := NewEnvSpecificInfo("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = esi.SetVariables("staging", map[string]string{"REPLICAS": "1", "HOST": "staging.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = esi.SetVariables("staging", map[string]string{"REPLICAS": "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	esi, err = NewEnvSpecificInfo("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"REPLICAS": "2", "HOST": "staging.example.com"}
+	if got := esi.GetVariables("staging"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := esi.GetVariables("production"); len(got) != 0 {
+		t.Errorf("got %v for another namespace, want no variable", got)
+	}
+}
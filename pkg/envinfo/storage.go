@@ -1,6 +1,8 @@
 package envinfo
 
 import (
+	"fmt"
+
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/generator"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
@@ -11,13 +13,27 @@ import (
 const (
 	// DefaultVolumeSize Default volume size for volumes defined in a devfile
 	DefaultVolumeSize = "1Gi"
+
+	// StorageClassAttribute is the attribute of a volume component defining the StorageClass of its PVC
+	StorageClassAttribute = "dev.odo.storage-class"
+
+	// AccessModesAttribute is the attribute of a volume component defining the access modes of its PVC,
+	// as a single access mode or a list of access modes
+	AccessModesAttribute = "dev.odo.access-modes"
 )
 
+// volumeInfo is the information of a volume component used to create its PVC
+type volumeInfo struct {
+	devfilev1.Volume
+	storageClass string
+	accessModes  []string
+}
+
 // ListStorage gets all the storage from the devfile.yaml
 func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 	var storageList []localConfigProvider.LocalStorage
 
-	volumeMap := make(map[string]devfilev1.Volume)
+	volumeMap := make(map[string]volumeInfo)
 	components, err := ei.devfileObj.Data.GetComponents(common.DevfileOptions{})
 	if err != nil {
 		return storageList, err
@@ -30,7 +46,12 @@ func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 		if component.Volume.Size == "" {
 			component.Volume.Size = DefaultVolumeSize
 		}
-		volumeMap[component.Name] = component.Volume.Volume
+		info := volumeInfo{Volume: component.Volume.Volume}
+		info.storageClass, info.accessModes, err = getVolumeStorageOptions(component)
+		if err != nil {
+			return storageList, err
+		}
+		volumeMap[component.Name] = info
 	}
 
 	for _, component := range components {
@@ -41,11 +62,13 @@ func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 			vol, ok := volumeMap[volumeMount.Name]
 			if ok {
 				storageList = append(storageList, localConfigProvider.LocalStorage{
-					Name:      volumeMount.Name,
-					Size:      vol.Size,
-					Ephemeral: vol.Ephemeral,
-					Path:      generator.GetVolumeMountPath(volumeMount),
-					Container: component.Name,
+					Name:         volumeMount.Name,
+					Size:         vol.Size,
+					Ephemeral:    vol.Ephemeral,
+					Path:         generator.GetVolumeMountPath(volumeMount),
+					Container:    component.Name,
+					StorageClass: vol.storageClass,
+					AccessModes:  vol.accessModes,
 				})
 			}
 		}
@@ -53,3 +76,26 @@ func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 
 	return storageList, nil
 }
+
+// getVolumeStorageOptions returns the StorageClass and the access modes defined by the attributes of the volume component
+func getVolumeStorageOptions(component devfilev1.Component) (storageClass string, accessModes []string, err error) {
+	if component.Attributes == nil {
+		return "", nil, nil
+	}
+	if component.Attributes.Exists(StorageClassAttribute) {
+		storageClass = component.Attributes.GetString(StorageClassAttribute, &err)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid %q attribute for volume %q: %w", StorageClassAttribute, component.Name, err)
+		}
+	}
+	if component.Attributes.Exists(AccessModesAttribute) {
+		if e := component.Attributes.GetInto(AccessModesAttribute, &accessModes); e != nil {
+			accessMode := component.Attributes.GetString(AccessModesAttribute, &err)
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid %q attribute for volume %q, it must be an access mode or a list of access modes: %w", AccessModesAttribute, component.Name, err)
+			}
+			accessModes = []string{accessMode}
+		}
+	}
+	return storageClass, accessModes, nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
 	"github.com/devfile/library/pkg/devfile/parser"
 	"github.com/devfile/library/pkg/devfile/parser/data"
 	"github.com/redhat-developer/odo/pkg/localConfigProvider"
@@ -222,6 +223,70 @@ func TestEnvInfo_ListStorage(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "case 5: list the volumes with the storage class and access modes of their attributes",
+			fields: fields{
+				devfileObj: parser.DevfileObj{
+					Data: func() data.DevfileData {
+						devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+						if err != nil {
+							t.Error(err)
+						}
+						volume0 := testingutil.GetFakeVolumeComponent("volume-0", "5Gi")
+						volume0.Attributes = attributes.Attributes{}.
+							PutString(StorageClassAttribute, "nfs-client").
+							PutString(AccessModesAttribute, "ReadWriteMany")
+						volume1 := testingutil.GetFakeVolumeComponent("volume-1", "10Gi")
+						volume1.Attributes = attributes.Attributes{}.
+							Put(AccessModesAttribute, []string{"ReadWriteOnce", "ReadOnlyMany"}, nil)
+						err = devfileData.AddComponents([]devfilev1.Component{
+							{
+								Name: "container-0",
+								ComponentUnion: devfilev1.ComponentUnion{
+									Container: &devfilev1.ContainerComponent{
+										Container: devfilev1.Container{
+											VolumeMounts: []devfilev1.VolumeMount{
+												{
+													Name: "volume-0",
+													Path: "/path",
+												},
+												{
+													Name: "volume-1",
+													Path: "/data",
+												},
+											},
+										},
+									},
+								},
+							},
+							volume0,
+							volume1,
+						})
+						if err != nil {
+							t.Error(err)
+						}
+						return devfileData
+					}(),
+				},
+			},
+			want: []localConfigProvider.LocalStorage{
+				{
+					Name:         "volume-0",
+					Size:         "5Gi",
+					Path:         "/path",
+					Container:    "container-0",
+					StorageClass: "nfs-client",
+					AccessModes:  []string{"ReadWriteMany"},
+				},
+				{
+					Name:        "volume-1",
+					Size:        "10Gi",
+					Path:        "/data",
+					Container:   "container-0",
+					AccessModes: []string{"ReadWriteOnce", "ReadOnlyMany"},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -17,4 +17,7 @@ type ComponentSettings struct {
 
 	// RunMode indicates the mode of run used for a successful push
 	RunMode *RUNMode `yaml:"RunMode,omitempty" json:"runMode,omitempty"`
+
+	// Variables are the default values of the variables of the devfile, indexed by namespace
+	Variables map[string]map[string]string `yaml:"Variables,omitempty" json:"variables,omitempty"`
 }
@@ -92,6 +92,24 @@ func (o *Survey) AskCorrect() (bool, error) {
 	return answer, nil
 }
 
+func (o *Survey) AskDevfileCandidate(candidates []string) (bool, int, error) {
+	options := append([]string{}, candidates...)
+	options = append(options, "** NONE OF THESE - SELECT MANUALLY **")
+	question := &survey.Select{
+		Message: "Which of the other detected devfiles do you want to use?",
+		Options: options,
+	}
+	var answer int
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return false, 0, err
+	}
+	if answer == len(options)-1 {
+		return false, 0, nil
+	}
+	return true, answer, nil
+}
+
 // AskPersonalizeConfiguration asks the configuration user wants to change
 func (o *Survey) AskPersonalizeConfiguration(configuration ContainerConfiguration) (OperationOnContainer, error) {
 	options, tracker := buildPersonalizedConfigurationOptions(configuration)
@@ -143,6 +161,20 @@ func (o *Survey) AskAddPort() (string, error) {
 	return newPortAnswer, nil
 }
 
+// AskTemplateValue asks for the value of a variable referenced by a project template
+func (o *Survey) AskTemplateValue(name string, defaultValue string) (string, error) {
+	question := &survey.Input{
+		Message: fmt.Sprintf("Enter value for %q template variable:", name),
+		Default: defaultValue,
+	}
+	var answer string
+	err := survey.AskOne(question, &answer)
+	if err != nil {
+		return "", err
+	}
+	return answer, nil
+}
+
 func (o *Survey) AskContainerName(containers []string) (string, error) {
 	selectContainerQuestion := &survey.Select{
 		Message: "Select container for which you want to change configuration?",
@@ -25,6 +25,10 @@ type Asker interface {
 	// AskCorrect asks for confirmation
 	AskCorrect() (bool, error)
 
+	// AskDevfileCandidate asks for an optional devfile, from a list of detected devfiles. If no devfile is selected, false is returned.
+	// Or the index of the selected devfile is returned
+	AskDevfileCandidate(candidates []string) (selected bool, _ int, _ error)
+
 	AskContainerName(containers []string) (string, error)
 
 	// AskPersonalizeConfiguration asks the configuration user wants to change
@@ -35,6 +39,9 @@ type Asker interface {
 
 	// AskAddPort asks the container name and port that user wants to add
 	AskAddPort() (string, error)
+
+	// AskTemplateValue asks for the value of a variable referenced by a project template
+	AskTemplateValue(name string, defaultValue string) (string, error)
 }
 
 type ContainerConfiguration struct {
@@ -95,6 +95,22 @@ func (mr *MockAskerMockRecorder) AskCorrect() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskCorrect", reflect.TypeOf((*MockAsker)(nil).AskCorrect))
 }
 
+// AskDevfileCandidate mocks base method.
+func (m *MockAsker) AskDevfileCandidate(candidates []string) (bool, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskDevfileCandidate", candidates)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AskDevfileCandidate indicates an expected call of AskDevfileCandidate.
+func (mr *MockAskerMockRecorder) AskDevfileCandidate(candidates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskDevfileCandidate", reflect.TypeOf((*MockAsker)(nil).AskDevfileCandidate), candidates)
+}
+
 // AskLanguage mocks base method.
 func (m *MockAsker) AskLanguage(langs []string) (string, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +172,21 @@ func (mr *MockAskerMockRecorder) AskStarterProject(projects interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskStarterProject", reflect.TypeOf((*MockAsker)(nil).AskStarterProject), projects)
 }
 
+// AskTemplateValue mocks base method.
+func (m *MockAsker) AskTemplateValue(name, defaultValue string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskTemplateValue", name, defaultValue)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskTemplateValue indicates an expected call of AskTemplateValue.
+func (mr *MockAskerMockRecorder) AskTemplateValue(name, defaultValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskTemplateValue", reflect.TypeOf((*MockAsker)(nil).AskTemplateValue), name, defaultValue)
+}
+
 // AskType mocks base method.
 func (m *MockAsker) AskType(types registry.TypesWithDetails) (bool, registry.DevfileStack, error) {
 	m.ctrl.T.Helper()
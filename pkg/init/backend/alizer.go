@@ -2,6 +2,7 @@ package backend
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser"
@@ -26,23 +27,47 @@ func (o *AlizerBackend) Validate(flags map[string]string, fs filesystem.Filesyst
 	return nil
 }
 
-// SelectDevfile calls thz Alizer to detect the devfile and asks for confirmation to the user
+// SelectDevfile calls the Alizer to detect the devfile and asks for confirmation to the user.
+// If the user does not confirm and other devfiles have been detected, the user can select one of them instead
 func (o *AlizerBackend) SelectDevfile(flags map[string]string, fs filesystem.Filesystem, dir string) (location *alizer.DevfileLocation, err error) {
-	selected, registry, err := o.alizerClient.DetectFramework(dir)
+	candidates, err := o.alizerClient.DetectFrameworks(dir)
 	if err != nil {
 		return nil, err
 	}
 
-	fmt.Printf("Based on the files in the current directory odo detected\nLanguage: %s\nProject type: %s\n", selected.Language, selected.ProjectType)
-	fmt.Printf("The devfile %q from the registry %q will be downloaded.\n", selected.Name, registry.Name)
+	selected := candidates[0]
+	fmt.Printf("Based on the files in the current directory odo detected\nLanguage: %s\nProject type: %s\n", selected.Type.Language, selected.Type.ProjectType)
+	fmt.Printf("The devfile %q from the registry %q will be downloaded.\n", selected.Type.Name, selected.Registry.Name)
 	confirm, err := o.askerClient.AskCorrect()
 	if err != nil {
 		return nil, err
 	}
-	if !confirm {
+	if confirm {
+		return alizer.GetDevfileLocationFromDetection(selected.Type, selected.Registry), nil
+	}
+
+	others := candidates[1:]
+	if len(others) == 0 {
+		return nil, nil
+	}
+	labels := make([]string, 0, len(others))
+	for _, other := range others {
+		labels = append(labels, getCandidateLabel(other))
+	}
+	ok, index, err := o.askerClient.AskDevfileCandidate(labels)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
 		return nil, nil
 	}
-	return alizer.GetDevfileLocationFromDetection(selected, registry), nil
+	return alizer.GetDevfileLocationFromDetection(others[index].Type, others[index].Registry), nil
+}
+
+// getCandidateLabel returns the label displayed to the user for a detected devfile
+func getCandidateLabel(candidate alizer.DetectedFramework) string {
+	return fmt.Sprintf("%s from registry %q (Language: %s, Project type: %s, detected in: %s)",
+		candidate.Type.Name, candidate.Registry.Name, candidate.Type.Language, candidate.Type.ProjectType, strings.Join(candidate.Paths, ", "))
 }
 
 func (o *AlizerBackend) SelectStarterProject(devfile parser.DevfileObj, flags map[string]string) (starter *v1alpha2.StarterProject, err error) {
@@ -53,6 +78,6 @@ func (o *AlizerBackend) PersonalizeName(devfile parser.DevfileObj, flags map[str
 	return devfile.GetMetadataName(), nil
 }
 
-func (o *AlizerBackend) PersonalizeDevfileConfig(devfile parser.DevfileObj) (parser.DevfileObj, error) {
+func (o *AlizerBackend) PersonalizeDevfileConfig(devfile parser.DevfileObj, flags map[string]string) (parser.DevfileObj, error) {
 	return devfile, nil
 }
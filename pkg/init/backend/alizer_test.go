@@ -22,6 +22,24 @@ func GetTestProjectPath(folder string) string {
 	return filepath.Join(basepath, "..", "..", "..", "tests/examples/source/", folder)
 }
 
+var candidates = []alizer.DetectedFramework{
+	{
+		Type:     recognizer.DevFileType{Name: "java-maven", Language: "java", ProjectType: "maven"},
+		Registry: registry.Registry{Name: "registry1"},
+		Paths:    []string{"backend"},
+	},
+	{
+		Type:     recognizer.DevFileType{Name: "java-quarkus", Language: "java", ProjectType: "quarkus"},
+		Registry: registry.Registry{Name: "registry1"},
+		Paths:    []string{"backend"},
+	},
+	{
+		Type:     recognizer.DevFileType{Name: "nodejs", Language: "javascript", ProjectType: "nodejs"},
+		Registry: registry.Registry{Name: "registry2"},
+		Paths:    []string{"frontend"},
+	},
+}
+
 func TestAlizerBackend_SelectDevfile(t *testing.T) {
 	type fields struct {
 		askerClient  func(ctrl *gomock.Controller) asker.Asker
@@ -49,10 +67,15 @@ func TestAlizerBackend_SelectDevfile(t *testing.T) {
 				},
 				alizerClient: func(ctrl *gomock.Controller) alizer.Client {
 					alizerClient := alizer.NewMockClient(ctrl)
-					alizerClient.EXPECT().DetectFramework(gomock.Any()).Return(recognizer.DevFileType{
-						Name: "a-devfile-name",
-					}, registry.Registry{
-						Name: "a-registry",
+					alizerClient.EXPECT().DetectFrameworks(gomock.Any()).Return([]alizer.DetectedFramework{
+						{
+							Type: recognizer.DevFileType{
+								Name: "a-devfile-name",
+							},
+							Registry: registry.Registry{
+								Name: "a-registry",
+							},
+						},
 					}, nil)
 					return alizerClient
 				},
@@ -76,7 +99,52 @@ func TestAlizerBackend_SelectDevfile(t *testing.T) {
 				},
 				alizerClient: func(ctrl *gomock.Controller) alizer.Client {
 					alizerClient := alizer.NewMockClient(ctrl)
-					alizerClient.EXPECT().DetectFramework(gomock.Any()).Return(recognizer.DevFileType{}, registry.Registry{}, nil)
+					alizerClient.EXPECT().DetectFrameworks(gomock.Any()).Return([]alizer.DetectedFramework{{}}, nil)
+					return alizerClient
+				},
+			},
+			args: args{
+				fs:  filesystem.DefaultFs{},
+				dir: GetTestProjectPath("nodejs"),
+			},
+			wantLocation: nil,
+		},
+		{
+			name: "devfile found but not accepted, other devfile selected",
+			fields: fields{
+				askerClient: func(ctrl *gomock.Controller) asker.Asker {
+					askerClient := asker.NewMockAsker(ctrl)
+					askerClient.EXPECT().AskCorrect().Return(false, nil)
+					askerClient.EXPECT().AskDevfileCandidate(gomock.Len(2)).Return(true, 1, nil)
+					return askerClient
+				},
+				alizerClient: func(ctrl *gomock.Controller) alizer.Client {
+					alizerClient := alizer.NewMockClient(ctrl)
+					alizerClient.EXPECT().DetectFrameworks(gomock.Any()).Return(candidates, nil)
+					return alizerClient
+				},
+			},
+			args: args{
+				fs:  filesystem.DefaultFs{},
+				dir: GetTestProjectPath("nodejs"),
+			},
+			wantLocation: &alizer.DevfileLocation{
+				Devfile:         "nodejs",
+				DevfileRegistry: "registry2",
+			},
+		},
+		{
+			name: "devfile found but not accepted, no other devfile selected",
+			fields: fields{
+				askerClient: func(ctrl *gomock.Controller) asker.Asker {
+					askerClient := asker.NewMockAsker(ctrl)
+					askerClient.EXPECT().AskCorrect().Return(false, nil)
+					askerClient.EXPECT().AskDevfileCandidate(gomock.Len(2)).Return(false, 0, nil)
+					return askerClient
+				},
+				alizerClient: func(ctrl *gomock.Controller) alizer.Client {
+					alizerClient := alizer.NewMockClient(ctrl)
+					alizerClient.EXPECT().DetectFrameworks(gomock.Any()).Return(candidates, nil)
 					return alizerClient
 				},
 			},
@@ -86,7 +154,6 @@ func TestAlizerBackend_SelectDevfile(t *testing.T) {
 			},
 			wantLocation: nil,
 		},
-		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
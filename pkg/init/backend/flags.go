@@ -1,13 +1,17 @@
 package backend
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	dfutil "github.com/devfile/library/pkg/util"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/redhat-developer/odo/pkg/alizer"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
@@ -21,6 +25,12 @@ const (
 	FLAG_DEVFILE_REGISTRY = "devfile-registry"
 	FLAG_STARTER          = "starter"
 	FLAG_DEVFILE_PATH     = "devfile-path"
+	FLAG_PORT             = "port"
+	FLAG_REMOVE_PORT      = "remove-port"
+	FLAG_ENV              = "env"
+	FLAG_MEMORY           = "memory"
+	FLAG_CPU              = "cpu"
+	FLAG_TEMPLATE         = "template"
 )
 
 // FlagsBackend is a backend that will extract all needed information from flags passed to the command
@@ -35,6 +45,9 @@ func NewFlagsBackend(preferenceClient preference.Client) *FlagsBackend {
 }
 
 func (o *FlagsBackend) Validate(flags map[string]string, fs filesystem.Filesystem, dir string) error {
+	if flags[FLAG_TEMPLATE] != "" {
+		return o.validateTemplate(flags, fs, dir)
+	}
 	if flags[FLAG_NAME] == "" {
 		return errors.New("missing --name parameter: please add --name <name> to specify a name for the component")
 	}
@@ -58,6 +71,11 @@ func (o *FlagsBackend) Validate(flags map[string]string, fs filesystem.Filesyste
 		return err
 	}
 
+	err = validatePersonalizationFlags(flags)
+	if err != nil {
+		return err
+	}
+
 	empty, err := location.DirIsEmpty(fs, dir)
 	if err != nil {
 		return err
@@ -69,6 +87,47 @@ func (o *FlagsBackend) Validate(flags map[string]string, fs filesystem.Filesyste
 	return nil
 }
 
+// validateTemplate validates the flags when a project template is used with the --template flag.
+// The name of the component is optional, as it can be asked interactively
+func (o *FlagsBackend) validateTemplate(flags map[string]string, fs filesystem.Filesystem, dir string) error {
+	for _, flag := range []string{FLAG_DEVFILE, FLAG_DEVFILE_PATH, FLAG_DEVFILE_REGISTRY, FLAG_STARTER} {
+		if flags[flag] != "" {
+			return fmt.Errorf("--%s parameter cannot be used with --%s", flag, FLAG_TEMPLATE)
+		}
+	}
+
+	if flags[FLAG_NAME] != "" {
+		err := dfutil.ValidateK8sResourceName("name", flags[FLAG_NAME])
+		if err != nil {
+			return err
+		}
+	}
+
+	err := validatePersonalizationFlags(flags)
+	if err != nil {
+		return err
+	}
+
+	if !IsGitTemplate(flags[FLAG_TEMPLATE]) {
+		info, err := fs.Stat(flags[FLAG_TEMPLATE])
+		if err != nil {
+			return fmt.Errorf("unable to access template %q: %w", flags[FLAG_TEMPLATE], err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("template %q is not a directory", flags[FLAG_TEMPLATE])
+		}
+	}
+
+	empty, err := location.DirIsEmpty(fs, dir)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return fmt.Errorf("--%s parameter cannot be used when the directory is not empty", FLAG_TEMPLATE)
+	}
+	return nil
+}
+
 func (o *FlagsBackend) SelectDevfile(flags map[string]string, _ filesystem.Filesystem, _ string) (*alizer.DevfileLocation, error) {
 	return &alizer.DevfileLocation{
 		Devfile:         flags[FLAG_DEVFILE],
@@ -99,6 +158,179 @@ func (o *FlagsBackend) PersonalizeName(devfile parser.DevfileObj, flags map[stri
 	return flags[FLAG_NAME], nil
 }
 
-func (o FlagsBackend) PersonalizeDevfileConfig(devfileobj parser.DevfileObj) (parser.DevfileObj, error) {
+// PersonalizeDevfileConfig updates the ports, environment variables and resource limits of the containers
+// depending on the --remove-port, --port, --env, --memory and --cpu flags
+func (o FlagsBackend) PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flags map[string]string) (parser.DevfileObj, error) {
+	var zeroDevfile parser.DevfileObj
+
+	containers, err := devfileobj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return zeroDevfile, err
+	}
+	containerNames := make(map[string]bool, len(containers))
+	for _, container := range containers {
+		containerNames[container.Name] = true
+	}
+
+	values := map[string]map[string][]string{}
+	for _, flag := range []string{FLAG_REMOVE_PORT, FLAG_PORT, FLAG_ENV, FLAG_MEMORY, FLAG_CPU} {
+		values[flag], err = parseContainerValues(flag, flags[flag])
+		if err != nil {
+			return zeroDevfile, err
+		}
+		for containerName := range values[flag] {
+			if !containerNames[containerName] {
+				return zeroDevfile, fmt.Errorf("container %q referenced by --%s parameter not found in devfile", containerName, flag)
+			}
+		}
+	}
+
+	if len(values[FLAG_REMOVE_PORT]) > 0 {
+		err = devfileobj.Data.RemovePorts(values[FLAG_REMOVE_PORT])
+		if err != nil {
+			return zeroDevfile, err
+		}
+	}
+
+	if len(values[FLAG_PORT]) > 0 {
+		// ports already exposed by a container are not added again.
+		// Containers need to be fetched again, as ports may have been removed
+		containers, err = devfileobj.Data.GetComponents(common.DevfileOptions{
+			ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+		})
+		if err != nil {
+			return zeroDevfile, err
+		}
+		for _, container := range containers {
+			for _, ep := range container.Container.Endpoints {
+				values[FLAG_PORT][container.Name] = removeValue(values[FLAG_PORT][container.Name], strconv.Itoa(ep.TargetPort))
+			}
+		}
+		err = devfileobj.Data.SetPorts(values[FLAG_PORT])
+		if err != nil {
+			return zeroDevfile, err
+		}
+	}
+
+	if len(values[FLAG_ENV]) > 0 {
+		envs := map[string][]v1alpha2.EnvVar{}
+		for containerName, vars := range values[FLAG_ENV] {
+			for _, v := range vars {
+				kv := strings.SplitN(v, "=", 2)
+				envs[containerName] = append(envs[containerName], v1alpha2.EnvVar{
+					Name:  kv[0],
+					Value: kv[1],
+				})
+			}
+		}
+		err = devfileobj.Data.AddEnvVars(envs)
+		if err != nil {
+			return zeroDevfile, err
+		}
+	}
+
+	if len(values[FLAG_MEMORY]) > 0 || len(values[FLAG_CPU]) > 0 {
+		// containers need to be fetched again, as they may have been modified
+		containers, err = devfileobj.Data.GetComponents(common.DevfileOptions{
+			ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+		})
+		if err != nil {
+			return zeroDevfile, err
+		}
+		for _, container := range containers {
+			memory, hasMemory := values[FLAG_MEMORY][container.Name]
+			cpu, hasCPU := values[FLAG_CPU][container.Name]
+			if !hasMemory && !hasCPU {
+				continue
+			}
+			// if a flag is repeated for the same container, the last value is used
+			if hasMemory {
+				container.Container.MemoryLimit = memory[len(memory)-1]
+			}
+			if hasCPU {
+				container.Container.CpuLimit = cpu[len(cpu)-1]
+			}
+			err = devfileobj.Data.UpdateComponent(container)
+			if err != nil {
+				return zeroDevfile, err
+			}
+		}
+	}
+
 	return devfileobj, nil
 }
+
+// validatePersonalizationFlags validates the format of the --remove-port, --port, --env, --memory and --cpu flags
+func validatePersonalizationFlags(flags map[string]string) error {
+	for _, flag := range []string{FLAG_REMOVE_PORT, FLAG_PORT, FLAG_ENV, FLAG_MEMORY, FLAG_CPU} {
+		values, err := parseContainerValues(flag, flags[flag])
+		if err != nil {
+			return err
+		}
+		for _, containerValues := range values {
+			for _, value := range containerValues {
+				switch flag {
+				case FLAG_PORT, FLAG_REMOVE_PORT:
+					port, err := strconv.Atoi(value)
+					if err != nil || port <= 0 || port > 65535 {
+						return fmt.Errorf("invalid value %q for --%s parameter: the port must be a number between 1 and 65535", value, flag)
+					}
+				case FLAG_ENV:
+					kv := strings.SplitN(value, "=", 2)
+					if len(kv) != 2 || kv[0] == "" {
+						return fmt.Errorf("invalid value %q for --%s parameter: the format must be container=KEY=VALUE", value, flag)
+					}
+				case FLAG_MEMORY, FLAG_CPU:
+					if _, err := resource.ParseQuantity(value); err != nil {
+						return fmt.Errorf("invalid value %q for --%s parameter: %w", value, flag, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// parseContainerValues parses the value of a repeatable flag, containing values with the format container=value,
+// and returns the values for each container
+func parseContainerValues(flag string, flagValue string) (map[string][]string, error) {
+	result := map[string][]string{}
+	if flagValue == "" {
+		return result, nil
+	}
+	values, err := parseArrayFlag(flagValue)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse --%s parameter: %w", flag, err)
+	}
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid value %q for --%s parameter: the format must be container=value", value, flag)
+		}
+		result[parts[0]] = append(result[parts[0]], parts[1])
+	}
+	return result, nil
+}
+
+// parseArrayFlag returns the values of a repeatable flag, encoded as a JSON array by cmdline.GetFlags
+func parseArrayFlag(flagValue string) ([]string, error) {
+	if flagValue == "" {
+		return nil, nil
+	}
+	var values []string
+	err := json.Unmarshal([]byte(flagValue), &values)
+	return values, err
+}
+
+// removeValue returns the values, without the occurrences of value
+func removeValue(values []string, value string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/devfile/library/pkg/devfile/parser"
 	parsercontext "github.com/devfile/library/pkg/devfile/parser/context"
 	"github.com/devfile/library/pkg/devfile/parser/data"
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	dffilesystem "github.com/devfile/library/pkg/testingutil/filesystem"
 
 	"github.com/redhat-developer/odo/pkg/alizer"
@@ -265,6 +266,179 @@ func TestFlagsBackend_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid personalization flags",
+			args: args{
+				flags: map[string]string{
+					"name":        "aname",
+					"devfile":     "adevfile",
+					"port":        `["runtime=8080","runtime=8443"]`,
+					"remove-port": `["runtime=3000"]`,
+					"env":         `["runtime=KEY=VALUE=WITH=EQUALS"]`,
+					"memory":      `["runtime=512Mi"]`,
+					"cpu":         `["runtime=500m"]`,
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: false,
+		},
+		{
+			name: "port flag without container",
+			args: args{
+				flags: map[string]string{
+					"name":    "aname",
+					"devfile": "adevfile",
+					"port":    `["8080"]`,
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non numeric port",
+			args: args{
+				flags: map[string]string{
+					"name":    "aname",
+					"devfile": "adevfile",
+					"port":    `["runtime=http"]`,
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "env flag without value",
+			args: args{
+				flags: map[string]string{
+					"name":    "aname",
+					"devfile": "adevfile",
+					"env":     `["runtime=KEY"]`,
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid memory limit",
+			args: args{
+				flags: map[string]string{
+					"name":    "aname",
+					"devfile": "adevfile",
+					"memory":  `["runtime=lots"]`,
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "template in a local directory, without name",
+			args: args{
+				flags: map[string]string{
+					"template": "/templates/nodejs",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					_ = fs.MkdirAll("/templates/nodejs", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: false,
+		},
+		{
+			name: "template in a git repository",
+			args: args{
+				flags: map[string]string{
+					"name":     "aname",
+					"template": "https://github.com/example/templates.git#nodejs",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: false,
+		},
+		{
+			name: "template in a non existing local directory",
+			args: args{
+				flags: map[string]string{
+					"name":     "aname",
+					"template": "/templates/nodejs",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "template and devfile passed",
+			args: args{
+				flags: map[string]string{
+					"name":     "aname",
+					"devfile":  "adevfile",
+					"template": "/templates/nodejs",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					_ = fs.MkdirAll("/templates/nodejs", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "template with a non empty directory",
+			args: args{
+				flags: map[string]string{
+					"name":     "aname",
+					"template": "/templates/nodejs",
+				},
+				fsys: func() filesystem.Filesystem {
+					fs := filesystem.NewFakeFs()
+					_ = fs.MkdirAll("/tmp", 0644)
+					_ = fs.WriteFile("/tmp/main.go", []byte("package main"), 0644)
+					_ = fs.MkdirAll("/templates/nodejs", 0644)
+					return fs
+				},
+				dir: "/tmp",
+			},
+			wantErr: true,
+		},
 		// TODO: Add test cases.
 	}
 	for _, tt := range tests {
@@ -440,3 +614,119 @@ func TestFlagsBackend_PersonalizeName(t *testing.T) {
 		})
 	}
 }
+
+func TestFlagsBackend_PersonalizeDevfileConfig(t *testing.T) {
+	newDevfile := func() parser.DevfileObj {
+		devfileData, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+		_ = devfileData.AddComponents([]v1alpha2.Component{
+			{
+				Name: "runtime",
+				ComponentUnion: v1alpha2.ComponentUnion{
+					Container: &v1alpha2.ContainerComponent{
+						Container: v1alpha2.Container{
+							Image:       "an-image",
+							MemoryLimit: "1Gi",
+						},
+						Endpoints: []v1alpha2.Endpoint{{Name: "http", TargetPort: 3000}},
+					},
+				},
+			},
+		})
+		return parser.DevfileObj{Data: devfileData}
+	}
+
+	tests := []struct {
+		name       string
+		flags      map[string]string
+		wantErr    bool
+		wantPorts  []int
+		wantEnvs   []v1alpha2.EnvVar
+		wantMemory string
+		wantCPU    string
+	}{
+		{
+			name: "no personalization flag",
+			flags: map[string]string{
+				"name": "aname",
+			},
+			wantPorts:  []int{3000},
+			wantMemory: "1Gi",
+		},
+		{
+			name: "all personalization flags",
+			flags: map[string]string{
+				"remove-port": `["runtime=3000"]`,
+				"port":        `["runtime=8080","runtime=8443"]`,
+				"env":         `["runtime=KEY=VALUE"]`,
+				"memory":      `["runtime=256Mi","runtime=512Mi"]`,
+				"cpu":         `["runtime=500m"]`,
+			},
+			wantPorts:  []int{8080, 8443},
+			wantEnvs:   []v1alpha2.EnvVar{{Name: "KEY", Value: "VALUE"}},
+			wantMemory: "512Mi",
+			wantCPU:    "500m",
+		},
+		{
+			name: "env value with commas, quotes and brackets",
+			flags: map[string]string{
+				"env": `["runtime=GREETING=hello, \"world\" [1,2]"]`,
+			},
+			wantPorts:  []int{3000},
+			wantEnvs:   []v1alpha2.EnvVar{{Name: "GREETING", Value: `hello, "world" [1,2]`}},
+			wantMemory: "1Gi",
+		},
+		{
+			name: "port already exposed by the container",
+			flags: map[string]string{
+				"port": `["runtime=3000","runtime=8080"]`,
+			},
+			wantPorts:  []int{3000, 8080},
+			wantMemory: "1Gi",
+		},
+		{
+			name: "non existing container",
+			flags: map[string]string{
+				"port": `["tools=8080"]`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "non existing port to remove",
+			flags: map[string]string{
+				"remove-port": `["runtime=8080"]`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &FlagsBackend{}
+			got, err := o.PersonalizeDevfileConfig(newDevfile(), tt.flags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FlagsBackend.PersonalizeDevfileConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			components, _ := got.Data.GetComponents(common.DevfileOptions{})
+			container := components[0].Container
+			var ports []int
+			for _, ep := range container.Endpoints {
+				ports = append(ports, ep.TargetPort)
+			}
+			if !reflect.DeepEqual(ports, tt.wantPorts) {
+				t.Errorf("FlagsBackend.PersonalizeDevfileConfig() ports = %v, want %v", ports, tt.wantPorts)
+			}
+			if !reflect.DeepEqual(container.Env, tt.wantEnvs) {
+				t.Errorf("FlagsBackend.PersonalizeDevfileConfig() envs = %v, want %v", container.Env, tt.wantEnvs)
+			}
+			if container.MemoryLimit != tt.wantMemory {
+				t.Errorf("FlagsBackend.PersonalizeDevfileConfig() memory = %q, want %q", container.MemoryLimit, tt.wantMemory)
+			}
+			if container.CpuLimit != tt.wantCPU {
+				t.Errorf("FlagsBackend.PersonalizeDevfileConfig() cpu = %q, want %q", container.CpuLimit, tt.wantCPU)
+			}
+		})
+	}
+}
@@ -106,7 +106,7 @@ func (o *InteractiveBackend) PersonalizeName(devfile parser.DevfileObj, flags ma
 	return o.askerClient.AskName(fmt.Sprintf("my-%s-app", devfile.GetMetadataName()))
 }
 
-func (o *InteractiveBackend) PersonalizeDevfileConfig(devfileobj parser.DevfileObj) (parser.DevfileObj, error) {
+func (o *InteractiveBackend) PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flags map[string]string) (parser.DevfileObj, error) {
 	// TODO: Add tests
 	config, err := getPortsAndEnvVar(devfileobj)
 	var zeroDevfile parser.DevfileObj
@@ -485,7 +485,7 @@ func TestInteractiveBackend_PersonalizeDevfileconfig(t *testing.T) {
 				askerClient:    askerClient,
 				registryClient: tt.fields.registryClient,
 			}
-			devfile, err = o.PersonalizeDevfileConfig(devfile)
+			devfile, err = o.PersonalizeDevfileConfig(devfile, map[string]string{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("PersonalizeDevfileConfig() error = %v, wantErr %v", err, tt.wantErr)
 			}
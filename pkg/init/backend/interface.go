@@ -26,6 +26,7 @@ type InitBackend interface {
 	// Depending on the flags, it may return a name set interactively or not.
 	PersonalizeName(devfile parser.DevfileObj, flags map[string]string) (string, error)
 
-	// PersonalizeDevfileConfig updates the devfile config for ports and environment variables
-	PersonalizeDevfileConfig(devfileobj parser.DevfileObj) (parser.DevfileObj, error)
+	// PersonalizeDevfileConfig updates the devfile config for ports and environment variables.
+	// Depending on the flags, the config may be updated interactively or not.
+	PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flags map[string]string) (parser.DevfileObj, error)
 }
@@ -38,18 +38,18 @@ func (m *MockInitBackend) EXPECT() *MockInitBackendMockRecorder {
 }
 
 // PersonalizeDevfileConfig mocks base method.
-func (m *MockInitBackend) PersonalizeDevfileConfig(devfileobj parser.DevfileObj) (parser.DevfileObj, error) {
+func (m *MockInitBackend) PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flags map[string]string) (parser.DevfileObj, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PersonalizeDevfileConfig", devfileobj)
+	ret := m.ctrl.Call(m, "PersonalizeDevfileConfig", devfileobj, flags)
 	ret0, _ := ret[0].(parser.DevfileObj)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PersonalizeDevfileConfig indicates an expected call of PersonalizeDevfileConfig.
-func (mr *MockInitBackendMockRecorder) PersonalizeDevfileConfig(devfileobj interface{}) *gomock.Call {
+func (mr *MockInitBackendMockRecorder) PersonalizeDevfileConfig(devfileobj, flags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PersonalizeDevfileConfig", reflect.TypeOf((*MockInitBackend)(nil).PersonalizeDevfileConfig), devfileobj)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PersonalizeDevfileConfig", reflect.TypeOf((*MockInitBackend)(nil).PersonalizeDevfileConfig), devfileobj, flags)
 }
 
 // PersonalizeName mocks base method.
@@ -0,0 +1,117 @@
+package backend
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	dfutil "github.com/devfile/library/pkg/util"
+
+	"github.com/redhat-developer/odo/pkg/init/asker"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+// IsGitTemplate returns true if the template passed with the --template flag references a git repository,
+// false if it references a local directory
+func IsGitTemplate(template string) bool {
+	return strings.HasPrefix(template, "https://") ||
+		strings.HasPrefix(template, "http://") ||
+		strings.HasPrefix(template, "git@") ||
+		strings.HasSuffix(strings.SplitN(template, "#", 2)[0], ".git")
+}
+
+// ParseGitTemplate returns the URL of the git repository and the sub-directory containing the template,
+// from a template with the format <url>[#<sub-directory>]
+func ParseGitTemplate(template string) (url string, subDir string) {
+	parts := strings.SplitN(template, "#", 2)
+	if len(parts) == 2 {
+		return parts[0], strings.Trim(parts[1], "/")
+	}
+	return parts[0], ""
+}
+
+// TemplateValues are the values used to render the files of a project template.
+// The values are taken from the flags, or asked interactively when they are not passed as flags.
+// A value is asked only when a file of the template references it.
+type TemplateValues struct {
+	askerClient asker.Asker
+	// kubeClient is nil when no cluster is configured
+	kubeClient  kclient.ClientInterface
+	flags       map[string]string
+	defaultName string
+
+	name      *string
+	namespace *string
+	port      *string
+}
+
+func NewTemplateValues(askerClient asker.Asker, kubeClient kclient.ClientInterface, flags map[string]string, defaultName string) *TemplateValues {
+	return &TemplateValues{
+		askerClient: askerClient,
+		kubeClient:  kubeClient,
+		flags:       flags,
+		defaultName: defaultName,
+	}
+}
+
+// Name returns the name of the component, from the --name flag or asked interactively
+func (o *TemplateValues) Name() (string, error) {
+	if o.name != nil {
+		return *o.name, nil
+	}
+	name := o.flags[FLAG_NAME]
+	if name == "" {
+		var err error
+		name, err = o.askerClient.AskName(o.defaultName)
+		if err != nil {
+			return "", err
+		}
+		err = dfutil.ValidateK8sResourceName("name", name)
+		if err != nil {
+			return "", err
+		}
+	}
+	o.name = &name
+	return name, nil
+}
+
+// Namespace returns the current namespace of the Kubernetes client, or "default" if no cluster is configured
+func (o *TemplateValues) Namespace() (string, error) {
+	if o.namespace != nil {
+		return *o.namespace, nil
+	}
+	namespace := ""
+	if o.kubeClient != nil {
+		namespace = o.kubeClient.GetCurrentNamespace()
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	o.namespace = &namespace
+	return namespace, nil
+}
+
+// Port returns the first port passed with the --port flag, or asked interactively
+func (o *TemplateValues) Port() (string, error) {
+	if o.port != nil {
+		return *o.port, nil
+	}
+	ports, err := parseArrayFlag(o.flags[FLAG_PORT])
+	if err != nil {
+		return "", err
+	}
+	var port string
+	if len(ports) > 0 {
+		port = strings.SplitN(ports[0], "=", 2)[1]
+	} else {
+		port, err = o.askerClient.AskTemplateValue("Port", "8080")
+		if err != nil {
+			return "", err
+		}
+		if p, err := strconv.Atoi(port); err != nil || p <= 0 || p > 65535 {
+			return "", fmt.Errorf("invalid port %q: the port must be a number between 1 and 65535", port)
+		}
+	}
+	o.port = &port
+	return port, nil
+}
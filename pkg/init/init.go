@@ -5,18 +5,22 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile"
 	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	dfutil "github.com/devfile/library/pkg/util"
 	"k8s.io/utils/pointer"
 
 	"github.com/redhat-developer/odo/pkg/alizer"
+	adapterscommon "github.com/redhat-developer/odo/pkg/devfile/adapters/common"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
 	"github.com/redhat-developer/odo/pkg/init/asker"
 	"github.com/redhat-developer/odo/pkg/init/backend"
+	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/registry"
@@ -34,9 +38,13 @@ type InitClient struct {
 	fsys             filesystem.Filesystem
 	preferenceClient preference.Client
 	registryClient   registry.Client
+	alizerClient     alizer.Client
+	askerClient      asker.Asker
+	// kubeClient is nil when no cluster is configured
+	kubeClient kclient.ClientInterface
 }
 
-func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Client, registryClient registry.Client, alizerClient alizer.Client) *InitClient {
+func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Client, registryClient registry.Client, alizerClient alizer.Client, kubeClient kclient.ClientInterface) *InitClient {
 	// We create the asker client and the backends here and not at the CLI level, as we want to hide these details to the CLI
 	askerClient := asker.NewSurveyAsker()
 	return &InitClient{
@@ -46,6 +54,9 @@ func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Clien
 		fsys:               fsys,
 		preferenceClient:   preferenceClient,
 		registryClient:     registryClient,
+		alizerClient:       alizerClient,
+		askerClient:        askerClient,
+		kubeClient:         kubeClient,
 	}
 }
 
@@ -54,7 +65,9 @@ func NewInitClient(fsys filesystem.Filesystem, preferenceClient preference.Clien
 func (o *InitClient) GetFlags(flags map[string]string) map[string]string {
 	initFlags := map[string]string{}
 	for flag, value := range flags {
-		if flag == backend.FLAG_NAME || flag == backend.FLAG_DEVFILE || flag == backend.FLAG_DEVFILE_REGISTRY || flag == backend.FLAG_STARTER || flag == backend.FLAG_DEVFILE_PATH {
+		switch flag {
+		case backend.FLAG_NAME, backend.FLAG_DEVFILE, backend.FLAG_DEVFILE_REGISTRY, backend.FLAG_STARTER, backend.FLAG_DEVFILE_PATH,
+			backend.FLAG_PORT, backend.FLAG_REMOVE_PORT, backend.FLAG_ENV, backend.FLAG_MEMORY, backend.FLAG_CPU, backend.FLAG_TEMPLATE:
 			initFlags[flag] = value
 		}
 	}
@@ -240,6 +253,15 @@ func (o InitClient) PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flags
 		return parser.DevfileObj{}, err
 	}
 
+	if !onlyDevfile {
+		// Pre-fill the devfile with the ports and env vars detected from the source files,
+		// before the backend personalizes it
+		err = o.applyDetectedConfiguration(devfileobj, dir)
+		if err != nil {
+			return parser.DevfileObj{}, err
+		}
+	}
+
 	// Interactive mode since no flags are provided
 	if len(flags) == 0 && !onlyDevfile {
 		// Other files present in the directory; hence alizer is run
@@ -247,7 +269,91 @@ func (o InitClient) PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flags
 	} else {
 		backend = o.flagsBackend
 	}
-	return backend.PersonalizeDevfileConfig(devfileobj)
+	return backend.PersonalizeDevfileConfig(devfileobj, flags)
+}
+
+// applyDetectedConfiguration adds to the devfile the ports and environment variables detected from the source files in dir.
+// They are added to the container of the default run command, or to the first container if there is no run command.
+// Ports and environment variables already defined in the devfile are not modified.
+// The environment variables whose values are not known or are secrets are not added, their names are only displayed
+func (o InitClient) applyDetectedConfiguration(devfileobj parser.DevfileObj, dir string) error {
+	detected, err := o.alizerClient.DetectConfiguration(dir)
+	if err != nil {
+		return err
+	}
+	if len(detected.Ports) == 0 && len(detected.Envs) == 0 && len(detected.UnsetEnvs) == 0 {
+		return nil
+	}
+
+	containers, err := devfileobj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return err
+	}
+	if len(containers) == 0 {
+		return nil
+	}
+
+	containerName := containers[0].Name
+	if runCommand, err := adapterscommon.GetRunCommand(devfileobj.Data, ""); err == nil && runCommand.Exec != nil {
+		containerName = runCommand.Exec.Component
+	}
+
+	existingPorts := map[int]bool{}
+	existingEnvs := map[string]bool{}
+	for _, container := range containers {
+		for _, ep := range container.Container.Endpoints {
+			existingPorts[ep.TargetPort] = true
+		}
+		if container.Name == containerName {
+			for _, env := range container.Container.Env {
+				existingEnvs[env.Name] = true
+			}
+		}
+	}
+
+	var ports []string
+	for _, port := range detected.Ports {
+		if !existingPorts[port] {
+			ports = append(ports, strconv.Itoa(port))
+		}
+	}
+	var envs []v1alpha2.EnvVar
+	for _, env := range detected.Envs {
+		if !existingEnvs[env.Name] {
+			envs = append(envs, env)
+		}
+	}
+
+	if len(ports) > 0 {
+		err = devfileobj.Data.SetPorts(map[string][]string{containerName: ports})
+		if err != nil {
+			return err
+		}
+		log.Infof("Port(s) %s detected from the source files added to container %q", strings.Join(ports, ", "), containerName)
+	}
+	if len(envs) > 0 {
+		err = devfileobj.Data.AddEnvVars(map[string][]v1alpha2.EnvVar{containerName: envs})
+		if err != nil {
+			return err
+		}
+		names := make([]string, 0, len(envs))
+		for _, env := range envs {
+			names = append(names, env.Name)
+		}
+		log.Infof("Environment variable(s) %s detected from the source files added to container %q", strings.Join(names, ", "), containerName)
+	}
+	var unsetEnvs []string
+	for _, name := range detected.UnsetEnvs {
+		if !existingEnvs[name] {
+			unsetEnvs = append(unsetEnvs, name)
+		}
+	}
+	if len(unsetEnvs) > 0 {
+		log.Infof("Environment variable(s) %s used by the application are not set in the devfile, as their values are unknown or secret", strings.Join(unsetEnvs, ", "))
+	}
+	return nil
 }
 
 func (o InitClient) SelectAndPersonalizeDevfile(flags map[string]string, contextDir string) (parser.DevfileObj, string, error) {
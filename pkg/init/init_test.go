@@ -2,9 +2,13 @@ package init
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	"github.com/golang/mock/gomock"
 	"github.com/redhat-developer/odo/pkg/alizer"
 	"github.com/redhat-developer/odo/pkg/preference"
@@ -350,3 +354,118 @@ func TestInitClient_downloadStarterProject(t *testing.T) {
 		})
 	}
 }
+
+func TestInitClient_applyDetectedConfiguration(t *testing.T) {
+	newDevfile := func() parser.DevfileObj {
+		devfileData, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+		_ = devfileData.AddComponents([]v1alpha2.Component{
+			{
+				Name: "tools",
+				ComponentUnion: v1alpha2.ComponentUnion{
+					Container: &v1alpha2.ContainerComponent{
+						Container: v1alpha2.Container{Image: "tools-image"},
+					},
+				},
+			},
+			{
+				Name: "runtime",
+				ComponentUnion: v1alpha2.ComponentUnion{
+					Container: &v1alpha2.ContainerComponent{
+						Container: v1alpha2.Container{
+							Image: "runtime-image",
+							Env:   []v1alpha2.EnvVar{{Name: "DATABASE_URL", Value: "from-devfile"}},
+						},
+						Endpoints: []v1alpha2.Endpoint{{Name: "http", TargetPort: 3000}},
+					},
+				},
+			},
+		})
+		_ = devfileData.AddCommands([]v1alpha2.Command{
+			{
+				Id: "run",
+				CommandUnion: v1alpha2.CommandUnion{
+					Exec: &v1alpha2.ExecCommand{
+						Component:   "runtime",
+						CommandLine: "npm start",
+						LabeledCommand: v1alpha2.LabeledCommand{
+							BaseCommand: v1alpha2.BaseCommand{
+								Group: &v1alpha2.CommandGroup{Kind: v1alpha2.RunCommandGroupKind},
+							},
+						},
+					},
+				},
+			},
+		})
+		return parser.DevfileObj{Data: devfileData}
+	}
+
+	tests := []struct {
+		name      string
+		detected  alizer.DetectedConfiguration
+		wantPorts map[string][]int
+		wantEnvs  map[string]map[string]string
+	}{
+		{
+			name: "nothing detected",
+			wantPorts: map[string][]int{
+				"tools":   nil,
+				"runtime": {3000},
+			},
+			wantEnvs: map[string]map[string]string{
+				"tools":   {},
+				"runtime": {"DATABASE_URL": "from-devfile"},
+			},
+		},
+		{
+			name: "new ports and env vars are added to the container of the run command",
+			detected: alizer.DetectedConfiguration{
+				Ports: []int{3000, 8080},
+				Envs: []v1alpha2.EnvVar{
+					{Name: "DATABASE_URL", Value: "from-sources"},
+					{Name: "LOG_LEVEL", Value: "info"},
+				},
+				UnsetEnvs: []string{"API_KEY"},
+			},
+			wantPorts: map[string][]int{
+				"tools":   nil,
+				"runtime": {3000, 8080},
+			},
+			wantEnvs: map[string]map[string]string{
+				"tools":   {},
+				"runtime": {"DATABASE_URL": "from-devfile", "LOG_LEVEL": "info"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			alizerClient := alizer.NewMockClient(ctrl)
+			alizerClient.EXPECT().DetectConfiguration("/dir").Return(tt.detected, nil)
+			o := &InitClient{
+				alizerClient: alizerClient,
+			}
+			devfileObj := newDevfile()
+			if err := o.applyDetectedConfiguration(devfileObj, "/dir"); err != nil {
+				t.Errorf("InitClient.applyDetectedConfiguration() unexpected error %v", err)
+				return
+			}
+			components, _ := devfileObj.Data.GetComponents(common.DevfileOptions{})
+			for _, component := range components {
+				var ports []int
+				for _, ep := range component.Container.Endpoints {
+					ports = append(ports, ep.TargetPort)
+				}
+				if !reflect.DeepEqual(ports, tt.wantPorts[component.Name]) {
+					t.Errorf("ports of %q = %v, want %v", component.Name, ports, tt.wantPorts[component.Name])
+				}
+				envs := map[string]string{}
+				for _, env := range component.Container.Env {
+					envs[env.Name] = env.Value
+				}
+				if !reflect.DeepEqual(envs, tt.wantEnvs[component.Name]) {
+					t.Errorf("envs of %q = %v, want %v", component.Name, envs, tt.wantEnvs[component.Name])
+				}
+			}
+		})
+	}
+}
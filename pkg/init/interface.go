@@ -59,4 +59,9 @@ type Client interface {
 	// SelectAndPersonalizeDevfile selects a devfile, then downloads, parse and personalize it
 	// Returns the devfile object and its path
 	SelectAndPersonalizeDevfile(flags map[string]string, contextDir string) (parser.DevfileObj, string, error)
+
+	// InitFromTemplate copies the files of the project template referenced by the flags into contextDir,
+	// rendering the placeholders they contain, and personalizes the devfile of the template.
+	// Returns the devfile object and the name of the component
+	InitFromTemplate(flags map[string]string, contextDir string) (parser.DevfileObj, string, error)
 }
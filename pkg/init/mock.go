@@ -94,6 +94,22 @@ func (mr *MockClientMockRecorder) InitDevfile(flags, contextDir, preInitHandlerF
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitDevfile", reflect.TypeOf((*MockClient)(nil).InitDevfile), flags, contextDir, preInitHandlerFunc, newDevfileHandlerFunc)
 }
 
+// InitFromTemplate mocks base method.
+func (m *MockClient) InitFromTemplate(flags map[string]string, contextDir string) (parser.DevfileObj, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitFromTemplate", flags, contextDir)
+	ret0, _ := ret[0].(parser.DevfileObj)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InitFromTemplate indicates an expected call of InitFromTemplate.
+func (mr *MockClientMockRecorder) InitFromTemplate(flags, contextDir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitFromTemplate", reflect.TypeOf((*MockClient)(nil).InitFromTemplate), flags, contextDir)
+}
+
 // PersonalizeDevfileConfig mocks base method.
 func (m *MockClient) PersonalizeDevfileConfig(devfileobj parser.DevfileObj, flags map[string]string, fs filesystem.Filesystem, dir string) (parser.DevfileObj, error) {
 	m.ctrl.T.Helper()
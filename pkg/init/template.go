@@ -0,0 +1,166 @@
+package init
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/init/backend"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// InitFromTemplate copies the files of the project template referenced by the --template flag into contextDir,
+// rendering the files with the .tmpl suffix with the values passed as flags or asked interactively.
+// The devfile of the template is then personalized depending on the flags.
+// Returns the devfile object and the name of the component
+func (o *InitClient) InitFromTemplate(flags map[string]string, contextDir string) (parser.DevfileObj, string, error) {
+	templateLocation := flags[backend.FLAG_TEMPLATE]
+
+	stagingDir, err := o.fsys.TempDir("", "odo-template")
+	if err != nil {
+		return parser.DevfileObj{}, "", err
+	}
+	defer func() {
+		_ = o.fsys.RemoveAll(stagingDir)
+	}()
+
+	err = o.downloadTemplate(templateLocation, stagingDir)
+	if err != nil {
+		return parser.DevfileObj{}, "", fmt.Errorf("unable to download template %q: %w", templateLocation, err)
+	}
+
+	values := backend.NewTemplateValues(o.askerClient, o.kubeClient, flags, filepath.Base(contextDir))
+	err = o.renderTemplate(stagingDir, contextDir, values)
+	if err != nil {
+		return parser.DevfileObj{}, "", err
+	}
+
+	devfilePath := filepath.Join(contextDir, "devfile.yaml")
+	if _, err = o.fsys.Stat(devfilePath); err != nil {
+		return parser.DevfileObj{}, "", fmt.Errorf("template %q does not contain a devfile.yaml file", templateLocation)
+	}
+	devfileObj, _, err := devfile.ParseDevfileAndValidate(parser.ParserArgs{Path: devfilePath, FlattenedDevfile: pointer.BoolPtr(false)})
+	if err != nil {
+		return parser.DevfileObj{}, "", fmt.Errorf("unable to parse devfile: %w", err)
+	}
+
+	devfileObj, err = o.PersonalizeDevfileConfig(devfileObj, flags, o.fsys, contextDir)
+	if err != nil {
+		return parser.DevfileObj{}, "", fmt.Errorf("failed to configure devfile: %w", err)
+	}
+
+	name, err := values.Name()
+	if err != nil {
+		return parser.DevfileObj{}, "", fmt.Errorf("failed to get the component's name: %w", err)
+	}
+	return devfileObj, name, nil
+}
+
+// downloadTemplate copies the files of a template into dest.
+// The template is either a local directory, or a git repository with the format <url>[#<sub-directory>]
+func (o *InitClient) downloadTemplate(templateLocation string, dest string) error {
+	if !backend.IsGitTemplate(templateLocation) {
+		copySpinner := log.Spinnerf("Copying template from %q", templateLocation)
+		defer copySpinner.End(false)
+		err := util.CopyDir(templateLocation, dest, o.fsys)
+		if err != nil {
+			return err
+		}
+		copySpinner.End(true)
+		return nil
+	}
+
+	url, subDir := backend.ParseGitTemplate(templateLocation)
+	downloadSpinner := log.Spinnerf("Downloading template from %q", url)
+	defer downloadSpinner.End(false)
+	// The template is downloaded the same way as a starter project referencing a git repository
+	project := &v1alpha2.StarterProject{
+		Name:   "template",
+		SubDir: subDir,
+		ProjectSource: v1alpha2.ProjectSource{
+			Git: &v1alpha2.GitProjectSource{
+				GitLikeProjectSource: v1alpha2.GitLikeProjectSource{
+					Remotes: map[string]string{"origin": url},
+				},
+			},
+		},
+	}
+	err := o.registryClient.DownloadStarterProject(project, "", dest, false)
+	if err != nil {
+		return err
+	}
+	downloadSpinner.End(true)
+	return nil
+}
+
+// templateSuffix is the suffix of the files of a template rendered as Go templates.
+// The suffix is removed from the names of the rendered files
+const templateSuffix = ".tmpl"
+
+// renderTemplate copies the files of the template in src into dest, executing the files with the templateSuffix suffix
+// as Go templates with the values. The files are rendered in a temporary directory first, so that dest is not modified
+// if a file cannot be rendered
+func (o *InitClient) renderTemplate(src string, dest string, values *backend.TemplateValues) error {
+	renderDir, err := o.fsys.TempDir("", "odo-template-render")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = o.fsys.RemoveAll(renderDir)
+	}()
+
+	err = o.fsys.Walk(src, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return o.fsys.MkdirAll(filepath.Join(renderDir, rel), info.Mode().Perm())
+		}
+		content, err := o.fsys.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(rel, templateSuffix) {
+			content, err = renderFile(filepath.ToSlash(rel), content, values)
+			if err != nil {
+				return err
+			}
+			rel = strings.TrimSuffix(rel, templateSuffix)
+		}
+		return o.fsys.WriteFile(filepath.Join(renderDir, rel), content, info.Mode().Perm())
+	})
+	if err != nil {
+		return err
+	}
+	return util.CopyDir(renderDir, dest, o.fsys)
+}
+
+// renderFile executes the content of a file as a Go template with the values
+func renderFile(name string, content []byte, values *backend.TemplateValues) ([]byte, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse template file %q: %w", name, err)
+	}
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, values)
+	if err != nil {
+		return nil, fmt.Errorf("unable to render template file %q: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
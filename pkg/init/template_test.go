@@ -0,0 +1,218 @@
+package init
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"github.com/golang/mock/gomock"
+
+	"github.com/redhat-developer/odo/pkg/alizer"
+	"github.com/redhat-developer/odo/pkg/init/asker"
+	"github.com/redhat-developer/odo/pkg/init/backend"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const templateDevfile = `schemaVersion: 2.0.0
+metadata:
+  name: nodejs-template
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-14:latest
+    endpoints:
+    - name: http
+      targetPort: {{.Port}}
+commands:
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+    group:
+      kind: run
+      isDefault: true
+`
+
+func TestInitClient_InitFromTemplate(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		flags map[string]string
+		// namespace is the current namespace of the Kubernetes client, no cluster is configured if empty
+		namespace string
+		asker     func(ctrl *gomock.Controller) asker.Asker
+		wantErr   bool
+		wantName  string
+		wantPorts []int
+		wantFiles map[string]string
+		// wantEmptyContext is true if no file must be written in the context directory
+		wantEmptyContext bool
+	}{
+		{
+			name: "values passed as flags",
+			files: map[string]string{
+				"devfile.yaml.tmpl":  templateDevfile,
+				"README.md.tmpl":     "# {{.Name}} deployed in {{.Namespace}}",
+				"src/server.js.tmpl": "const port = {{.Port}};",
+				"src/App.vue":        "<p>{{ message }}</p>",
+				"chart/values.yaml":  "image: {{ .Values.image }}",
+				"static/no-template": "no placeholder",
+			},
+			flags: map[string]string{
+				backend.FLAG_NAME: "my-app",
+				backend.FLAG_PORT: `["runtime=8080"]`,
+			},
+			namespace: "my-ns",
+			asker: func(ctrl *gomock.Controller) asker.Asker {
+				return asker.NewMockAsker(ctrl)
+			},
+			wantName:  "my-app",
+			wantPorts: []int{8080},
+			wantFiles: map[string]string{
+				"README.md":          "# my-app deployed in my-ns",
+				"src/server.js":      "const port = 8080;",
+				"src/App.vue":        "<p>{{ message }}</p>",
+				"chart/values.yaml":  "image: {{ .Values.image }}",
+				"static/no-template": "no placeholder",
+			},
+		},
+		{
+			name: "values asked interactively",
+			files: map[string]string{
+				"devfile.yaml.tmpl": templateDevfile,
+				"README.md.tmpl":    "# {{.Name}}",
+			},
+			flags: map[string]string{},
+			asker: func(ctrl *gomock.Controller) asker.Asker {
+				client := asker.NewMockAsker(ctrl)
+				client.EXPECT().AskName(gomock.Any()).Return("asked-name", nil)
+				client.EXPECT().AskTemplateValue("Port", "8080").Return("3000", nil)
+				return client
+			},
+			wantName:  "asked-name",
+			wantPorts: []int{3000},
+			wantFiles: map[string]string{
+				"README.md": "# asked-name",
+			},
+		},
+		{
+			name: "default namespace without cluster",
+			files: map[string]string{
+				"devfile.yaml.tmpl": templateDevfile,
+				"README.md.tmpl":    "# deployed in {{.Namespace}}",
+			},
+			flags: map[string]string{
+				backend.FLAG_NAME: "my-app",
+				backend.FLAG_PORT: `["runtime=8080"]`,
+			},
+			asker: func(ctrl *gomock.Controller) asker.Asker {
+				return asker.NewMockAsker(ctrl)
+			},
+			wantName:  "my-app",
+			wantPorts: []int{8080},
+			wantFiles: map[string]string{
+				"README.md": "# deployed in default",
+			},
+		},
+		{
+			name: "undefined value",
+			files: map[string]string{
+				"devfile.yaml.tmpl": templateDevfile,
+				"README.md.tmpl":    "# {{.Unknown}}",
+				"src/server.js":     "const port = 8080;",
+			},
+			flags: map[string]string{
+				backend.FLAG_NAME: "my-app",
+				backend.FLAG_PORT: `["runtime=8080"]`,
+			},
+			asker: func(ctrl *gomock.Controller) asker.Asker {
+				return asker.NewMockAsker(ctrl)
+			},
+			wantErr:          true,
+			wantEmptyContext: true,
+		},
+		{
+			name: "no devfile in template",
+			files: map[string]string{
+				"README.md.tmpl": "# {{.Name}}",
+			},
+			flags: map[string]string{
+				backend.FLAG_NAME: "my-app",
+			},
+			asker: func(ctrl *gomock.Controller) asker.Asker {
+				return asker.NewMockAsker(ctrl)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			templateDir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(templateDir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			contextDir := t.TempDir()
+
+			alizerClient := alizer.NewMockClient(ctrl)
+			alizerClient.EXPECT().DetectConfiguration(gomock.Any()).Return(alizer.DetectedConfiguration{}, nil).AnyTimes()
+
+			o := &InitClient{
+				flagsBackend: backend.NewFlagsBackend(nil),
+				fsys:         filesystem.DefaultFs{},
+				alizerClient: alizerClient,
+				askerClient:  tt.asker(ctrl),
+			}
+			if tt.namespace != "" {
+				kubeClient := kclient.NewMockClientInterface(ctrl)
+				kubeClient.EXPECT().GetCurrentNamespace().Return(tt.namespace).AnyTimes()
+				o.kubeClient = kubeClient
+			}
+			flags := map[string]string{backend.FLAG_TEMPLATE: templateDir}
+			for k, v := range tt.flags {
+				flags[k] = v
+			}
+			devfileObj, name, err := o.InitFromTemplate(flags, contextDir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InitClient.InitFromTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if entries, _ := os.ReadDir(contextDir); tt.wantEmptyContext && len(entries) > 0 {
+					t.Errorf("InitClient.InitFromTemplate() wrote %d files in the context directory", len(entries))
+				}
+				return
+			}
+			if name != tt.wantName {
+				t.Errorf("InitClient.InitFromTemplate() name = %q, want %q", name, tt.wantName)
+			}
+			components, _ := devfileObj.Data.GetComponents(common.DevfileOptions{})
+			var ports []int
+			for _, ep := range components[0].Container.Endpoints {
+				ports = append(ports, ep.TargetPort)
+			}
+			if !reflect.DeepEqual(ports, tt.wantPorts) {
+				t.Errorf("InitClient.InitFromTemplate() ports = %v, want %v", ports, tt.wantPorts)
+			}
+			for file, want := range tt.wantFiles {
+				got, err := os.ReadFile(filepath.Join(contextDir, file))
+				if err != nil {
+					t.Errorf("InitClient.InitFromTemplate() file %q not found: %v", file, err)
+					continue
+				}
+				if string(got) != want {
+					t.Errorf("InitClient.InitFromTemplate() file %q = %q, want %q", file, string(got), want)
+				}
+			}
+		})
+	}
+}
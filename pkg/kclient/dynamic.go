@@ -39,6 +39,26 @@ func (c *Client) CreateDynamicResource(resource unstructured.Unstructured) error
 	return nil
 }
 
+// DryRunApplyDynamicResource runs a server-side apply of the resource in dry-run mode, and returns the resource
+// as it would be after the apply, without modifying the cluster
+func (c *Client) DryRunApplyDynamicResource(resource unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(resource.Object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal resource: %w", err)
+	}
+
+	gvr, err := c.GetRestMappingFromUnstructured(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.DynamicClient.Resource(gvr.Resource).Namespace(c.Namespace).Patch(context.TODO(), resource.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: FieldManager,
+		Force:        boolPtr(true),
+		DryRun:       []string{metav1.DryRunAll},
+	})
+}
+
 // ListDynamicResource returns an unstructured list of instances of a Custom
 // Resource currently deployed in the active namespace of the cluster
 func (c *Client) ListDynamicResources(gvr schema.GroupVersionResource) (*unstructured.UnstructuredList, error) {
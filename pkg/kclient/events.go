@@ -39,6 +39,7 @@ func (c *Client) CollectEvents(selector string, events map[string]corev1.Event,
 		case val, ok := <-eventWatcher.ResultChan():
 			mu.Lock()
 			if !ok {
+				mu.Unlock()
 				log.Warning("Watch channel was closed")
 				return
 			}
@@ -56,6 +57,7 @@ func (c *Client) CollectEvents(selector string, events map[string]corev1.Event,
 				}
 
 			} else {
+				mu.Unlock()
 				log.Warning("Unable to convert object to event")
 				return
 			}
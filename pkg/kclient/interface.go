@@ -9,6 +9,7 @@ import (
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/kubectl/pkg/util/openapi"
 )
 
 type ClientInterface interface {
@@ -47,6 +49,8 @@ type ClientInterface interface {
 
 	// dynamic.go
 	CreateDynamicResource(exampleCustomResource unstructured.Unstructured) error
+	DryRunApplyDynamicResource(resource unstructured.Unstructured) (*unstructured.Unstructured, error)
+	WaitForResourcesReady(resources []unstructured.Unstructured, selector string, timeout time.Duration) error
 	ListDynamicResources(gvr schema.GroupVersionResource) (*unstructured.UnstructuredList, error)
 	GetDynamicResource(gvr schema.GroupVersionResource, name string) (*unstructured.Unstructured, error)
 	UpdateDynamicResource(gvr schema.GroupVersionResource, name string, u *unstructured.Unstructured) error
@@ -88,6 +92,7 @@ type ClientInterface interface {
 	GetCustomResourcesFromCSV(csv *olm.ClusterServiceVersion) *[]olm.CRDDescription
 	GetCSVWithCR(name string) (*olm.ClusterServiceVersion, error)
 	GetResourceSpecDefinition(group, version, kind string) (*spec.Schema, error)
+	GetOpenAPIResources() (openapi.Resources, error)
 	GetRestMappingFromUnstructured(unstructured.Unstructured) (*meta.RESTMapping, error)
 	GetOperatorGVRList() ([]meta.RESTMapping, error)
 
@@ -143,7 +148,9 @@ type ClientInterface interface {
 	ListPVCs(selector string) ([]corev1.PersistentVolumeClaim, error)
 	ListPVCNames(selector string) ([]string, error)
 	GetPVCFromName(pvcName string) (*corev1.PersistentVolumeClaim, error)
+	UpdatePVC(pvc *corev1.PersistentVolumeClaim) error
 	UpdatePVCLabels(pvc *corev1.PersistentVolumeClaim, labels map[string]string) error
+	GetStorageClass(name string) (*storagev1.StorageClass, error)
 	GetAndUpdateStorageOwnerReference(pvc *corev1.PersistentVolumeClaim, ownerReference ...metav1.OwnerReference) error
 	UpdateStorageOwnerReference(pvc *corev1.PersistentVolumeClaim, ownerReference ...metav1.OwnerReference) error
 }
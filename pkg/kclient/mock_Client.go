@@ -15,6 +15,7 @@ import (
 	v1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	v10 "k8s.io/api/apps/v1"
 	v11 "k8s.io/api/core/v1"
+	v13 "k8s.io/api/storage/v1"
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -24,6 +25,7 @@ import (
 	kubernetes "k8s.io/client-go/kubernetes"
 	rest "k8s.io/client-go/rest"
 	clientcmd "k8s.io/client-go/tools/clientcmd"
+	openapi "k8s.io/kubectl/pkg/util/openapi"
 )
 
 // MockClientInterface is a mock of ClientInterface interface.
@@ -319,6 +321,21 @@ func (mr *MockClientInterfaceMockRecorder) DeleteService(serviceName interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MockClientInterface)(nil).DeleteService), serviceName)
 }
 
+// DryRunApplyDynamicResource mocks base method.
+func (m *MockClientInterface) DryRunApplyDynamicResource(resource unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunApplyDynamicResource", resource)
+	ret0, _ := ret[0].(*unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunApplyDynamicResource indicates an expected call of DryRunApplyDynamicResource.
+func (mr *MockClientInterfaceMockRecorder) DryRunApplyDynamicResource(resource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunApplyDynamicResource", reflect.TypeOf((*MockClientInterface)(nil).DryRunApplyDynamicResource), resource)
+}
+
 // ExecCMDInContainer mocks base method.
 func (m *MockClientInterface) ExecCMDInContainer(containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
 	m.ctrl.T.Helper()
@@ -703,6 +720,21 @@ func (mr *MockClientInterfaceMockRecorder) GetOneServiceFromSelector(selector in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneServiceFromSelector", reflect.TypeOf((*MockClientInterface)(nil).GetOneServiceFromSelector), selector)
 }
 
+// GetOpenAPIResources mocks base method.
+func (m *MockClientInterface) GetOpenAPIResources() (openapi.Resources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenAPIResources")
+	ret0, _ := ret[0].(openapi.Resources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenAPIResources indicates an expected call of GetOpenAPIResources.
+func (mr *MockClientInterfaceMockRecorder) GetOpenAPIResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenAPIResources", reflect.TypeOf((*MockClientInterface)(nil).GetOpenAPIResources))
+}
+
 // GetOperatorGVRList mocks base method.
 func (m *MockClientInterface) GetOperatorGVRList() ([]meta.RESTMapping, error) {
 	m.ctrl.T.Helper()
@@ -838,6 +870,21 @@ func (mr *MockClientInterfaceMockRecorder) GetServerVersion(timeout interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerVersion", reflect.TypeOf((*MockClientInterface)(nil).GetServerVersion), timeout)
 }
 
+// GetStorageClass mocks base method.
+func (m *MockClientInterface) GetStorageClass(name string) (*v13.StorageClass, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageClass", name)
+	ret0, _ := ret[0].(*v13.StorageClass)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageClass indicates an expected call of GetStorageClass.
+func (mr *MockClientInterfaceMockRecorder) GetStorageClass(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageClass", reflect.TypeOf((*MockClientInterface)(nil).GetStorageClass), name)
+}
+
 // IsCSVSupported mocks base method.
 func (m *MockClientInterface) IsCSVSupported() (bool, error) {
 	m.ctrl.T.Helper()
@@ -1184,6 +1231,20 @@ func (mr *MockClientInterfaceMockRecorder) UpdateDynamicResource(gvr, name, u in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicResource", reflect.TypeOf((*MockClientInterface)(nil).UpdateDynamicResource), gvr, name, u)
 }
 
+// UpdatePVC mocks base method.
+func (m *MockClientInterface) UpdatePVC(pvc *v11.PersistentVolumeClaim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePVC", pvc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePVC indicates an expected call of UpdatePVC.
+func (mr *MockClientInterfaceMockRecorder) UpdatePVC(pvc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePVC", reflect.TypeOf((*MockClientInterface)(nil).UpdatePVC), pvc)
+}
+
 // UpdatePVCLabels mocks base method.
 func (m *MockClientInterface) UpdatePVCLabels(pvc *v11.PersistentVolumeClaim, labels map[string]string) error {
 	m.ctrl.T.Helper()
@@ -1320,6 +1381,20 @@ func (mr *MockClientInterfaceMockRecorder) WaitForPodDeletion(name interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForPodDeletion", reflect.TypeOf((*MockClientInterface)(nil).WaitForPodDeletion), name)
 }
 
+// WaitForResourcesReady mocks base method.
+func (m *MockClientInterface) WaitForResourcesReady(resources []unstructured.Unstructured, selector string, timeout time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForResourcesReady", resources, selector, timeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForResourcesReady indicates an expected call of WaitForResourcesReady.
+func (mr *MockClientInterfaceMockRecorder) WaitForResourcesReady(resources, selector, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForResourcesReady", reflect.TypeOf((*MockClientInterface)(nil).WaitForResourcesReady), resources, selector, timeout)
+}
+
 // WaitForServiceAccountInNamespace mocks base method.
 func (m *MockClientInterface) WaitForServiceAccountInNamespace(namespace, serviceAccountName string) error {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/restmapper"
+	"k8s.io/kubectl/pkg/util/openapi"
 
 	"github.com/go-openapi/spec"
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -78,6 +79,16 @@ func (c *Client) GetResourceSpecDefinition(group, version, kind string) (*spec.S
 	return getResourceSpecDefinitionFromSwagger(data, group, version, kind)
 }
 
+// GetOpenAPIResources returns the OpenAPI v2 definitions of the resources supported by the cluster,
+// used to validate the resources before creating them
+func (c *Client) GetOpenAPIResources() (openapi.Resources, error) {
+	doc, err := c.discoveryClient.OpenAPISchema()
+	if err != nil {
+		return nil, err
+	}
+	return openapi.NewOpenAPIData(doc)
+}
+
 // getResourceSpecDefinitionFromSwagger returns the OpenAPI v2 definition of the Kubernetes resource of a given group/version/kind, for a given swagger data
 func getResourceSpecDefinitionFromSwagger(data []byte, group, version, kind string) (*spec.Schema, error) {
 	schema := new(spec.Schema)
@@ -0,0 +1,238 @@
+package kclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// rolloutPollInterval is the interval between two checks of the status of a resource
+const rolloutPollInterval = 2 * time.Second
+
+// WaitForResourcesReady waits for the resources to be ready: Deployments, StatefulSets and DaemonSets to be rolled out,
+// Jobs to be complete, and the other resources to have their Ready or Available condition true.
+// An error is returned if a resource fails or if the resources are not ready after timeout;
+// the warning events occurring while waiting on the resources, selected by selector, and on the objects created for them
+// are part of the error
+func (c *Client) WaitForResourcesReady(resources []unstructured.Unstructured, selector string, timeout time.Duration) error {
+	failedEvents := make(map[string]corev1.Event)
+	quit := make(chan int)
+	go c.CollectEvents(selector, failedEvents, quit)
+	defer close(quit)
+
+	deadline := time.Now().Add(timeout)
+	for _, resource := range resources {
+		err := c.waitForResourceReady(resource, deadline)
+		if err != nil {
+			mu.Lock()
+			defer mu.Unlock()
+			resourcesEvents := filterResourcesEvents(failedEvents, resources)
+			if len(resourcesEvents) == 0 {
+				return err
+			}
+			tableString := getErrorMessageFromEvents(resourcesEvents)
+			return fmt.Errorf(`%w
+For more information to help determine the cause of the error, re-run with '-v'.
+See below for a list of failed events that occured more than %d times during deployment:
+%s`, err, failedEventCount, tableString.String())
+		}
+	}
+	return nil
+}
+
+// filterResourcesEvents returns the events involving the resources, or the objects created for them and named after them,
+// such as the ReplicaSets and Pods of a Deployment, as the events of the namespace are collected
+func filterResourcesEvents(events map[string]corev1.Event, resources []unstructured.Unstructured) map[string]corev1.Event {
+	result := make(map[string]corev1.Event)
+	for name, event := range events {
+		for _, resource := range resources {
+			if event.InvolvedObject.Name == resource.GetName() || strings.HasPrefix(event.InvolvedObject.Name, resource.GetName()+"-") {
+				result[name] = event
+				break
+			}
+		}
+	}
+	return result
+}
+
+// waitForResourceReady polls the resource until it is ready, it fails or the deadline is reached
+func (c *Client) waitForResourceReady(resource unstructured.Unstructured, deadline time.Time) error {
+	name := resource.GetKind() + "/" + resource.GetName()
+	spinner := log.Spinnerf("Waiting for %s to be ready", name)
+	defer spinner.End(false)
+
+	mapping, err := c.GetRestMappingFromUnstructured(resource)
+	if err != nil {
+		return err
+	}
+
+	message := ""
+	for {
+		u, err := c.DynamicClient.Resource(mapping.Resource).Namespace(c.Namespace).Get(context.TODO(), resource.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to get %s: %w", name, err)
+		}
+		var ready bool
+		ready, message, err = GetResourceReadiness(u)
+		if err != nil {
+			return fmt.Errorf("%s failed: %w", name, err)
+		}
+		if ready {
+			spinner.End(true)
+			return nil
+		}
+		klog.V(3).Infof("Waiting for %s: %s", name, message)
+		if time.Now().Add(rolloutPollInterval).After(deadline) {
+			return fmt.Errorf("timeout while waiting for %s to be ready: %s", name, message)
+		}
+		time.Sleep(rolloutPollInterval)
+	}
+}
+
+// GetResourceReadiness returns true if the resource is ready, or a message explaining why it is not ready yet.
+// An error is returned if the resource failed and will not become ready
+func GetResourceReadiness(u *unstructured.Unstructured) (bool, string, error) {
+	switch u.GroupVersionKind().GroupKind().String() {
+	case "Deployment.apps":
+		var deployment appsv1.Deployment
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &deployment); err != nil {
+			return false, "", err
+		}
+		return getDeploymentReadiness(&deployment)
+	case "StatefulSet.apps":
+		var statefulSet appsv1.StatefulSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &statefulSet); err != nil {
+			return false, "", err
+		}
+		return getStatefulSetReadiness(&statefulSet)
+	case "DaemonSet.apps":
+		var daemonSet appsv1.DaemonSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &daemonSet); err != nil {
+			return false, "", err
+		}
+		return getDaemonSetReadiness(&daemonSet)
+	case "Job.batch":
+		var job batchv1.Job
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &job); err != nil {
+			return false, "", err
+		}
+		return getJobReadiness(&job)
+	}
+	return getConditionsReadiness(u)
+}
+
+func getDeploymentReadiness(deployment *appsv1.Deployment) (bool, string, error) {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return false, "waiting for the deployment spec update to be observed", nil
+	}
+	cond := getDeploymentCondition(deployment.Status, appsv1.DeploymentProgressing)
+	if cond != nil && cond.Reason == timedOutReason {
+		return false, "", fmt.Errorf("deployment %q exceeded its progress deadline", deployment.Name)
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	if deployment.Status.UpdatedReplicas < replicas {
+		return false, fmt.Sprintf("%d out of %d new replicas have been updated", deployment.Status.UpdatedReplicas, replicas), nil
+	}
+	if deployment.Status.Replicas > deployment.Status.UpdatedReplicas {
+		return false, fmt.Sprintf("%d old replicas are pending termination", deployment.Status.Replicas-deployment.Status.UpdatedReplicas), nil
+	}
+	if deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas {
+		return false, fmt.Sprintf("%d of %d updated replicas are available", deployment.Status.AvailableReplicas, deployment.Status.UpdatedReplicas), nil
+	}
+	return true, "", nil
+}
+
+func getStatefulSetReadiness(statefulSet *appsv1.StatefulSet) (bool, string, error) {
+	if statefulSet.Generation > statefulSet.Status.ObservedGeneration {
+		return false, "waiting for the statefulset spec update to be observed", nil
+	}
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d replicas are ready", statefulSet.Status.ReadyReplicas, replicas), nil
+	}
+	if statefulSet.Spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType && statefulSet.Status.UpdateRevision != statefulSet.Status.CurrentRevision {
+		return false, fmt.Sprintf("%d out of %d new replicas have been updated", statefulSet.Status.UpdatedReplicas, replicas), nil
+	}
+	return true, "", nil
+}
+
+func getDaemonSetReadiness(daemonSet *appsv1.DaemonSet) (bool, string, error) {
+	if daemonSet.Generation > daemonSet.Status.ObservedGeneration {
+		return false, "waiting for the daemonset spec update to be observed", nil
+	}
+	desired := daemonSet.Status.DesiredNumberScheduled
+	if daemonSet.Status.UpdatedNumberScheduled < desired {
+		return false, fmt.Sprintf("%d out of %d new pods have been updated", daemonSet.Status.UpdatedNumberScheduled, desired), nil
+	}
+	if daemonSet.Status.NumberAvailable < desired {
+		return false, fmt.Sprintf("%d of %d updated pods are available", daemonSet.Status.NumberAvailable, desired), nil
+	}
+	return true, "", nil
+}
+
+func getJobReadiness(job *batchv1.Job) (bool, string, error) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return true, "", nil
+		case batchv1.JobFailed:
+			return false, "", fmt.Errorf("job %q failed: %s", job.Name, cond.Message)
+		}
+	}
+	return false, fmt.Sprintf("%d pods active, %d succeeded", job.Status.Active, job.Status.Succeeded), nil
+}
+
+// getConditionsReadiness returns the readiness of a resource from its standard status conditions.
+// A resource without a Ready or Available condition is considered ready
+func getConditionsReadiness(u *unstructured.Unstructured) (bool, string, error) {
+	conditions, found, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil || !found {
+		return true, "", nil
+	}
+	ready := true
+	message := ""
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		condType, _, _ := unstructured.NestedString(cond, "type")
+		status, _, _ := unstructured.NestedString(cond, "status")
+		condMessage, _, _ := unstructured.NestedString(cond, "message")
+		switch condType {
+		case "Failed":
+			if status == string(metav1.ConditionTrue) {
+				return false, "", fmt.Errorf("%s", condMessage)
+			}
+		case "Ready", "Available":
+			if status != string(metav1.ConditionTrue) {
+				ready = false
+				message = fmt.Sprintf("condition %s is %s", condType, status)
+				if condMessage != "" {
+					message += ": " + condMessage
+				}
+			}
+		}
+	}
+	return ready, message, nil
+}
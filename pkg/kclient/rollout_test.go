@@ -0,0 +1,182 @@
+package kclient
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGetResourceReadiness(t *testing.T) {
+	tests := []struct {
+		name      string
+		object    map[string]interface{}
+		wantReady bool
+		wantErr   bool
+	}{
+		{
+			name: "deployment rolled out",
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "my-deployment", "generation": int64(2)},
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(2),
+					"updatedReplicas":    int64(2),
+					"availableReplicas":  int64(2),
+				},
+			},
+			wantReady: true,
+		},
+		{
+			name: "deployment with unavailable replicas",
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "my-deployment", "generation": int64(1)},
+				"spec":       map[string]interface{}{},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(1),
+					"replicas":           int64(1),
+					"updatedReplicas":    int64(1),
+					"availableReplicas":  int64(0),
+				},
+			},
+			wantReady: false,
+		},
+		{
+			name: "deployment spec update not observed",
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "my-deployment", "generation": int64(3)},
+				"status":     map[string]interface{}{"observedGeneration": int64(2)},
+			},
+			wantReady: false,
+		},
+		{
+			name: "deployment exceeding its progress deadline",
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "my-deployment", "generation": int64(1)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(1),
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "statefulset with ready replicas",
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"metadata":   map[string]interface{}{"name": "my-statefulset", "generation": int64(1)},
+				"spec":       map[string]interface{}{"replicas": int64(1)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(1),
+					"readyReplicas":      int64(1),
+					"currentRevision":    "rev1",
+					"updateRevision":     "rev1",
+				},
+			},
+			wantReady: true,
+		},
+		{
+			name: "complete job",
+			object: map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   map[string]interface{}{"name": "my-job"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Complete", "status": "True"},
+					},
+				},
+			},
+			wantReady: true,
+		},
+		{
+			name: "failed job",
+			object: map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   map[string]interface{}{"name": "my-job"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Failed", "status": "True", "message": "BackoffLimitExceeded"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "custom resource not ready",
+			object: map[string]interface{}{
+				"apiVersion": "postgresql.example.com/v1",
+				"kind":       "Database",
+				"metadata":   map[string]interface{}{"name": "my-db"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False", "message": "provisioning"},
+					},
+				},
+			},
+			wantReady: false,
+		},
+		{
+			name: "resource without status",
+			object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "my-config"},
+			},
+			wantReady: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, message, err := GetResourceReadiness(&unstructured.Unstructured{Object: tt.object})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetResourceReadiness() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ready != tt.wantReady {
+				t.Errorf("GetResourceReadiness() ready = %v, want %v", ready, tt.wantReady)
+			}
+			if !ready && err == nil && message == "" {
+				t.Errorf("GetResourceReadiness() should return a message when the resource is not ready")
+			}
+		})
+	}
+}
+
+func Test_filterResourcesEvents(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{"kind": "Deployment", "metadata": map[string]interface{}{"name": "my-deployment"}}},
+		{Object: map[string]interface{}{"kind": "Job", "metadata": map[string]interface{}{"name": "my-job"}}},
+	}
+	events := map[string]corev1.Event{
+		"deployment": {InvolvedObject: corev1.ObjectReference{Kind: "Deployment", Name: "my-deployment"}},
+		"pod":        {InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "my-deployment-5d8f7b9c4-x2k8p"}},
+		"job-pod":    {InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "my-job-7hf2d"}},
+		"other":      {InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other-component-5d8f7b9c4-x2k8p"}},
+		"prefix":     {InvolvedObject: corev1.ObjectReference{Kind: "Deployment", Name: "my-deployment2"}},
+	}
+	got := filterResourcesEvents(events, resources)
+	var names []string
+	for name := range got {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	want := []string{"deployment", "job-pod", "pod"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("filterResourcesEvents() = %v, want %v", names, want)
+	}
+}
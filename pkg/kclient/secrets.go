@@ -133,7 +133,10 @@ func (c *Client) CreateSecret(objectMeta metav1.ObjectMeta, data map[string]stri
 		Type:       corev1.SecretTypeOpaque,
 		StringData: data,
 	}
-	secret.SetOwnerReferences(append(secret.GetOwnerReferences(), ownerReference))
+	// the secret is not owned by any resource when the owner reference is empty
+	if ownerReference.Name != "" {
+		secret.SetOwnerReferences(append(secret.GetOwnerReferences(), ownerReference))
+	}
 	_, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Create(context.TODO(), &secret, metav1.CreateOptions{FieldManager: FieldManager})
 	if err != nil {
 		return fmt.Errorf("unable to create secret for %s: %w", objectMeta.Name, err)
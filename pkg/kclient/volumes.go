@@ -7,6 +7,7 @@ import (
 
 	"github.com/devfile/library/pkg/devfile/generator"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -62,6 +63,20 @@ func (c *Client) GetPVCFromName(pvcName string) (*corev1.PersistentVolumeClaim,
 	return c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Get(context.TODO(), pvcName, metav1.GetOptions{})
 }
 
+// UpdatePVC updates the given PVC, for example to request a larger size
+func (c *Client) UpdatePVC(pvc *corev1.PersistentVolumeClaim) error {
+	_, err := c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Update(context.TODO(), pvc, metav1.UpdateOptions{FieldManager: FieldManager})
+	if err != nil {
+		return fmt.Errorf("unable to update PVC %q: %w", pvc.Name, err)
+	}
+	return nil
+}
+
+// GetStorageClass returns the StorageClass of the given name
+func (c *Client) GetStorageClass(name string) (*storagev1.StorageClass, error) {
+	return c.KubeClient.StorageV1().StorageClasses().Get(context.TODO(), name, metav1.GetOptions{})
+}
+
 // UpdatePVCLabels updates the given PVC with the given labels
 func (c *Client) UpdatePVCLabels(pvc *corev1.PersistentVolumeClaim, labels map[string]string) error {
 	pvc.Labels = labels
@@ -0,0 +1,482 @@
+package libdevfile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/yaml"
+)
+
+// HelmValuesAttribute is the attribute of a Kubernetes component referencing a Helm chart, listing the values files,
+// relative to the directory of the devfile, merged in this order over the values.yaml file of the chart
+const HelmValuesAttribute = "dev.odo.helm.values"
+
+const (
+	helmChartFile  = "Chart.yaml"
+	helmValuesFile = "values.yaml"
+	// helmChartsDir is the directory containing the dependencies of a chart
+	helmChartsDir = "charts"
+	// noValue is printed by the templates for an undefined value
+	noValue = "<no value>"
+)
+
+var kustomizationFiles = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+var yamlSeparator = regexp.MustCompile(`(?m)^---.*$`)
+
+// K8sRenderValues are the values computed by odo to render the Helm charts and Kustomize directories of the Kubernetes components
+type K8sRenderValues struct {
+	ComponentName string
+	Namespace     string
+	// Images are the names of the images of the image components, indexed by the names of the image components
+	Images map[string]string
+}
+
+// GetK8sRenderValues returns the values computed by odo for the devfile, to deploy the component in namespace
+func GetK8sRenderValues(devfileObj parser.DevfileObj, namespace string) (K8sRenderValues, error) {
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.ImageComponentType},
+	})
+	if err != nil {
+		return K8sRenderValues{}, err
+	}
+	images := map[string]string{}
+	for _, component := range components {
+		images[component.Name] = component.Image.ImageName
+	}
+	return K8sRenderValues{
+		ComponentName: devfileObj.GetMetadataName(),
+		Namespace:     namespace,
+		Images:        images,
+	}, nil
+}
+
+// IsK8sComponentRendered returns true if the URI of the Kubernetes component references a local directory,
+// containing a Helm chart or a Kustomize directory
+func IsK8sComponentRendered(component *v1alpha2.KubernetesComponent, context string, fs devfilefs.Filesystem) bool {
+	if component == nil || component.Uri == "" {
+		return false
+	}
+	parsedURL, err := url.Parse(component.Uri)
+	if err == nil && len(parsedURL.Host) != 0 && len(parsedURL.Scheme) != 0 {
+		return false
+	}
+	info, err := fs.Stat(filepath.Join(context, component.Uri))
+	return err == nil && info.IsDir()
+}
+
+// RenderK8sComponents returns the Kubernetes components, where each component referencing a Helm chart or a Kustomize directory
+// is replaced by an inlined Kubernetes component of the same name for each resource rendered
+func RenderK8sComponents(components []v1alpha2.Component, context string, fs devfilefs.Filesystem, values K8sRenderValues) ([]v1alpha2.Component, error) {
+	var result []v1alpha2.Component
+	for _, component := range components {
+		if !IsK8sComponentRendered(component.Kubernetes, context, fs) {
+			result = append(result, component)
+			continue
+		}
+		manifests, err := renderK8sComponent(component, context, fs, values)
+		if err != nil {
+			return nil, fmt.Errorf("unable to render the Kubernetes component %q: %w", component.Name, err)
+		}
+		for _, manifest := range manifests {
+			rendered := *component.DeepCopy()
+			rendered.Kubernetes.Uri = ""
+			rendered.Kubernetes.Inlined = manifest
+			result = append(result, rendered)
+		}
+	}
+	return result, nil
+}
+
+// renderK8sComponent returns the manifests of the resources rendered from the Helm chart or Kustomize directory of the component
+func renderK8sComponent(component v1alpha2.Component, context string, fs devfilefs.Filesystem, values K8sRenderValues) ([]string, error) {
+	dir := filepath.Join(context, component.Kubernetes.Uri)
+	var output string
+	var err error
+	if _, statErr := fs.Stat(filepath.Join(dir, helmChartFile)); statErr == nil {
+		var valuesFiles []string
+		if component.Attributes.Exists(HelmValuesAttribute) {
+			err = component.Attributes.GetInto(HelmValuesAttribute, &valuesFiles)
+			if err != nil {
+				return nil, fmt.Errorf("the attribute %q must be a list of values files: %w", HelmValuesAttribute, err)
+			}
+		}
+		output, err = renderHelmChart(dir, context, valuesFiles, fs, values)
+	} else if isKustomizeDir(dir, fs) {
+		output, err = renderKustomizeDir(dir, fs)
+	} else {
+		return nil, fmt.Errorf("the directory %q contains neither a Helm chart nor a kustomization file", component.Kubernetes.Uri)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []string
+	for _, document := range yamlSeparator.Split(output, -1) {
+		var obj map[string]interface{}
+		err = yaml.Unmarshal([]byte(document), &obj)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rendered manifest: %w\n%s", err, document)
+		}
+		if len(obj) == 0 {
+			// empty document, or containing only comments
+			continue
+		}
+		replaceImages(obj, values.Images)
+		manifest, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, string(manifest))
+	}
+	return manifests, nil
+}
+
+// isKustomizeDir returns true if the directory contains a kustomization file
+func isKustomizeDir(dir string, fs devfilefs.Filesystem) bool {
+	for _, name := range kustomizationFiles {
+		if _, err := fs.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// renderKustomizeDir runs the kustomization of the directory, read from fs
+func renderKustomizeDir(dir string, fs devfilefs.Filesystem) (string, error) {
+	resources, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(kustomizeFs{fs: fs}, dir)
+	if err != nil {
+		return "", err
+	}
+	output, err := resources.AsYaml()
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+// renderHelmChart renders the templates of the Helm chart in dir, with the values of the chart, the values files, relative to context,
+// and the values computed by odo under the odo key.
+// An error is returned if the chart uses a feature not supported by odo: the dependencies of the chart, the .Files and .Capabilities objects
+// and the lookup function. Contrary to Helm, printing an undefined value is an error, instead of printing an empty string
+func renderHelmChart(dir string, context string, valuesFiles []string, fs devfilefs.Filesystem, values K8sRenderValues) (string, error) {
+	content, err := fs.ReadFile(filepath.Join(dir, helmChartFile))
+	if err != nil {
+		return "", err
+	}
+	var chart map[string]interface{}
+	err = yaml.Unmarshal(content, &chart)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", helmChartFile, err)
+	}
+	chartName, _ := chart["name"].(string)
+	if chartName == "" {
+		return "", fmt.Errorf("the name of the chart is missing in %s", helmChartFile)
+	}
+	if _, ok := chart["dependencies"]; ok {
+		return "", fmt.Errorf("the chart %q has dependencies, which are not supported", chartName)
+	}
+	if _, err = fs.Stat(filepath.Join(dir, helmChartsDir)); err == nil {
+		return "", fmt.Errorf("the chart %q has dependencies in the %s directory, which are not supported", chartName, helmChartsDir)
+	}
+
+	chartValues := map[string]interface{}{}
+	files := []string{filepath.Join(dir, helmValuesFile)}
+	for _, file := range valuesFiles {
+		files = append(files, filepath.Join(context, file))
+	}
+	for i, file := range files {
+		content, err = fs.ReadFile(file)
+		if i == 0 && os.IsNotExist(err) {
+			// the values.yaml file of the chart is optional
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		var fileValues map[string]interface{}
+		err = yaml.Unmarshal(content, &fileValues)
+		if err != nil {
+			return "", fmt.Errorf("invalid values file %q: %w", file, err)
+		}
+		mergeValues(chartValues, fileValues)
+	}
+	chartValues["odo"] = map[string]interface{}{
+		"componentName": values.ComponentName,
+		"namespace":     values.Namespace,
+		"images":        values.Images,
+	}
+
+	// the chart object exposes the fields of Chart.yaml with capitalized names, as Helm does
+	chartObject := map[string]interface{}{}
+	for key, value := range chart {
+		if key == "" {
+			continue
+		}
+		chartObject[strings.ToUpper(key[:1])+key[1:]] = value
+	}
+	top := map[string]interface{}{
+		"Values": chartValues,
+		"Chart":  chartObject,
+		"Release": map[string]interface{}{
+			"Name":      values.ComponentName,
+			"Namespace": values.Namespace,
+			"Service":   "odo",
+			"IsInstall": true,
+			"IsUpgrade": false,
+			"Revision":  1,
+		},
+	}
+
+	t := template.New(chartName).Option("missingkey=zero")
+	t.Funcs(getHelmFuncMap(t))
+	templatesDir := filepath.Join(dir, "templates")
+	var names []string
+	err = fs.Walk(templatesDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		name := chartName + "/" + filepath.ToSlash(rel)
+		content, err := fs.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		parsed, err := t.New(name).Parse(string(content))
+		if err != nil {
+			return err
+		}
+		err = checkHelmTemplate(parsed.Tree)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if !strings.HasPrefix(info.Name(), "_") && info.Name() != "NOTES.txt" {
+			names = append(names, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(names)
+
+	var output strings.Builder
+	for _, name := range names {
+		top["Template"] = map[string]interface{}{
+			"Name":     name,
+			"BasePath": chartName + "/templates",
+		}
+		var buf bytes.Buffer
+		err = t.ExecuteTemplate(&buf, name, top)
+		if err != nil {
+			return "", err
+		}
+		if strings.Contains(buf.String(), noValue) {
+			return "", fmt.Errorf("%s: the template prints a value which is not defined", name)
+		}
+		output.WriteString("\n---\n")
+		output.WriteString(buf.String())
+	}
+	return output.String(), nil
+}
+
+// checkHelmTemplate returns an error if the parsed template uses the .Files or .Capabilities objects, or the lookup function,
+// which are not supported
+func checkHelmTemplate(tree *parse.Tree) error {
+	if tree == nil || tree.Root == nil {
+		return nil
+	}
+	var err error
+	var check func(node parse.Node)
+	check = func(node parse.Node) {
+		if err != nil || node == nil || reflect.ValueOf(node).IsNil() {
+			return
+		}
+		var idents []string
+		switch n := node.(type) {
+		case *parse.ListNode:
+			for _, child := range n.Nodes {
+				check(child)
+			}
+		case *parse.ActionNode:
+			check(n.Pipe)
+		case *parse.PipeNode:
+			for _, cmd := range n.Cmds {
+				check(cmd)
+			}
+		case *parse.CommandNode:
+			for _, arg := range n.Args {
+				check(arg)
+			}
+		case *parse.ChainNode:
+			check(n.Node)
+		case *parse.IfNode:
+			check(n.Pipe)
+			check(n.List)
+			check(n.ElseList)
+		case *parse.RangeNode:
+			check(n.Pipe)
+			check(n.List)
+			check(n.ElseList)
+		case *parse.WithNode:
+			check(n.Pipe)
+			check(n.List)
+			check(n.ElseList)
+		case *parse.TemplateNode:
+			check(n.Pipe)
+		case *parse.IdentifierNode:
+			if n.Ident == "lookup" {
+				err = errors.New("the lookup function is not supported")
+			}
+		case *parse.FieldNode:
+			idents = n.Ident
+		case *parse.VariableNode:
+			if len(n.Ident) > 1 && n.Ident[0] == "$" {
+				idents = n.Ident[1:]
+			}
+		}
+		if len(idents) > 0 && (idents[0] == "Files" || idents[0] == "Capabilities") {
+			err = fmt.Errorf("the .%s object is not supported", idents[0])
+		}
+	}
+	check(tree.Root)
+	return err
+}
+
+// getHelmFuncMap returns the functions available to the templates of the Helm charts: the functions of the sprig library,
+// except the ones accessing the environment, and the functions added by Helm. The lookup function is defined for the templates
+// to be parsed, and returns an error
+func getHelmFuncMap(t *template.Template) template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	delete(funcs, "env")
+	delete(funcs, "expandenv")
+
+	funcs["toYaml"] = func(v interface{}) string {
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSuffix(string(data), "\n")
+	}
+	funcs["fromYaml"] = func(str string) map[string]interface{} {
+		m := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(str), &m); err != nil {
+			m["Error"] = err.Error()
+		}
+		return m
+	}
+	funcs["required"] = func(msg string, v interface{}) (interface{}, error) {
+		if v == nil {
+			return nil, errors.New(msg)
+		}
+		if s, ok := v.(string); ok && s == "" {
+			return nil, errors.New(msg)
+		}
+		return v, nil
+	}
+	funcs["include"] = func(name string, data interface{}) (string, error) {
+		var buf strings.Builder
+		err := t.ExecuteTemplate(&buf, name, data)
+		return buf.String(), err
+	}
+	funcs["tpl"] = func(text string, data interface{}) (string, error) {
+		clone, err := t.Clone()
+		if err != nil {
+			return "", err
+		}
+		parsed, err := clone.New("tpl").Parse(text)
+		if err != nil {
+			return "", err
+		}
+		err = checkHelmTemplate(parsed.Tree)
+		if err != nil {
+			return "", err
+		}
+		var buf strings.Builder
+		err = parsed.Execute(&buf, data)
+		if err == nil && strings.Contains(buf.String(), noValue) {
+			err = errors.New("the tpl template prints a value which is not defined")
+		}
+		return buf.String(), err
+	}
+	funcs["lookup"] = func(apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+		return nil, errors.New("the lookup function is not supported")
+	}
+	return funcs
+}
+
+// mergeValues merges recursively the values of src into dst
+func mergeValues(dst map[string]interface{}, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeValues(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// replaceImages replaces, in the containers and init containers of the resource, the images referencing an image component by its name,
+// with any tag or digest, by the name of the image of the component
+func replaceImages(obj interface{}, images map[string]string) {
+	switch value := obj.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if key != "containers" && key != "initContainers" {
+				replaceImages(field, images)
+				continue
+			}
+			containers, ok := field.([]interface{})
+			if !ok {
+				continue
+			}
+			for _, container := range containers {
+				c, ok := container.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if image, ok := c["image"].(string); ok {
+					if imageName, ok := images[GetImageRepository(image)]; ok {
+						c["image"] = imageName
+					}
+				}
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			replaceImages(item, images)
+		}
+	}
+}
+
+// GetImageRepository returns the name of the image, without its tag or digest
+func GetImageRepository(imageName string) string {
+	if i := strings.Index(imageName, "@"); i >= 0 {
+		imageName = imageName[:i]
+	}
+	if i := strings.LastIndex(imageName, ":"); i > strings.LastIndex(imageName, "/") {
+		imageName = imageName[:i]
+	}
+	return imageName
+}
@@ -0,0 +1,281 @@
+package libdevfile
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func getKubernetesComponent(name string, uri string, inlined string) v1alpha2.Component {
+	return v1alpha2.Component{
+		Name: name,
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Kubernetes: &v1alpha2.KubernetesComponent{
+				K8sLikeComponent: v1alpha2.K8sLikeComponent{
+					K8sLikeComponentLocation: v1alpha2.K8sLikeComponentLocation{
+						Uri:     uri,
+						Inlined: inlined,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRenderK8sComponents(t *testing.T) {
+	context := t.TempDir()
+	writeFiles(t, context, map[string]string{
+		"chart/Chart.yaml":  "apiVersion: v2\nname: my-chart\nversion: 1.0.0\n",
+		"chart/values.yaml": "replicas: 1\nport: 8080\n",
+		"chart/templates/_helpers.tpl": `{{- define "my-chart.fullname" -}}
+{{ .Release.Name }}-{{ .Chart.Name }}
+{{- end -}}`,
+		"chart/templates/NOTES.txt": "deployed {{ .Release.Name }}",
+		"chart/templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ include "my-chart.fullname" . }}
+  namespace: {{ .Release.Namespace }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    spec:
+      containers:
+      - name: main
+        image: {{ .Values.odo.images.backend | quote }}
+---
+# only a comment
+`,
+		"chart/templates/service.yaml": `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ include "my-chart.fullname" . }}
+spec:
+  ports:
+  - port: {{ .Values.port }}
+`,
+		"values-prod.yaml": "replicas: 3\n",
+		"kustomize/kustomization.yaml": `namePrefix: prod-
+resources:
+- deployment.yaml
+`,
+		"kustomize/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: frontend
+spec:
+  template:
+    spec:
+      containers:
+      - name: main
+        image: frontend:latest
+      initContainers:
+      - name: init
+        image: busybox
+`,
+		"empty/file.yaml": "",
+	})
+
+	chart := getKubernetesComponent("chart", "chart", "")
+	chart.Attributes = attributes.Attributes{}.Put(HelmValuesAttribute, []string{"values-prod.yaml"}, nil)
+	inlined := getKubernetesComponent("inlined", "", "kind: ConfigMap")
+	values := K8sRenderValues{
+		ComponentName: "my-component",
+		Namespace:     "my-ns",
+		Images:        map[string]string{"backend": "quay.io/user/backend", "frontend": "quay.io/user/frontend"},
+	}
+
+	tests := []struct {
+		name       string
+		components []v1alpha2.Component
+		want       []v1alpha2.Component
+		wantErr    bool
+	}{
+		{
+			name:       "Helm chart with values file",
+			components: []v1alpha2.Component{chart, inlined},
+			want: []v1alpha2.Component{
+				func() v1alpha2.Component {
+					c := *chart.DeepCopy()
+					c.Kubernetes.Uri = ""
+					c.Kubernetes.Inlined = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-component-my-chart
+  namespace: my-ns
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - image: quay.io/user/backend
+        name: main
+`
+					return c
+				}(),
+				func() v1alpha2.Component {
+					c := *chart.DeepCopy()
+					c.Kubernetes.Uri = ""
+					c.Kubernetes.Inlined = `apiVersion: v1
+kind: Service
+metadata:
+  name: my-component-my-chart
+spec:
+  ports:
+  - port: 8080
+`
+					return c
+				}(),
+				inlined,
+			},
+		},
+		{
+			name:       "Kustomize directory",
+			components: []v1alpha2.Component{getKubernetesComponent("kustomize", "kustomize", "")},
+			want: []v1alpha2.Component{
+				getKubernetesComponent("kustomize", "", `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: prod-frontend
+spec:
+  template:
+    spec:
+      containers:
+      - image: quay.io/user/frontend
+        name: main
+      initContainers:
+      - image: busybox
+        name: init
+`),
+			},
+		},
+		{
+			name:       "directory without chart or kustomization",
+			components: []v1alpha2.Component{getKubernetesComponent("empty", "empty", "")},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderK8sComponents(tt.components, context, devfilefs.DefaultFs{}, values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderK8sComponents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RenderK8sComponents() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderK8sComponents_kustomizeFromFs(t *testing.T) {
+	fs := devfilefs.NewFakeFs()
+	files := map[string]string{
+		"/project/base/kustomization.yaml": "resources:\n- configmap.yaml\n",
+		"/project/base/configmap.yaml":     "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n",
+		"/project/overlay/kustomization.yaml": `namePrefix: prod-
+resources:
+- ../base
+`,
+	}
+	for name, content := range files {
+		if err := fs.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := fs.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := RenderK8sComponents([]v1alpha2.Component{getKubernetesComponent("overlay", "overlay", "")}, "/project", fs, K8sRenderValues{})
+	if err != nil {
+		t.Fatalf("RenderK8sComponents() unexpected error = %v", err)
+	}
+	want := []v1alpha2.Component{getKubernetesComponent("overlay", "", `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: prod-config
+`)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RenderK8sComponents() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRenderK8sComponents_unsupportedHelmFeatures(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name: ".Files object",
+			files: map[string]string{
+				"templates/configmap.yaml": "data:\n  config: {{ .Files.Get \"config.ini\" | quote }}\n",
+			},
+			wantErr: "the .Files object is not supported",
+		},
+		{
+			name: ".Capabilities object from the root",
+			files: map[string]string{
+				"templates/configmap.yaml": "{{ range .Values.list }}version: {{ $.Capabilities.KubeVersion.Version }}{{ end }}\n",
+			},
+			wantErr: "the .Capabilities object is not supported",
+		},
+		{
+			name: "lookup function",
+			files: map[string]string{
+				"templates/configmap.yaml": "{{ $cm := lookup \"v1\" \"ConfigMap\" \"ns\" \"name\" }}kind: ConfigMap\n",
+			},
+			wantErr: "the lookup function is not supported",
+		},
+		{
+			name: "dependencies in the charts directory",
+			files: map[string]string{
+				"templates/configmap.yaml":     "kind: ConfigMap\n",
+				"charts/dependency/Chart.yaml": "name: dependency\n",
+			},
+			wantErr: "dependencies in the charts directory",
+		},
+		{
+			name: "undefined value printed",
+			files: map[string]string{
+				"templates/configmap.yaml": "kind: ConfigMap\nmetadata:\n  name: {{ .Values.undefined }}\n",
+			},
+			wantErr: "prints a value which is not defined",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			context := t.TempDir()
+			files := map[string]string{
+				"chart/Chart.yaml": "apiVersion: v2\nname: my-chart\n\"\": empty key\n",
+			}
+			for name, content := range tt.files {
+				files["chart/"+name] = content
+			}
+			writeFiles(t, context, files)
+			_, err := RenderK8sComponents([]v1alpha2.Component{getKubernetesComponent("chart", "chart", "")}, context, devfilefs.DefaultFs{}, K8sRenderValues{})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("RenderK8sComponents() error = %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
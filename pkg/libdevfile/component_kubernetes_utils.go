@@ -12,13 +12,9 @@ import (
 
 // GetK8sComponentAsUnstructured parses the Inlined/URI K8s of the devfile K8s component
 func GetK8sComponentAsUnstructured(component *v1alpha2.KubernetesComponent, context string, fs devfilefs.Filesystem) (unstructured.Unstructured, error) {
-	strCRD := component.Inlined
-	var err error
-	if component.Uri != "" {
-		strCRD, err = util.GetDataFromURI(component.Uri, context, fs)
-		if err != nil {
-			return unstructured.Unstructured{}, err
-		}
+	strCRD, err := GetK8sComponentManifest(component, context, fs)
+	if err != nil {
+		return unstructured.Unstructured{}, err
 	}
 
 	// convert the YAML definition into map[string]interface{} since it's needed to create dynamic resource
@@ -29,7 +25,16 @@ func GetK8sComponentAsUnstructured(component *v1alpha2.KubernetesComponent, cont
 	return u, nil
 }
 
-// ListKubernetesComponents lists all the kubernetes components from the devfile
+// GetK8sComponentManifest returns the YAML definition of a Kubernetes component, either inlined or from its URI
+func GetK8sComponentManifest(component *v1alpha2.KubernetesComponent, context string, fs devfilefs.Filesystem) (string, error) {
+	if component.Uri != "" {
+		return util.GetDataFromURI(component.Uri, context, fs)
+	}
+	return component.Inlined, nil
+}
+
+// ListKubernetesComponents lists all the kubernetes components from the devfile.
+// The resources are rendered from the Helm charts and Kustomize directories without namespace
 func ListKubernetesComponents(devfileObj parser.DevfileObj, path string) (list []unstructured.Unstructured, err error) {
 	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
 		ComponentOptions: common.ComponentOptions{ComponentType: v1alpha2.KubernetesComponentType},
@@ -37,6 +42,14 @@ func ListKubernetesComponents(devfileObj parser.DevfileObj, path string) (list [
 	if err != nil {
 		return
 	}
+	values, err := GetK8sRenderValues(devfileObj, "")
+	if err != nil {
+		return
+	}
+	components, err = RenderK8sComponents(components, path, devfilefs.DefaultFs{}, values)
+	if err != nil {
+		return
+	}
 	var u unstructured.Unstructured
 	for _, kComponent := range components {
 		if kComponent.Kubernetes != nil {
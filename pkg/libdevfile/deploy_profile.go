@@ -0,0 +1,54 @@
+package libdevfile
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+)
+
+// DeployProfilesAttribute is the top-level attribute of the devfile defining the deploy profiles, indexed by their names
+const DeployProfilesAttribute = "dev.odo.deploy.profiles"
+
+// DeployProfile is a named target of odo deploy
+type DeployProfile struct {
+	// Command is the name of the Deploy command to run, the default Deploy command if empty
+	Command string `json:"command,omitempty"`
+	// Namespace is the namespace to deploy to, the namespace of the component if empty
+	Namespace string `json:"namespace,omitempty"`
+	// Variables override the values of the variables of the devfile
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// GetDeployProfiles returns the deploy profiles defined in the devfile, indexed by their names
+func GetDeployProfiles(devfileObj parser.DevfileObj) (map[string]DeployProfile, error) {
+	attributes, err := devfileObj.Data.GetAttributes()
+	if err != nil || !attributes.Exists(DeployProfilesAttribute) {
+		// no top-level attributes in devfile schema 2.0.0
+		return map[string]DeployProfile{}, nil
+	}
+	profiles := map[string]DeployProfile{}
+	err = attributes.GetInto(DeployProfilesAttribute, &profiles)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the attribute %q of the devfile: %w", DeployProfilesAttribute, err)
+	}
+	return profiles, nil
+}
+
+// GetDeployProfile returns the deploy profile of the devfile with the given name
+func GetDeployProfile(devfileObj parser.DevfileObj, name string) (DeployProfile, error) {
+	profiles, err := GetDeployProfiles(devfileObj)
+	if err != nil {
+		return DeployProfile{}, err
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		names := make([]string, 0, len(profiles))
+		for n := range profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return DeployProfile{}, fmt.Errorf("no deploy profile %q found in devfile, the profiles defined in the %q attribute are %v", name, DeployProfilesAttribute, names)
+	}
+	return profile, nil
+}
@@ -0,0 +1,78 @@
+package libdevfile
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	v2 "github.com/devfile/library/pkg/devfile/parser/data/v2"
+)
+
+func TestGetDeployProfile(t *testing.T) {
+	getDevfileObj := func(version string, profiles interface{}) parser.DevfileObj {
+		devfileData, _ := data.NewDevfileData(version)
+		if profiles != nil {
+			devfileData.(*v2.DevfileV2).Attributes = attributes.Attributes{}.Put(DeployProfilesAttribute, profiles, nil)
+		}
+		return parser.DevfileObj{Data: devfileData}
+	}
+	profiles := map[string]interface{}{
+		"staging": map[string]interface{}{
+			"command":   "deploy-staging",
+			"namespace": "myapp-staging",
+			"variables": map[string]interface{}{"REPLICAS": "1"},
+		},
+		"production": map[string]interface{}{
+			"command": "deploy-production",
+		},
+	}
+	tests := []struct {
+		name        string
+		devfileObj  parser.DevfileObj
+		profileName string
+		want        DeployProfile
+		wantErr     bool
+	}{
+		{
+			name:        "profile with command, namespace and variables",
+			devfileObj:  getDevfileObj(string(data.APISchemaVersion220), profiles),
+			profileName: "staging",
+			want: DeployProfile{
+				Command:   "deploy-staging",
+				Namespace: "myapp-staging",
+				Variables: map[string]string{"REPLICAS": "1"},
+			},
+		},
+		{
+			name:        "profile with command only",
+			devfileObj:  getDevfileObj(string(data.APISchemaVersion220), profiles),
+			profileName: "production",
+			want:        DeployProfile{Command: "deploy-production"},
+		},
+		{
+			name:        "profile not defined",
+			devfileObj:  getDevfileObj(string(data.APISchemaVersion220), profiles),
+			profileName: "dev",
+			wantErr:     true,
+		},
+		{
+			name:        "no profile defined",
+			devfileObj:  getDevfileObj(string(data.APISchemaVersion200), nil),
+			profileName: "staging",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetDeployProfile(tt.devfileObj, tt.profileName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDeployProfile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetDeployProfile() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
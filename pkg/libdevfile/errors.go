@@ -20,6 +20,22 @@ func (e NoCommandFoundError) Error() string {
 	return fmt.Sprintf("no %s command found in devfile", e.kind)
 }
 
+// NoCommandNameFoundError is returned when no command of the specified kind with the specified name is found in devfile
+type NoCommandNameFoundError struct {
+	kind v1alpha2.CommandGroupKind
+	name string
+}
+
+func NewNoCommandNameFoundError(kind v1alpha2.CommandGroupKind, name string) NoCommandNameFoundError {
+	return NoCommandNameFoundError{
+		kind: kind,
+		name: name,
+	}
+}
+func (e NoCommandNameFoundError) Error() string {
+	return fmt.Sprintf("no %s command with name %q found in devfile", e.kind, e.name)
+}
+
 // NoDefaultCommandFoundError is returned when several commands of the specified kind exist
 // but no one is the default one
 type NoDefaultCommandFoundError struct {
@@ -0,0 +1,132 @@
+package libdevfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// kustomizeFs implements the filesystem used by Kustomize on top of the filesystem of the devfile,
+// so that the Kustomize directories are read from the same filesystem as the devfile
+type kustomizeFs struct {
+	fs devfilefs.Filesystem
+}
+
+var _ filesys.FileSystem = kustomizeFs{}
+
+// kustomizeFile adds the Stat method required by Kustomize to the files of the filesystem of the devfile
+type kustomizeFile struct {
+	devfilefs.File
+	fs devfilefs.Filesystem
+}
+
+func (o kustomizeFile) Stat() (os.FileInfo, error) {
+	return o.fs.Stat(o.Name())
+}
+
+func (o kustomizeFs) Create(path string) (filesys.File, error) {
+	f, err := o.fs.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return kustomizeFile{File: f, fs: o.fs}, nil
+}
+
+func (o kustomizeFs) Mkdir(path string) error {
+	return o.fs.MkdirAll(path, 0777|os.ModeDir)
+}
+
+func (o kustomizeFs) MkdirAll(path string) error {
+	return o.fs.MkdirAll(path, 0777|os.ModeDir)
+}
+
+func (o kustomizeFs) RemoveAll(path string) error {
+	return o.fs.RemoveAll(path)
+}
+
+func (o kustomizeFs) Open(path string) (filesys.File, error) {
+	f, err := o.fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return kustomizeFile{File: f, fs: o.fs}, nil
+}
+
+func (o kustomizeFs) IsDir(path string) bool {
+	info, err := o.fs.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+func (o kustomizeFs) ReadDir(path string) ([]string, error) {
+	infos, err := o.fs.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	return names, nil
+}
+
+// CleanedAbs returns the absolute directory of path, and the name of the file if path is not a directory.
+// The symbolic links are not resolved
+func (o kustomizeFs) CleanedAbs(path string) (filesys.ConfirmedDir, string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", "", fmt.Errorf("abs path error on '%s' : %v", path, err)
+	}
+	if o.IsDir(abs) {
+		return filesys.ConfirmedDir(abs), "", nil
+	}
+	dir, file := filepath.Split(abs)
+	if !o.IsDir(dir) {
+		return "", "", fmt.Errorf("'%s' must be a file in an existing directory", path)
+	}
+	return filesys.ConfirmedDir(filepath.Clean(dir)), file, nil
+}
+
+func (o kustomizeFs) Exists(path string) bool {
+	_, err := o.fs.Stat(path)
+	return err == nil
+}
+
+// Glob returns the files matching the pattern, whose patterns are only supported in the last element
+func (o kustomizeFs) Glob(pattern string) ([]string, error) {
+	dir, filePattern := filepath.Split(pattern)
+	infos, err := o.fs.ReadDir(filepath.Clean(dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var result []string
+	for _, info := range infos {
+		matched, err := filepath.Match(filePattern, info.Name())
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			result = append(result, filepath.Join(dir, info.Name()))
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (o kustomizeFs) ReadFile(path string) ([]byte, error) {
+	return o.fs.ReadFile(path)
+}
+
+func (o kustomizeFs) WriteFile(path string, data []byte) error {
+	return o.fs.WriteFile(path, data, 0666)
+}
+
+func (o kustomizeFs) Walk(path string, walkFn filepath.WalkFunc) error {
+	return o.fs.Walk(path, walkFn)
+}
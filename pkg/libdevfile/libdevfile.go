@@ -14,9 +14,9 @@ type Handler interface {
 	Execute(command v1alpha2.Command) error
 }
 
-// Deploy executes the default Deploy command of the devfile
-func Deploy(devfileObj parser.DevfileObj, handler Handler) error {
-	deployCommand, err := getDefaultCommand(devfileObj, v1alpha2.DeployCommandGroupKind)
+// Deploy executes the Deploy command of the devfile named commandName, or the default Deploy command if commandName is empty
+func Deploy(devfileObj parser.DevfileObj, commandName string, handler Handler) error {
+	deployCommand, err := GetDeployCommand(devfileObj, commandName)
 	if err != nil {
 		return err
 	}
@@ -24,6 +24,32 @@ func Deploy(devfileObj parser.DevfileObj, handler Handler) error {
 	return executeCommand(devfileObj, deployCommand, handler)
 }
 
+// GetDeployCommand returns the command of the Deploy group named commandName, or the default Deploy command if commandName is empty
+func GetDeployCommand(devfileObj parser.DevfileObj, commandName string) (v1alpha2.Command, error) {
+	if commandName == "" {
+		return getDefaultCommand(devfileObj, v1alpha2.DeployCommandGroupKind)
+	}
+	return getCommandByName(devfileObj, v1alpha2.DeployCommandGroupKind, commandName)
+}
+
+// getCommandByName returns the command of the given kind in the devfile with the name commandName
+func getCommandByName(devfileObj parser.DevfileObj, kind v1alpha2.CommandGroupKind, commandName string) (v1alpha2.Command, error) {
+	groupCmds, err := devfileObj.Data.GetCommands(common.DevfileOptions{
+		CommandOptions: common.CommandOptions{
+			CommandGroupKind: kind,
+		},
+	})
+	if err != nil {
+		return v1alpha2.Command{}, err
+	}
+	for _, groupCmd := range groupCmds {
+		if groupCmd.Id == commandName {
+			return groupCmd, nil
+		}
+	}
+	return v1alpha2.Command{}, NewNoCommandNameFoundError(kind, commandName)
+}
+
 // getDefaultCommand returns the default command of the given kind in the devfile.
 // If only one command of the kind exists, it is returned, even if it is not marked as default
 func getDefaultCommand(devfileObj parser.DevfileObj, kind v1alpha2.CommandGroupKind) (v1alpha2.Command, error) {
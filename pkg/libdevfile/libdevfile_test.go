@@ -208,9 +208,17 @@ func TestDeploy(t *testing.T) {
 		Kubernetes: &v1alpha2.KubernetesComponent{},
 	})
 
+	deployStaging := generator.GetCompositeCommand(generator.CompositeCommandParams{
+		Kind:      v1alpha2.DeployCommandGroupKind,
+		Id:        "deploy-staging",
+		IsDefault: pointer.BoolPtr(false),
+		Commands:  []string{"deployment-command"},
+	})
+
 	type args struct {
-		devfileObj func() parser.DevfileObj
-		handler    func(ctrl *gomock.Controller) Handler
+		devfileObj  func() parser.DevfileObj
+		commandName string
+		handler     func(ctrl *gomock.Controller) Handler
 	}
 	tests := []struct {
 		name    string
@@ -237,12 +245,48 @@ func TestDeploy(t *testing.T) {
 				},
 			},
 		},
-		// TODO: Add test cases.
+		{
+			name: "deploy the command with the given name",
+			args: args{
+				devfileObj: func() parser.DevfileObj {
+					data, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+					_ = data.AddCommands([]v1alpha2.Command{deployDefault1, deployStaging, applyImageCommand, applyDeploymentCommand, applyServiceCommand})
+					_ = data.AddComponents([]v1alpha2.Component{imageComponent, deploymentComponent, serviceComponent})
+					return parser.DevfileObj{
+						Data: data,
+					}
+				},
+				commandName: "deploy-staging",
+				handler: func(ctrl *gomock.Controller) Handler {
+					h := NewMockHandler(ctrl)
+					h.EXPECT().ApplyKubernetes(deploymentComponent)
+					return h
+				},
+			},
+		},
+		{
+			name: "no deploy command with the given name",
+			args: args{
+				devfileObj: func() parser.DevfileObj {
+					data, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+					_ = data.AddCommands([]v1alpha2.Command{deployDefault1, applyImageCommand, applyDeploymentCommand, applyServiceCommand})
+					_ = data.AddComponents([]v1alpha2.Component{imageComponent, deploymentComponent, serviceComponent})
+					return parser.DevfileObj{
+						Data: data,
+					}
+				},
+				commandName: "deploy-production",
+				handler: func(ctrl *gomock.Controller) Handler {
+					return NewMockHandler(ctrl)
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			if err := Deploy(tt.args.devfileObj(), tt.args.handler(ctrl)); (err != nil) != tt.wantErr {
+			if err := Deploy(tt.args.devfileObj(), tt.args.commandName, tt.args.handler(ctrl)); (err != nil) != tt.wantErr {
 				t.Errorf("Deploy() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
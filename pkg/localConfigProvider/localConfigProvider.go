@@ -12,6 +12,10 @@ type LocalStorage struct {
 	Path string `yaml:"Path,omitempty"`
 	// Container is the container name on which this storage is mounted
 	Container string `yaml:"-" json:"-"`
+	// StorageClass of the PVC of the storage, the default StorageClass is used when empty
+	StorageClass string `yaml:"-" json:"-"`
+	// AccessModes of the PVC of the storage, the default access mode is used when empty
+	AccessModes []string `yaml:"-" json:"-"`
 }
 
 // LocalContainer holds the container related information
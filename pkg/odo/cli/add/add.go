@@ -0,0 +1,26 @@
+package add
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended add command name
+const RecommendedCommandName = "add"
+
+// NewCmdAdd implements the add odo command
+func NewCmdAdd(name, fullName string) *cobra.Command {
+	var addCmd = &cobra.Command{
+		Use:   name,
+		Short: "Add resources to devfile",
+	}
+
+	bindingCmd := NewCmdBinding(BindingRecommendedCommandName, util.GetFullName(fullName, BindingRecommendedCommandName))
+	serviceCmd := NewCmdService(ServiceRecommendedCommandName, util.GetFullName(fullName, ServiceRecommendedCommandName))
+	addCmd.AddCommand(bindingCmd, serviceCmd)
+	addCmd.Annotations = map[string]string{"command": "main"}
+	addCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return addCmd
+}
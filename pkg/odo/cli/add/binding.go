@@ -0,0 +1,136 @@
+package add
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/binding/backend"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+)
+
+// BindingRecommendedCommandName is the recommended binding sub-command name
+const BindingRecommendedCommandName = "binding"
+
+var addBindingExample = ktemplates.Examples(`
+# Add a binding between the component in the current directory and a service instance, selected interactively
+%[1]s
+
+# Add a binding between the component in the current directory and the Redis service instance named 'myredis'
+%[1]s --service Redis/myredis --name redis-binding
+
+# Add a binding injecting the binding information as environment variables with uppercase names
+%[1]s --service Redis/myredis --bind-as-files=false --naming-strategy uppercase
+`)
+
+type AddBindingOptions struct {
+	// Flags passed to the command
+	flags map[string]string
+
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+// NewAddBindingOptions returns new instance of AddBindingOptions
+func NewAddBindingOptions() *AddBindingOptions {
+	return &AddBindingOptions{}
+}
+
+func (o *AddBindingOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *AddBindingOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.flags = o.clientset.BindingClient.GetFlags(cmdline.GetFlags())
+
+	scontext.SetInteractive(cmdline.Context(), len(o.flags) == 0)
+
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(""))
+	if err != nil {
+		return err
+	}
+	// this ensures that the namespace set in env.yaml is used
+	o.clientset.KubernetesClient.SetNamespace(o.GetProject())
+	return nil
+}
+
+func (o *AddBindingOptions) Validate() (err error) {
+	return o.clientset.BindingClient.Validate(o.flags)
+}
+
+func (o *AddBindingOptions) Run(_ context.Context) error {
+	serviceMap, err := o.clientset.BindingClient.GetServiceInstances()
+	if err != nil {
+		return err
+	}
+
+	service, err := o.clientset.BindingClient.SelectServiceInstance(o.flags, serviceMap)
+	if err != nil {
+		return err
+	}
+	serviceInstance := serviceMap[service]
+
+	devfileObj := o.EnvSpecificInfo.GetDevfileObj()
+	componentName := devfileObj.GetMetadataName()
+
+	bindingName, err := o.clientset.BindingClient.AskBindingName(serviceInstance.GetName(), componentName, o.flags)
+	if err != nil {
+		return err
+	}
+
+	bindAsFiles, err := o.clientset.BindingClient.AskBindAsFiles(o.flags)
+	if err != nil {
+		return err
+	}
+
+	namingStrategy, err := o.clientset.BindingClient.AskNamingStrategy(o.flags)
+	if err != nil {
+		return err
+	}
+
+	devfileObj, err = o.clientset.BindingClient.AddBinding(bindingName, bindAsFiles, namingStrategy, serviceInstance, devfileObj, "app")
+	if err != nil {
+		return err
+	}
+
+	err = devfileObj.WriteYamlDevfile()
+	if err != nil {
+		return err
+	}
+
+	log.Successf("Successfully added the binding %q between the component %q and the service %q to the devfile.", bindingName, componentName, service)
+	log.Info("Run `odo dev` to create it on the cluster.")
+	return nil
+}
+
+// NewCmdBinding implements the binding odo sub-command
+func NewCmdBinding(name, fullName string) *cobra.Command {
+	o := NewAddBindingOptions()
+
+	var bindingCmd = &cobra.Command{
+		Use:     name,
+		Short:   "Add Binding",
+		Long:    "Add a binding between the component and a service instance of the namespace. The binding is created on the cluster by \"odo dev\"",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(addBindingExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	bindingCmd.Flags().String(backend.FLAG_SERVICE, "", "Service instance to bind the component to, with the format <kind>/<name>")
+	bindingCmd.Flags().String(backend.FLAG_NAME, "", "Name of the binding, optional. By default, <component>-<service> is used")
+	bindingCmd.Flags().Bool(backend.FLAG_BIND_AS_FILES, true, "If set to true, the binding information is injected as files into the component, otherwise as environment variables")
+	bindingCmd.Flags().String(backend.FLAG_NAMING_STRATEGY, "", "Naming strategy of the binding information: none, lowercase, uppercase or a custom Go template")
+	clientset.Add(bindingCmd, clientset.BINDING, clientset.KUBERNETES)
+
+	return bindingCmd
+}
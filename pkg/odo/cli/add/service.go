@@ -0,0 +1,174 @@
+package add
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	dfutil "github.com/devfile/library/pkg/util"
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/service"
+)
+
+// ServiceRecommendedCommandName is the recommended service sub-command name
+const ServiceRecommendedCommandName = "service"
+
+var addServiceExample = ktemplates.Examples(`
+# Add a Redis service provided by the redis-operator Operator, with parameters
+%[1]s redis-operator/Redis --name myredis --param kubernetesConfig.image=quay.io/opstree/redis:v6.2.5 --param kubernetesConfig.imagePullPolicy=IfNotPresent
+
+# Add a Redis service provided by the redis-operator Operator, from the definition in a file
+%[1]s redis-operator/Redis --name myredis --from-file redis.yaml
+`)
+
+type AddServiceOptions struct {
+	// operator providing the service, and kind of the service
+	operator string
+	crd      string
+
+	// Flags passed to the command
+	nameFlag     string
+	paramFlag    []string
+	fromFileFlag string
+
+	// parsed parameters
+	params map[string]string
+
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+// NewAddServiceOptions returns new instance of AddServiceOptions
+func NewAddServiceOptions() *AddServiceOptions {
+	return &AddServiceOptions{}
+}
+
+func (o *AddServiceOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *AddServiceOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.operator, o.crd, err = service.SplitServiceKindName(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid service %q: the service must have the format <operator>/<CRD>", args[0])
+	}
+
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(""))
+	if err != nil {
+		return err
+	}
+	// this ensures that the namespace set in env.yaml is used
+	o.clientset.KubernetesClient.SetNamespace(o.GetProject())
+	return nil
+}
+
+func (o *AddServiceOptions) Validate() (err error) {
+	if len(o.paramFlag) > 0 && o.fromFileFlag != "" {
+		return errors.New("--param and --from-file parameters cannot be used together")
+	}
+	if o.nameFlag != "" {
+		if err = dfutil.ValidateK8sResourceName("name", o.nameFlag); err != nil {
+			return err
+		}
+	}
+	o.params, err = parseParams(o.paramFlag)
+	return err
+}
+
+func (o *AddServiceOptions) Run(_ context.Context) error {
+	csvSupport, err := o.clientset.KubernetesClient.IsCSVSupported()
+	if err != nil {
+		return err
+	}
+	if !csvSupport {
+		return errors.New("unable to add the service: Operators are not supported by the cluster")
+	}
+
+	csv, cr, err := service.GetOperatorCR(o.clientset.KubernetesClient, o.operator, o.crd)
+	if err != nil {
+		return err
+	}
+
+	var fileContent []byte
+	if o.fromFileFlag != "" {
+		fileContent, err = os.ReadFile(o.fromFileFlag)
+		if err != nil {
+			return fmt.Errorf("unable to read file %q: %w", o.fromFileFlag, err)
+		}
+	}
+
+	u, err := service.BuildOperatorService(o.clientset.KubernetesClient, cr, o.nameFlag, o.params, fileContent)
+	if err != nil {
+		return fmt.Errorf("invalid service %s/%s: %w", csv.Name, cr.Kind, err)
+	}
+
+	crdYAML, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return err
+	}
+
+	devfileObj, err := devfile.AddKubernetesComponentToDevfile(string(crdYAML), u.GetName(), o.EnvSpecificInfo.GetDevfileObj())
+	if err != nil {
+		return err
+	}
+
+	err = devfileObj.WriteYamlDevfile()
+	if err != nil {
+		return err
+	}
+
+	log.Successf("Successfully added the service %q of kind %q provided by the Operator %q to the devfile.", u.GetName(), cr.Kind, csv.Name)
+	log.Info("Run `odo dev` or `odo deploy` to create it on the cluster.")
+	return nil
+}
+
+// parseParams parses the parameters with the format key=value
+func parseParams(params []string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, param := range params {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid parameter %q: the parameter must have the format key=value", param)
+		}
+		if _, found := result[kv[0]]; found {
+			return nil, fmt.Errorf("parameter %q is defined several times", kv[0])
+		}
+		result[kv[0]] = kv[1]
+	}
+	return result, nil
+}
+
+// NewCmdService implements the service odo sub-command
+func NewCmdService(name, fullName string) *cobra.Command {
+	o := NewAddServiceOptions()
+
+	var serviceCmd = &cobra.Command{
+		Use:     name + " <operator>/<CRD>",
+		Short:   "Add Operator backed service",
+		Long:    "Add a service provided by an Operator to the devfile, as a Kubernetes component. The service is created on the cluster by \"odo dev\" and \"odo deploy\"",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(addServiceExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	serviceCmd.Flags().StringVar(&o.nameFlag, "name", "", "Name of the service, optional. By default, the name defined in the file or the lowercase kind of the CRD is used")
+	serviceCmd.Flags().StringArrayVarP(&o.paramFlag, "param", "p", nil, "Parameter of the service with the format key=value, validated against the definition of the CRD. Nested properties are separated by dots, for example kubernetesConfig.image=redis. Can be repeated")
+	serviceCmd.Flags().StringVar(&o.fromFileFlag, "from-file", "", "Path to a YAML file containing the definition of the service. It cannot be used with --param")
+	clientset.Add(serviceCmd, clientset.KUBERNETES)
+
+	return serviceCmd
+}
@@ -48,11 +48,11 @@ func (o *AlizerOptions) RunForJsonOutput(ctx context.Context) (out interface{},
 	if err != nil {
 		return nil, err
 	}
-	df, reg, err := o.clientset.AlizerClient.DetectFramework(cwd)
+	candidates, err := o.clientset.AlizerClient.DetectFrameworks(cwd)
 	if err != nil {
 		return nil, err
 	}
-	result := alizer.GetDevfileLocationFromDetection(df, reg)
+	result := alizer.GetDetectionResult(candidates)
 	return result, nil
 }
 
@@ -2,6 +2,7 @@ package build_images
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 
@@ -26,14 +27,36 @@ type BuildImagesOptions struct {
 	// Flags
 	pushFlag    bool
 	contextFlag string
+	varsFlag    []string
+	varFileFlag string
+
+	parallelismFlag int
+	cacheFromFlag   []string
+	cacheToFlag     string
+	platformFlag    []string
 }
 
+// defaultParallelism is the default maximum number of images built at the same time
+const defaultParallelism = 4
+
 var buildImagesExample = templates.Examples(`
   # Build images defined in the devfile
   %[1]s
 
   # Build images and push them to their registries
   %[1]s --push
+
+  # Build the images one at a time
+  %[1]s --parallelism 1
+
+  # Build images reusing the layers cached in a registry, and updating the cache
+  %[1]s --push --cache-from quay.io/user/cache --cache-to quay.io/user/cache
+
+  # Build images for several platforms (requires podman)
+  %[1]s --platform linux/amd64,linux/arm64
+
+  # Build images with the value of the variable CONTAINER_IMAGE of the devfile overridden
+  %[1]s --var CONTAINER_IMAGE=quay.io/user/image:v2
 `)
 
 // NewLoginOptions creates a new LoginOptions instance
@@ -46,7 +69,11 @@ func (o *BuildImagesOptions) SetClientset(clientset *clientset.Clientset) {
 
 // Complete completes LoginOptions after they've been created
 func (o *BuildImagesOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
-	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag).IsOffline())
+	variables, err := util.GetVariables(o.varsFlag, o.varFileFlag)
+	if err != nil {
+		return err
+	}
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag).IsOffline().WithVariables(variables))
 	if err != nil {
 		return err
 	}
@@ -55,6 +82,9 @@ func (o *BuildImagesOptions) Complete(cmdline cmdline.Cmdline, args []string) (e
 
 // Validate validates the LoginOptions based on completed values
 func (o *BuildImagesOptions) Validate() (err error) {
+	if o.parallelismFlag < 1 {
+		return errors.New("--parallelism must be at least 1")
+	}
 	return
 }
 
@@ -62,7 +92,12 @@ func (o *BuildImagesOptions) Validate() (err error) {
 func (o *BuildImagesOptions) Run(ctx context.Context) (err error) {
 	devfileObj := o.Context.EnvSpecificInfo.GetDevfileObj()
 	path := filepath.Dir(o.Context.EnvSpecificInfo.GetDevfilePath())
-	return image.BuildPushImages(devfileObj, path, o.pushFlag)
+	options := image.BuildOptions{
+		CacheFrom: o.cacheFromFlag,
+		CacheTo:   o.cacheToFlag,
+		Platforms: o.platformFlag,
+	}
+	return image.BuildPushImages(devfileObj, path, o.pushFlag, options, o.parallelismFlag)
 }
 
 // NewCmdLogin implements the odo command
@@ -83,6 +118,9 @@ func NewCmdBuildImages(name, fullName string) *cobra.Command {
 	buildImagesCmd.Annotations = map[string]string{"command": "main"}
 	buildImagesCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	buildImagesCmd.Flags().BoolVar(&o.pushFlag, "push", false, "If true, build and push the images")
+	buildImagesCmd.Flags().IntVar(&o.parallelismFlag, "parallelism", defaultParallelism, "Maximum number of images built at the same time")
+	util.AddBuildOptionsFlags(buildImagesCmd, &o.cacheFromFlag, &o.cacheToFlag, &o.platformFlag)
 	util.AddContextFlag(buildImagesCmd, &o.contextFlag)
+	util.AddVariablesFlags(buildImagesCmd, &o.varsFlag, &o.varFileFlag)
 	return buildImagesCmd
 }
@@ -8,8 +8,13 @@ import (
 	"github.com/devfile/library/pkg/devfile/parser"
 
 	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/deploy"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/devfile/validate"
 	"github.com/redhat-developer/odo/pkg/envinfo"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
@@ -20,6 +25,7 @@ import (
 
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/kubectl/pkg/util/templates"
@@ -28,6 +34,9 @@ import (
 // RecommendedCommandName is the recommended command name
 const RecommendedCommandName = "deploy"
 
+// diffRecommendedCommandName is the recommended diff sub-command name
+const diffRecommendedCommandName = "diff"
+
 // DeployOptions encapsulates the options for the odo command
 type DeployOptions struct {
 	// Context
@@ -38,11 +47,61 @@ type DeployOptions struct {
 
 	// working directory
 	contextDir string
+
+	// commandName is the name of the Deploy command to run, the default Deploy command if empty
+	commandName string
+	// namespace is the namespace to deploy to
+	namespace string
+
+	// Flags
+	validateFlag bool
+	dryRunFlag   bool
+	pruneFlag    bool
+	waitFlag     bool
+	imageTagFlag string
+	commandFlag  string
+	profileFlag  string
+	varsFlag     []string
+	varFileFlag  string
+	saveVarsFlag bool
+	// build options of the images
+	cacheFromFlag []string
+	cacheToFlag   string
+	platformFlag  []string
 }
 
 var deployExample = templates.Examples(`
   # Deploy components defined in the devfile
   %[1]s
+
+  # Validate the Kubernetes components of the devfile before deploying them
+  %[1]s --validate
+
+  # Preview the changes to the resources of the cluster and the images to build, without deploying them
+  %[1]s --dry-run
+
+  # Tag the images built with the git commit of the sources
+  %[1]s --image-tag git
+
+  # Build the images reusing the layers cached in a registry, and updating the cache
+  %[1]s --cache-from quay.io/user/cache --cache-to quay.io/user/cache
+
+  # Run the Deploy command named deploy-staging instead of the default Deploy command
+  %[1]s --command deploy-staging
+
+  # Deploy with the command, namespace and variables of the deploy profile named production
+  %[1]s --profile production
+
+  # Deploy with the values of the variables of the devfile overridden by a file and a flag
+  %[1]s --var-file staging.yaml --var REPLICAS=2
+
+  # Deploy with a variable overridden, and save its value as default for the namespace
+  %[1]s --var REPLICAS=2 --save-vars
+`)
+
+var diffExample = templates.Examples(`
+  # Show the differences between the resources defined in the devfile and the resources of the cluster
+  %[1]s
 `)
 
 // NewDeployOptions creates a new DeployOptions instance
@@ -54,8 +113,14 @@ func (o *DeployOptions) SetClientset(clientset *clientset.Clientset) {
 	o.clientset = clientset
 }
 
-// Complete DeployOptions after they've been created
+// Complete DeployOptions after they've been created.
+// In dry-run mode, no local file is written: the devfile must exist, and env.yaml is not created nor updated
 func (o *DeployOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	// checked before any file is written, Validate runs after Complete
+	if o.dryRunFlag && o.saveVarsFlag {
+		return errors.New("--save-vars cannot be used with --dry-run, no local file is modified when previewing the deployment")
+	}
+
 	o.contextDir, err = os.Getwd()
 	if err != nil {
 		return err
@@ -68,24 +133,40 @@ func (o *DeployOptions) Complete(cmdline cmdline.Cmdline, args []string) (err er
 		return errors.New("this command cannot run in an empty directory, run the command in a directory containing source code or initialize using 'odo init'")
 	}
 
-	initFlags := o.clientset.InitClient.GetFlags(cmdline.GetFlags())
+	if o.dryRunFlag {
+		var containsDevfile bool
+		containsDevfile, err = location.DirectoryContainsDevfile(o.clientset.FS, o.contextDir)
+		if err != nil {
+			return err
+		}
+		if !containsDevfile {
+			return errors.New("no devfile found in the current directory, run 'odo init' to create one before previewing the deployment")
+		}
+	} else {
+		initFlags := o.clientset.InitClient.GetFlags(cmdline.GetFlags())
+
+		err = o.clientset.InitClient.InitDevfile(initFlags, o.contextDir,
+			func(interactiveMode bool) {
+				scontext.SetInteractive(cmdline.Context(), interactiveMode)
+				if interactiveMode {
+					fmt.Println("The current directory already contains source code. " +
+						"odo will try to autodetect the language and project type in order to select the best suited Devfile for your project.")
+				}
+			},
+			func(newDevfileObj parser.DevfileObj) error {
+				return newDevfileObj.WriteYamlDevfile()
+			})
+		if err != nil {
+			return err
+		}
+	}
 
-	err = o.clientset.InitClient.InitDevfile(initFlags, o.contextDir,
-		func(interactiveMode bool) {
-			scontext.SetInteractive(cmdline.Context(), interactiveMode)
-			if interactiveMode {
-				fmt.Println("The current directory already contains source code. " +
-					"odo will try to autodetect the language and project type in order to select the best suited Devfile for your project.")
-			}
-		},
-		func(newDevfileObj parser.DevfileObj) error {
-			return newDevfileObj.WriteYamlDevfile()
-		})
+	variables, err := odoutil.GetVariables(o.varsFlag, o.varFileFlag)
 	if err != nil {
 		return err
 	}
 
-	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextDir).CreateAppIfNeeded())
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextDir).CreateAppIfNeeded().WithVariables(variables))
 	if err != nil {
 		return err
 	}
@@ -94,32 +175,61 @@ func (o *DeployOptions) Complete(cmdline cmdline.Cmdline, args []string) (err er
 	if err != nil {
 		return fmt.Errorf("unable to retrieve configuration information: %w", err)
 	}
-	if !envFileInfo.Exists() {
-		var cmpName string
-		cmpName, err = component.GatherName(o.EnvSpecificInfo.GetDevfileObj(), o.GetDevfilePath())
-		if err != nil {
-			return fmt.Errorf("unable to retrieve component name: %w", err)
+	// env.yaml is not created nor updated when previewing the deployment
+	if !o.dryRunFlag {
+		if !envFileInfo.Exists() {
+			var cmpName string
+			cmpName, err = component.GatherName(o.EnvSpecificInfo.GetDevfileObj(), o.GetDevfilePath())
+			if err != nil {
+				return fmt.Errorf("unable to retrieve component name: %w", err)
+			}
+			err = envFileInfo.SetComponentSettings(envinfo.ComponentSettings{Name: cmpName, Project: o.GetProject(), AppName: "app"})
+			if err != nil {
+				return fmt.Errorf("failed to write new env.yaml file: %w", err)
+			}
+
+		} else if envFileInfo.GetComponentSettings().Project != o.GetProject() {
+			err = envFileInfo.SetConfiguration("project", o.GetProject())
+			if err != nil {
+				return fmt.Errorf("failed to update project in env.yaml file: %w", err)
+			}
 		}
-		err = envFileInfo.SetComponentSettings(envinfo.ComponentSettings{Name: cmpName, Project: o.GetProject(), AppName: "app"})
+	}
+
+	o.commandName = o.commandFlag
+	o.namespace = o.GetProject()
+	if o.profileFlag != "" {
+		var profile libdevfile.DeployProfile
+		profile, err = applyDeployProfile(o.Context, o.profileFlag, variables)
 		if err != nil {
-			return fmt.Errorf("failed to write new env.yaml file: %w", err)
+			return err
+		}
+		if o.commandName == "" {
+			o.commandName = profile.Command
+		}
+		if profile.Namespace != "" {
+			o.namespace = profile.Namespace
 		}
+	}
 
-	} else if envFileInfo.GetComponentSettings().Project != o.GetProject() {
-		err = envFileInfo.SetConfiguration("project", o.GetProject())
+	if o.saveVarsFlag && len(variables) > 0 {
+		err = envFileInfo.SetVariables(o.namespace, variables)
 		if err != nil {
-			return fmt.Errorf("failed to update project in env.yaml file: %w", err)
+			return fmt.Errorf("failed to save the variables in env.yaml file: %w", err)
 		}
 	}
 
-	// this ensures that odo deploy uses the namespace set in env.yaml
-	o.clientset.KubernetesClient.SetNamespace(o.GetProject())
+	// this ensures that odo deploy uses the namespace set in env.yaml, or in the deploy profile
+	o.clientset.KubernetesClient.SetNamespace(o.namespace)
 	return
 }
 
 // Validate validates the DeployOptions based on completed values
 func (o *DeployOptions) Validate() error {
-	return nil
+	if o.commandFlag != "" && o.profileFlag != "" {
+		return errors.New("--command and --profile cannot be used together, the command is defined by the profile")
+	}
+	return image.ValidateTagStrategy(image.TagStrategy(o.imageTagFlag))
 }
 
 // Run contains the logic for the odo command
@@ -128,18 +238,48 @@ func (o *DeployOptions) Run(ctx context.Context) error {
 	devfileName := devfileObj.GetMetadataName()
 	path := filepath.Dir(o.EnvSpecificInfo.GetDevfilePath())
 	appName := o.GetApplication()
-	namespace := o.GetProject()
+	namespace := o.namespace
 	scontext.SetComponentType(ctx, component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
 	scontext.SetLanguage(ctx, devfileObj.Data.GetMetadata().Language)
 	scontext.SetProjectType(ctx, devfileObj.Data.GetMetadata().ProjectType)
 	scontext.SetDevfileName(ctx, devfileName)
 	// Output what the command is doing / information
+	target := "Namespace: " + namespace
+	if o.commandName != "" {
+		target += ", Deploy command: " + o.commandName
+	}
 	log.Title("Deploying the application using "+devfileName+" Devfile",
-		"Namespace: "+namespace,
+		target,
 		"odo version: "+version.VERSION)
 
+	if o.validateFlag {
+		err := validate.ValidateKubernetesManifests(o.clientset.KubernetesClient, devfileObj, path)
+		if err != nil {
+			return err
+		}
+	}
+
+	options := deploy.Options{
+		CommandName: o.commandName,
+		Prune:       o.pruneFlag,
+		TagStrategy: image.TagStrategy(o.imageTagFlag),
+		BuildOptions: image.BuildOptions{
+			CacheFrom: o.cacheFromFlag,
+			CacheTo:   o.cacheToFlag,
+			Platforms: o.platformFlag,
+		},
+	}
+
+	if o.dryRunFlag {
+		log.Info("\nPreviewing the deployment, no image will be built and no resource will be modified")
+		return o.clientset.DeployClient.DryRun(devfileObj, path, appName, options, log.GetStdout())
+	}
+
 	// Run actual deploy command to be used
-	err := o.clientset.DeployClient.Deploy(devfileObj, path, appName)
+	if o.waitFlag {
+		options.WaitTimeout = time.Duration(o.clientset.PreferenceClient.GetDeployTimeout()) * time.Second
+	}
+	err := o.clientset.DeployClient.Deploy(devfileObj, path, appName, options)
 
 	if err == nil {
 		log.Info("\nYour Devfile has been successfully deployed")
@@ -161,10 +301,80 @@ func NewCmdDeploy(name, fullName string) *cobra.Command {
 			genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
-	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY)
+	deployCmd.Flags().BoolVar(&o.validateFlag, "validate", false, "Validate the Kubernetes components of the devfile before deploying them")
+	deployCmd.Flags().BoolVar(&o.waitFlag, "wait", true, "Wait for the deployed resources to be ready, for the DeployTimeout preference")
+	deployCmd.Flags().BoolVar(&o.pruneFlag, "prune", true, "Delete the resources deployed from Kubernetes components removed from the devfile")
+	deployCmd.Flags().BoolVar(&o.dryRunFlag, "dry-run", false, "Show the changes to the resources of the cluster and the images to build, without deploying them")
+	deployCmd.Flags().StringVar(&o.imageTagFlag, "image-tag", "", fmt.Sprintf("Tag the images built with a generated tag, one of %v", image.TagStrategies))
+	odoutil.AddBuildOptionsFlags(deployCmd, &o.cacheFromFlag, &o.cacheToFlag, &o.platformFlag)
+	addDeployTargetFlags(deployCmd, o)
+	odoutil.AddVariablesFlags(deployCmd, &o.varsFlag, &o.varFileFlag)
+	deployCmd.Flags().BoolVar(&o.saveVarsFlag, "save-vars", false, "Save the values of --var and --var-file in env.yaml, as default values of the variables for the namespace")
+	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.PREFERENCE)
+
+	deployCmd.AddCommand(NewCmdDiff(diffRecommendedCommandName, odoutil.GetFullName(fullName, diffRecommendedCommandName)))
+	deployCmd.AddCommand(NewCmdHistory(historyRecommendedCommandName, odoutil.GetFullName(fullName, historyRecommendedCommandName)))
+	deployCmd.AddCommand(NewCmdRollback(rollbackRecommendedCommandName, odoutil.GetFullName(fullName, rollbackRecommendedCommandName)))
 
 	// Add a defined annotation in order to appear in the help menu
 	deployCmd.Annotations["command"] = "main"
 	deployCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	return deployCmd
 }
+
+// NewCmdDiff implements the odo deploy diff command, running odo deploy in dry-run mode
+func NewCmdDiff(name, fullName string) *cobra.Command {
+	o := NewDeployOptions()
+	o.dryRunFlag = true
+	o.pruneFlag = true
+	diffCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Show the changes odo deploy would make",
+		Long:    "Show the differences between the resources defined in the devfile and the resources of the cluster, and the images odo deploy would build",
+		Example: fmt.Sprintf(diffExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	diffCmd.Flags().StringVar(&o.imageTagFlag, "image-tag", "", fmt.Sprintf("Tag the images built with a generated tag, one of %v", image.TagStrategies))
+	odoutil.AddBuildOptionsFlags(diffCmd, &o.cacheFromFlag, &o.cacheToFlag, &o.platformFlag)
+	addDeployTargetFlags(diffCmd, o)
+	odoutil.AddVariablesFlags(diffCmd, &o.varsFlag, &o.varFileFlag)
+	clientset.Add(diffCmd, clientset.INIT, clientset.DEPLOY)
+	diffCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return diffCmd
+}
+
+// addDeployTargetFlags adds the flags selecting the Deploy command and the deploy profile
+func addDeployTargetFlags(cmd *cobra.Command, o *DeployOptions) {
+	cmd.Flags().StringVar(&o.commandFlag, "command", "", "Name of the Deploy command of the devfile to run, the default Deploy command if not set")
+	cmd.Flags().StringVar(&o.profileFlag, "profile", "", fmt.Sprintf("Name of the deploy profile, defined in the %q attribute of the devfile, defining the Deploy command, the namespace and the variables", libdevfile.DeployProfilesAttribute))
+}
+
+// applyDeployProfile returns the deploy profile named profileName of the devfile of the context,
+// and parses again the devfile of the context with the default values of the variables for the namespace of the profile,
+// overridden by the variables of the profile, then by variables
+func applyDeployProfile(ctx *genericclioptions.Context, profileName string, variables map[string]string) (libdevfile.DeployProfile, error) {
+	profile, err := libdevfile.GetDeployProfile(ctx.EnvSpecificInfo.GetDevfileObj(), profileName)
+	if err != nil {
+		return libdevfile.DeployProfile{}, err
+	}
+	namespace := ctx.GetProject()
+	if profile.Namespace != "" {
+		namespace = profile.Namespace
+	}
+	merged := ctx.EnvSpecificInfo.GetVariables(namespace)
+	for name, value := range profile.Variables {
+		merged[name] = value
+	}
+	for name, value := range variables {
+		merged[name] = value
+	}
+	devObj, err := devfile.ParseAndValidateFromFileWithVariables(ctx.GetDevfilePath(), merged)
+	if err != nil {
+		return libdevfile.DeployProfile{}, fmt.Errorf("failed to parse the devfile with the variables of the profile %q: %w", profileName, err)
+	}
+	ctx.EnvSpecificInfo.SetDevfileObj(devObj)
+	return profile, nil
+}
@@ -0,0 +1,178 @@
+package deploy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/deploy"
+	_init "github.com/redhat-developer/odo/pkg/init"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const testDevfile = `schemaVersion: 2.2.0
+metadata:
+  name: my-component
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-14:latest
+`
+
+const testEnvFile = `ComponentSettings:
+  Name: my-component
+  Project: other-namespace
+  AppName: app
+`
+
+func TestDeployOptions_DryRunDoesNotWriteFiles(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        map[string]string
+		saveVarsFlag bool
+		varsFlag     []string
+		wantErr      bool
+	}{
+		{
+			name: "no env.yaml",
+			files: map[string]string{
+				"devfile.yaml": testDevfile,
+			},
+		},
+		{
+			name: "env.yaml with another namespace",
+			files: map[string]string{
+				"devfile.yaml":          testDevfile,
+				".odo/env/env.yaml":     testEnvFile,
+				"src/server.js":         "console.log('hello')",
+				"kubernetes/deploy.yml": "kind: Deployment",
+			},
+		},
+		{
+			name: "no devfile",
+			files: map[string]string{
+				"src/server.js": "console.log('hello')",
+			},
+			wantErr: true,
+		},
+		{
+			name: "variables saved",
+			files: map[string]string{
+				"devfile.yaml":      testDevfile,
+				".odo/env/env.yaml": testEnvFile,
+			},
+			saveVarsFlag: true,
+			varsFlag:     []string{"REPLICAS=2"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			contextDir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(contextDir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			before := getDirContent(t, contextDir)
+
+			wd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = os.Chdir(contextDir); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				_ = os.Chdir(wd)
+			}()
+
+			kubeClient := kclient.NewMockClientInterface(ctrl)
+			kubeClient.EXPECT().GetCurrentNamespace().Return("my-namespace").AnyTimes()
+			kubeClient.EXPECT().GetNamespaceNormal(gomock.Any()).DoAndReturn(func(name string) (*corev1.Namespace, error) {
+				return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+			}).AnyTimes()
+			kubeClient.EXPECT().SetNamespace(gomock.Any()).AnyTimes()
+
+			cmdline := cmdline.NewMockCmdline(ctrl)
+			cmdline.EXPECT().GetWorkingDirectory().Return(contextDir, nil).AnyTimes()
+			cmdline.EXPECT().CheckIfConfigurationNeeded().Return(true, nil).AnyTimes()
+			cmdline.EXPECT().FlagValueIfSet(gomock.Any()).Return("").AnyTimes()
+			cmdline.EXPECT().GetKubeClient().Return(kubeClient, nil).AnyTimes()
+			cmdline.EXPECT().GetFlags().Return(map[string]string{}).AnyTimes()
+			cmdline.EXPECT().Context().Return(context.Background()).AnyTimes()
+
+			// the devfile is never initialized in dry-run mode
+			initClient := _init.NewMockClient(ctrl)
+			initClient.EXPECT().InitDevfile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			deployClient := deploy.NewMockClient(ctrl)
+			deployClient.EXPECT().DryRun(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			o := NewDeployOptions()
+			o.dryRunFlag = true
+			o.saveVarsFlag = tt.saveVarsFlag
+			o.varsFlag = tt.varsFlag
+			o.SetClientset(&clientset.Clientset{
+				FS:               filesystem.DefaultFs{},
+				InitClient:       initClient,
+				DeployClient:     deployClient,
+				KubernetesClient: kubeClient,
+			})
+
+			err = o.Complete(cmdline, nil)
+			if err == nil {
+				err = o.Run(context.Background())
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeployOptions dry-run error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if after := getDirContent(t, contextDir); !reflect.DeepEqual(after, before) {
+				t.Errorf("DeployOptions dry-run modified the context directory, content = %v, want %v", after, before)
+			}
+		})
+	}
+}
+
+// getDirContent returns the content of the files of dir, indexed by their paths relative to dir.
+// The directories are returned with an empty content
+func getDirContent(t *testing.T, dir string) map[string]string {
+	content := map[string]string{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			content[rel+"/"] = ""
+			return nil
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		content[rel] = string(b)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
@@ -0,0 +1,169 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/deploy"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// historyRecommendedCommandName is the recommended history sub-command name
+const historyRecommendedCommandName = "history"
+
+var historyExample = templates.Examples(`
+  # List the revisions deployed for the component
+  %[1]s
+
+  # List the revisions in JSON format
+  %[1]s -o json
+
+  # List the revisions deployed in the namespace of the deploy profile named production
+  %[1]s --profile production
+`)
+
+// HistoryOptions encapsulates the options for the odo deploy history command
+type HistoryOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	profileFlag string
+}
+
+// NewHistoryOptions creates a new HistoryOptions instance
+func NewHistoryOptions() *HistoryOptions {
+	return &HistoryOptions{}
+}
+
+func (o *HistoryOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete HistoryOptions after they've been created
+func (o *HistoryOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = getDeployedContext(cmdline, o.clientset, o.profileFlag)
+	return err
+}
+
+// Validate validates the HistoryOptions based on completed values
+func (o *HistoryOptions) Validate() error {
+	return nil
+}
+
+// Run contains the logic for the odo deploy history command
+func (o *HistoryOptions) Run(ctx context.Context) error {
+	componentName := o.EnvSpecificInfo.GetDevfileObj().GetMetadataName()
+	revisions, err := o.clientset.DeployClient.History(componentName, o.GetApplication())
+	if err != nil {
+		return err
+	}
+	if len(revisions) == 0 {
+		log.Infof("No deployment recorded for the component %q", componentName)
+		return nil
+	}
+
+	t := table.NewWriter()
+	t.SetOutputMirror(log.GetStdout())
+	t.SetStyle(table.Style{
+		Box:    table.BoxStyle{PaddingLeft: " ", PaddingRight: " "},
+		Color:  table.ColorOptions{Header: text.Colors{text.FgHiGreen, text.Underline}},
+		Format: table.FormatOptions{Header: text.FormatUpper, Row: text.FormatDefault},
+	})
+	t.AppendHeader(table.Row{"REVISION", "DEPLOYED", "STATUS", "COMMAND", "GIT COMMIT", "IMAGES", "DESCRIPTION"})
+	for _, revision := range revisions {
+		var images []string
+		for _, image := range revision.Images {
+			if image.Digest != "" {
+				images = append(images, image.Name+"@"+image.Digest)
+			} else {
+				images = append(images, image.Name)
+			}
+		}
+		commit := revision.GitCommit
+		if len(commit) > 7 {
+			commit = commit[:7]
+		}
+		description := "Deploy"
+		if revision.RollbackOf != 0 {
+			description = fmt.Sprintf("Rollback to revision %d", revision.RollbackOf)
+		}
+		t.AppendRow(table.Row{revision.Number, revision.Timestamp.Local().Format("2006-01-02 15:04:05"), revision.Status, revision.Command, commit, strings.Join(images, "\n"), description})
+	}
+	t.Render()
+	return nil
+}
+
+// RunForJsonOutput contains the logic for the odo deploy history -o json command
+func (o *HistoryOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	revisions, err := o.clientset.DeployClient.History(o.EnvSpecificInfo.GetDevfileObj().GetMetadataName(), o.GetApplication())
+	if err != nil {
+		return nil, err
+	}
+	if revisions == nil {
+		revisions = []deploy.Revision{}
+	}
+	return revisions, nil
+}
+
+// NewCmdHistory implements the odo deploy history command
+func NewCmdHistory(name, fullName string) *cobra.Command {
+	o := NewHistoryOptions()
+	historyCmd := &cobra.Command{
+		Use:     name,
+		Short:   "List the revisions deployed by odo deploy",
+		Long:    "List the revisions recorded by odo deploy and odo deploy rollback for the component, the most recent first",
+		Example: fmt.Sprintf(historyExample, fullName),
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	historyCmd.Flags().StringVar(&o.profileFlag, "profile", "", "Name of the deploy profile of the devfile, defining the namespace of the revisions")
+	clientset.Add(historyCmd, clientset.DEPLOY)
+	machineoutput.UsedByCommand(historyCmd)
+	historyCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return historyCmd
+}
+
+// getDeployedContext returns the context of the component of the current directory,
+// and sets the namespace of the Kubernetes client to the namespace of the deploy profile named profileName if any,
+// or to the namespace of the component
+func getDeployedContext(cmdline cmdline.Cmdline, clientset *clientset.Clientset, profileName string) (*genericclioptions.Context, error) {
+	contextDir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(contextDir))
+	if err != nil {
+		return nil, err
+	}
+	namespace := ctx.GetProject()
+	if profileName != "" {
+		profile, err := libdevfile.GetDeployProfile(ctx.EnvSpecificInfo.GetDevfileObj(), profileName)
+		if err != nil {
+			return nil, err
+		}
+		if profile.Namespace != "" {
+			namespace = profile.Namespace
+		}
+	}
+	clientset.KubernetesClient.SetNamespace(namespace)
+	return ctx, nil
+}
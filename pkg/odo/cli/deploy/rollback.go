@@ -0,0 +1,108 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// rollbackRecommendedCommandName is the recommended rollback sub-command name
+const rollbackRecommendedCommandName = "rollback"
+
+var rollbackExample = templates.Examples(`
+  # Deploy again the revision before the current one
+  %[1]s
+
+  # Deploy again the revision 3, as listed by odo deploy history
+  %[1]s 3
+
+  # Deploy again the previous revision in the namespace of the deploy profile named production
+  %[1]s --profile production
+`)
+
+// RollbackOptions encapsulates the options for the odo deploy rollback command
+type RollbackOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+
+	// Parameters
+	revision int
+
+	// Flags
+	pruneFlag   bool
+	waitFlag    bool
+	profileFlag string
+}
+
+// NewRollbackOptions creates a new RollbackOptions instance
+func NewRollbackOptions() *RollbackOptions {
+	return &RollbackOptions{}
+}
+
+func (o *RollbackOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete RollbackOptions after they've been created
+func (o *RollbackOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	if len(args) == 1 {
+		o.revision, err = strconv.Atoi(args[0])
+		if err != nil || o.revision <= 0 {
+			return fmt.Errorf("invalid revision %q, the revision must be a positive number", args[0])
+		}
+	}
+	o.Context, err = getDeployedContext(cmdline, o.clientset, o.profileFlag)
+	return err
+}
+
+// Validate validates the RollbackOptions based on completed values
+func (o *RollbackOptions) Validate() error {
+	return nil
+}
+
+// Run contains the logic for the odo deploy rollback command
+func (o *RollbackOptions) Run(ctx context.Context) error {
+	var waitTimeout time.Duration
+	if o.waitFlag {
+		waitTimeout = time.Duration(o.clientset.PreferenceClient.GetDeployTimeout()) * time.Second
+	}
+	err := o.clientset.DeployClient.Rollback(o.EnvSpecificInfo.GetDevfileObj(), o.GetApplication(), o.revision, o.pruneFlag, waitTimeout)
+	if err == nil {
+		log.Info("\nThe revision has been successfully deployed")
+	}
+	return err
+}
+
+// NewCmdRollback implements the odo deploy rollback command
+func NewCmdRollback(name, fullName string) *cobra.Command {
+	o := NewRollbackOptions()
+	rollbackCmd := &cobra.Command{
+		Use:     name + " [revision]",
+		Short:   "Deploy again a revision recorded by odo deploy",
+		Long:    "Apply again the resources of a revision listed by odo deploy history, or of the revision before the current one. The images are not built again.",
+		Example: fmt.Sprintf(rollbackExample, fullName),
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	rollbackCmd.Flags().BoolVar(&o.waitFlag, "wait", true, "Wait for the deployed resources to be ready, for the DeployTimeout preference")
+	rollbackCmd.Flags().BoolVar(&o.pruneFlag, "prune", true, "Delete the resources deployed from Kubernetes components not part of the revision")
+	rollbackCmd.Flags().StringVar(&o.profileFlag, "profile", "", "Name of the deploy profile of the devfile, defining the namespace of the revisions")
+	clientset.Add(rollbackCmd, clientset.DEPLOY, clientset.PREFERENCE)
+	rollbackCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return rollbackCmd
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
 	"github.com/redhat-developer/odo/pkg/devfile/adapters/kubernetes"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/devfile/validate"
 	"github.com/redhat-developer/odo/pkg/envinfo"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -61,10 +62,17 @@ type DevOptions struct {
 	contextDir string
 
 	// Flags
-	randomPorts bool
+	randomPorts  bool
+	validateFlag bool
+	varsFlag     []string
+	varFileFlag  string
+	saveVarsFlag bool
 }
 
-type Handler struct{}
+type Handler struct {
+	// variables are the values overriding the values of the variables of the devfile
+	variables map[string]string
+}
 
 func NewDevOptions() *DevOptions {
 	return &DevOptions{
@@ -76,6 +84,9 @@ func NewDevOptions() *DevOptions {
 var devExample = templates.Examples(`
 	# Deploy component to the development cluster
 	%[1]s
+
+	# Deploy component to the development cluster, with the value of a variable of the devfile overridden
+	%[1]s --var CONTAINER_IMAGE=quay.io/user/image:dev
 `)
 
 func (o *DevOptions) SetClientset(clientset *clientset.Clientset) {
@@ -116,7 +127,12 @@ func (o *DevOptions) Complete(cmdline cmdline.Cmdline, args []string) error {
 		return err
 	}
 
-	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(""))
+	variables, err := odoutil.GetVariables(o.varsFlag, o.varFileFlag)
+	if err != nil {
+		return err
+	}
+
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile("").WithVariables(variables))
 	if err != nil {
 		return fmt.Errorf("unable to create context: %v", err)
 	}
@@ -148,6 +164,12 @@ func (o *DevOptions) Complete(cmdline cmdline.Cmdline, args []string) error {
 			return fmt.Errorf("failed to update project in env.yaml file: %w", err)
 		}
 	}
+	if o.saveVarsFlag && len(variables) > 0 {
+		err = envfileinfo.SetVariables(o.GetProject(), variables)
+		if err != nil {
+			return fmt.Errorf("failed to save the variables in env.yaml file: %w", err)
+		}
+	}
 	o.clientset.KubernetesClient.SetNamespace(o.GetProject())
 
 	// 3 steps to evaluate the paths to be ignored when "watching" the pwd/cwd for changes
@@ -181,6 +203,13 @@ func (o *DevOptions) Run(ctx context.Context) error {
 		"Namespace: "+namespace,
 		"odo version: "+version.VERSION)
 
+	if o.validateFlag {
+		err = validate.ValidateKubernetesManifests(o.clientset.KubernetesClient, o.Context.EnvSpecificInfo.GetDevfileObj(), path)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Section("Deploying to the cluster in developer mode")
 	err = o.clientset.DevClient.Start(o.Context.EnvSpecificInfo.GetDevfileObj(), platformContext, o.ignorePaths, path)
 	if err != nil {
@@ -230,7 +259,7 @@ func (o *DevOptions) Run(ctx context.Context) error {
 	scontext.SetProjectType(ctx, devFileObj.Data.GetMetadata().ProjectType)
 	scontext.SetDevfileName(ctx, devFileObj.GetMetadataName())
 
-	d := Handler{}
+	d := Handler{variables: o.GetVariables()}
 	err = o.clientset.DevClient.Watch(devFileObj, path, o.ignorePaths, o.out, &d, o.ctx)
 
 	return err
@@ -240,7 +269,7 @@ func (o *DevOptions) Run(ctx context.Context) error {
 func (o *Handler) RegenerateAdapterAndPush(pushParams common.PushParameters, watchParams watch.WatchParameters) error {
 	var adapter common.ComponentAdapter
 
-	adapter, err := regenerateComponentAdapterFromWatchParams(watchParams)
+	adapter, err := regenerateComponentAdapterFromWatchParams(watchParams, o.variables)
 	if err != nil {
 		return fmt.Errorf("unable to generate component from watch parameters: %w", err)
 	}
@@ -253,8 +282,8 @@ func (o *Handler) RegenerateAdapterAndPush(pushParams common.PushParameters, wat
 	return nil
 }
 
-func regenerateComponentAdapterFromWatchParams(parameters watch.WatchParameters) (common.ComponentAdapter, error) {
-	devObj, err := ododevfile.ParseAndValidateFromFile(location.DevfileLocation(""))
+func regenerateComponentAdapterFromWatchParams(parameters watch.WatchParameters, variables map[string]string) (common.ComponentAdapter, error) {
+	devObj, err := ododevfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(""), variables)
 	if err != nil {
 		return nil, err
 	}
@@ -293,6 +322,9 @@ It forwards endpoints with exposure values 'public' or 'internal' to a port on l
 		},
 	}
 	devCmd.Flags().BoolVarP(&o.randomPorts, "random-ports", "f", false, "Assign random ports to redirected ports")
+	devCmd.Flags().BoolVar(&o.validateFlag, "validate", false, "Validate the Kubernetes components of the devfile before deploying them")
+	odoutil.AddVariablesFlags(devCmd, &o.varsFlag, &o.varFileFlag)
+	devCmd.Flags().BoolVar(&o.saveVarsFlag, "save-vars", false, "Save the values of --var and --var-file in env.yaml, as default values of the variables for the namespace")
 
 	clientset.Add(devCmd, clientset.DEV, clientset.INIT, clientset.KUBERNETES)
 	// Add a defined annotation in order to appear in the help menu
@@ -0,0 +1,25 @@
+package generate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended generate command name
+const RecommendedCommandName = "generate"
+
+// NewCmdGenerate implements the generate odo command
+func NewCmdGenerate(name, fullName string) *cobra.Command {
+	var generateCmd = &cobra.Command{
+		Use:   name,
+		Short: "Generate resources from the devfile",
+	}
+
+	pipelineCmd := NewCmdPipeline(PipelineRecommendedCommandName, util.GetFullName(fullName, PipelineRecommendedCommandName))
+	generateCmd.AddCommand(pipelineCmd)
+	generateCmd.Annotations = map[string]string{"command": "main"}
+	generateCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return generateCmd
+}
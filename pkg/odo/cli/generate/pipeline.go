@@ -0,0 +1,109 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/pipeline"
+)
+
+// PipelineRecommendedCommandName is the recommended pipeline command name
+const PipelineRecommendedCommandName = "pipeline"
+
+var pipelineExample = templates.Examples(`
+  # Generate a Tekton Pipeline running the default Deploy command of the devfile
+  %[1]s --format tekton > pipeline.yaml
+
+  # Generate a GitHub Actions workflow running the Deploy command named deploy-production
+  %[1]s --format github-actions --command deploy-production > .github/workflows/deploy.yaml
+
+  # Generate a pipeline with the default value of the variable CONTAINER_IMAGE of the devfile overridden
+  %[1]s --format tekton --var CONTAINER_IMAGE=quay.io/user/image:v2
+`)
+
+// PipelineOptions encapsulates the options for the odo generate pipeline command
+type PipelineOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Flags
+	formatFlag  string
+	commandFlag string
+	contextFlag string
+	varsFlag    []string
+	varFileFlag string
+}
+
+// NewPipelineOptions creates a new PipelineOptions instance
+func NewPipelineOptions() *PipelineOptions {
+	return &PipelineOptions{}
+}
+
+func (o *PipelineOptions) SetClientset(clientset *clientset.Clientset) {
+}
+
+// Complete completes PipelineOptions after they've been created
+func (o *PipelineOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	variables, err := odoutil.GetVariables(o.varsFlag, o.varFileFlag)
+	if err != nil {
+		return err
+	}
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag).IsOffline().WithVariables(variables))
+	return err
+}
+
+// Validate validates the PipelineOptions based on completed values
+func (o *PipelineOptions) Validate() (err error) {
+	if o.formatFlag == "" {
+		return fmt.Errorf("the --format flag is required, supported formats are %s", strings.Join(pipeline.Formats(), ", "))
+	}
+	for _, format := range pipeline.Formats() {
+		if o.formatFlag == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported format %q, supported formats are %s", o.formatFlag, strings.Join(pipeline.Formats(), ", "))
+}
+
+// Run contains the logic for the odo generate pipeline command
+func (o *PipelineOptions) Run(ctx context.Context) (err error) {
+	definition, err := pipeline.Generate(o.EnvSpecificInfo.GetDevfileObj(), o.GetDevfilePath(), o.commandFlag, o.GetProject(), o.formatFlag)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(definition)
+	return err
+}
+
+// NewCmdPipeline implements the odo generate pipeline command
+func NewCmdPipeline(name, fullName string) *cobra.Command {
+	o := NewPipelineOptions()
+	pipelineCmd := &cobra.Command{
+		Use:   name,
+		Short: "Generate a CI pipeline running the Deploy command of the devfile",
+		Long: `Generate the definition of a CI pipeline building the images and applying the Kubernetes resources
+in the order of the Deploy command of the devfile. The variables of the devfile, the registries of the images
+and the namespace are parameters of the pipeline.`,
+		Example: fmt.Sprintf(pipelineExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	pipelineCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	pipelineCmd.Flags().StringVar(&o.formatFlag, "format", "", fmt.Sprintf("Format of the pipeline (%s)", strings.Join(pipeline.Formats(), ", ")))
+	pipelineCmd.Flags().StringVar(&o.commandFlag, "command", "", "Name of the Deploy command of the devfile run by the pipeline, the default Deploy command if not set")
+	odoutil.AddContextFlag(pipelineCmd, &o.contextFlag)
+	odoutil.AddVariablesFlags(pipelineCmd, &o.varsFlag, &o.varFileFlag)
+	return pipelineCmd
+}
@@ -48,6 +48,15 @@ var initExample = templates.Examples(`
 
   # Bootstrap a new component and download a starter project
   %[1]s --name my-app --devfile nodejs --starter nodejs-starter
+
+  # Bootstrap a new component, replacing port 3000 with port 8080, adding an environment variable and setting a memory limit on the "runtime" container
+  %[1]s --name my-app --devfile nodejs --remove-port runtime=3000 --port runtime=8080 --env runtime=NODE_ENV=production --memory runtime=512Mi
+
+  # Bootstrap a new component from a project template in a local directory
+  %[1]s --name my-app --template $HOME/templates/nodejs --port runtime=8080
+
+  # Bootstrap a new component from a project template in a sub-directory of a git repository
+  %[1]s --name my-app --template https://github.com/example/templates.git#nodejs
   `)
 
 type InitOptions struct {
@@ -119,7 +128,9 @@ func (o *InitOptions) Run(ctx context.Context) (err error) {
 		if err == nil {
 			return
 		}
-		if starterDownloaded {
+		if o.flags[backend.FLAG_TEMPLATE] != "" {
+			err = fmt.Errorf("%w\nthe command failed while initializing the component from the template. By security, the directory is not cleaned up", err)
+		} else if starterDownloaded {
 			err = fmt.Errorf("%w\nthe command failed after downloading the starter project. By security, the directory is not cleaned up", err)
 		} else {
 			_ = o.clientset.FS.Remove("devfile.yaml")
@@ -143,39 +154,20 @@ func (o *InitOptions) Run(ctx context.Context) (err error) {
 	log.Title(messages.InitializingNewComponent, infoOutput, "odo version: "+version.VERSION)
 	log.Info("\nInteractive mode enabled, please answer the following questions:")
 
-	devfileObj, devfilePath, err := o.clientset.InitClient.SelectAndPersonalizeDevfile(o.flags, o.contextDir)
-	if err != nil {
-		return err
-	}
-
-	starterInfo, err := o.clientset.InitClient.SelectStarterProject(devfileObj, o.flags, o.clientset.FS, o.contextDir)
-	if err != nil {
-		return err
-	}
-
-	// Set the name in the devfile but do not write it yet to disk,
-	// because the starter project downloaded at the end might come bundled with a specific Devfile.
-	name, err := o.clientset.InitClient.PersonalizeName(devfileObj, o.flags)
-	if err != nil {
-		return fmt.Errorf("failed to update the devfile's name: %w", err)
-	}
-
-	if starterInfo != nil {
-		// WARNING: this will remove all the content of the destination directory, ie the devfile.yaml file
-		err = o.clientset.InitClient.DownloadStarterProject(starterInfo, o.contextDir)
+	var devfileObj parser.DevfileObj
+	var name string
+	if o.flags[backend.FLAG_TEMPLATE] != "" {
+		devfileObj, name, err = o.clientset.InitClient.InitFromTemplate(o.flags, o.contextDir)
 		if err != nil {
-			return fmt.Errorf("unable to download starter project %q: %w", starterInfo.Name, err)
+			return err
 		}
-		starterDownloaded = true
-
-		// in case the starter project contains a devfile, read it again
-		if _, err = o.clientset.FS.Stat(devfilePath); err == nil {
-			devfileObj, _, err = devfile.ParseDevfileAndValidate(parser.ParserArgs{Path: devfilePath, FlattenedDevfile: pointer.BoolPtr(false)})
-			if err != nil {
-				return err
-			}
+	} else {
+		devfileObj, name, starterDownloaded, err = o.selectDevfileAndStarterProject()
+		if err != nil {
+			return err
 		}
 	}
+
 	// WARNING: SetMetadataName writes the Devfile to disk
 	if err = devfileObj.SetMetadataName(name); err != nil {
 		return err
@@ -204,6 +196,45 @@ Changes will be directly reflected on the cluster.`, devfileObj.Data.GetMetadata
 	return nil
 }
 
+// selectDevfileAndStarterProject selects, downloads and personalizes a devfile, then downloads the starter project if any.
+// Returns the devfile object, the name of the component and whether a starter project has been downloaded
+func (o *InitOptions) selectDevfileAndStarterProject() (devfileObj parser.DevfileObj, name string, starterDownloaded bool, err error) {
+	devfileObj, devfilePath, err := o.clientset.InitClient.SelectAndPersonalizeDevfile(o.flags, o.contextDir)
+	if err != nil {
+		return parser.DevfileObj{}, "", false, err
+	}
+
+	starterInfo, err := o.clientset.InitClient.SelectStarterProject(devfileObj, o.flags, o.clientset.FS, o.contextDir)
+	if err != nil {
+		return parser.DevfileObj{}, "", false, err
+	}
+
+	// Set the name in the devfile but do not write it yet to disk,
+	// because the starter project downloaded at the end might come bundled with a specific Devfile.
+	name, err = o.clientset.InitClient.PersonalizeName(devfileObj, o.flags)
+	if err != nil {
+		return parser.DevfileObj{}, "", false, fmt.Errorf("failed to update the devfile's name: %w", err)
+	}
+
+	if starterInfo != nil {
+		// WARNING: this will remove all the content of the destination directory, ie the devfile.yaml file
+		err = o.clientset.InitClient.DownloadStarterProject(starterInfo, o.contextDir)
+		if err != nil {
+			return parser.DevfileObj{}, "", false, fmt.Errorf("unable to download starter project %q: %w", starterInfo.Name, err)
+		}
+		starterDownloaded = true
+
+		// in case the starter project contains a devfile, read it again
+		if _, err = o.clientset.FS.Stat(devfilePath); err == nil {
+			devfileObj, _, err = devfile.ParseDevfileAndValidate(parser.ParserArgs{Path: devfilePath, FlattenedDevfile: pointer.BoolPtr(false)})
+			if err != nil {
+				return parser.DevfileObj{}, "", starterDownloaded, err
+			}
+		}
+	}
+	return devfileObj, name, starterDownloaded, nil
+}
+
 // NewCmdInit implements the odo command
 func NewCmdInit(name, fullName string) *cobra.Command {
 
@@ -225,6 +256,12 @@ func NewCmdInit(name, fullName string) *cobra.Command {
 	initCmd.Flags().String(backend.FLAG_DEVFILE_REGISTRY, "", "name of the devfile registry (as configured in \"odo preference registry list\"). It can be used in combination with --devfile, but not with --devfile-path")
 	initCmd.Flags().String(backend.FLAG_STARTER, "", "name of the starter project")
 	initCmd.Flags().String(backend.FLAG_DEVFILE_PATH, "", "path to a devfile. This is an alternative to using devfile from Devfile registry. It can be local filesystem path or http(s) URL")
+	initCmd.Flags().StringArray(backend.FLAG_PORT, nil, "port to add to a container, with the format container=port. Can be repeated")
+	initCmd.Flags().StringArray(backend.FLAG_REMOVE_PORT, nil, "port to remove from a container, with the format container=port. Can be repeated")
+	initCmd.Flags().StringArray(backend.FLAG_ENV, nil, "environment variable to set in a container, with the format container=KEY=VALUE. Can be repeated")
+	initCmd.Flags().StringArray(backend.FLAG_MEMORY, nil, "memory limit of a container, with the format container=limit (for example runtime=512Mi). Can be repeated")
+	initCmd.Flags().String(backend.FLAG_TEMPLATE, "", "path to a local directory or URL of a git repository (with the format <url>[#<sub-directory>]) containing a project template. It cannot be used with --devfile, --devfile-path or --starter")
+	initCmd.Flags().StringArray(backend.FLAG_CPU, nil, "CPU limit of a container, with the format container=limit (for example runtime=500m). Can be repeated")
 
 	// Add a defined annotation in order to appear in the help menu
 	initCmd.Annotations["command"] = "main"
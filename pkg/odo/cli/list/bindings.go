@@ -0,0 +1,132 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/binding"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+)
+
+// BindingsRecommendedCommandName is the recommended bindings sub-command name
+const BindingsRecommendedCommandName = "bindings"
+
+var listBindingsExample = ktemplates.Examples(`  # List the bindings of the current namespace, and the ones defined in the devfile
+%[1]s
+
+  # List the bindings of all the namespaces
+%[1]s --all-namespaces
+
+  # List the bindings in JSON format
+%[1]s -o json
+`)
+
+// BindingsOptions encapsulates the options for the "odo list bindings" command
+type BindingsOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Local variables
+	devfileObj *parser.DevfileObj
+
+	// Flags
+	allNamespacesFlag bool
+}
+
+// NewBindingsOptions creates a new BindingsOptions instance
+func NewBindingsOptions() *BindingsOptions {
+	return &BindingsOptions{}
+}
+
+func (o *BindingsOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes BindingsOptions after they've been created
+func (o *BindingsOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.devfileObj, err = getLocalDevfile(cmdline, o.clientset)
+	return err
+}
+
+// Validate validates the BindingsOptions based on completed values
+func (o *BindingsOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for "odo list bindings" command
+func (o *BindingsOptions) Run(ctx context.Context) error {
+	bindings, err := o.clientset.BindingClient.ListBindings(o.devfileObj, o.allNamespacesFlag)
+	if err != nil {
+		return err
+	}
+	if len(bindings) == 0 {
+		log.Info("There are no bindings.")
+		return nil
+	}
+
+	t := newTable()
+	header := table.Row{"NAME", "COMPONENT", "SERVICE", "MODE", "STATUS"}
+	if o.allNamespacesFlag {
+		header = append(table.Row{"NAMESPACE"}, header...)
+	}
+	t.AppendHeader(header)
+	for _, b := range bindings {
+		name := text.Colors{text.FgHiYellow}.Sprint(b.Name)
+		// Mark the bindings defined in the local devfile, as `odo list` does for the local component
+		if b.InDevfile {
+			name = "* " + name
+		}
+		mode := b.BindingMode
+		if mode == "" {
+			mode = binding.StatusUnknown
+		}
+		row := table.Row{name, b.Component, b.Service, mode, b.Status}
+		if o.allNamespacesFlag {
+			row = append(table.Row{b.Namespace}, row...)
+		}
+		t.AppendRow(row)
+	}
+	t.Render()
+	return nil
+}
+
+// RunForJsonOutput contains the logic for "odo list bindings -o json" command
+func (o *BindingsOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	bindings, err := o.clientset.BindingClient.ListBindings(o.devfileObj, o.allNamespacesFlag)
+	if err != nil {
+		return nil, err
+	}
+	if bindings == nil {
+		bindings = []binding.ServiceBinding{}
+	}
+	return bindings, nil
+}
+
+// NewCmdBindings implements the "odo list bindings" command
+func NewCmdBindings(name, fullName string) *cobra.Command {
+	o := NewBindingsOptions()
+	bindingsCmd := &cobra.Command{
+		Use:     name,
+		Short:   "List the bindings between components and services",
+		Long:    "List the bindings between components and services, with their binding mode and status. The bindings defined in the local devfile are marked with a '*'.",
+		Example: fmt.Sprintf(listBindingsExample, fullName),
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	bindingsCmd.Flags().BoolVarP(&o.allNamespacesFlag, "all-namespaces", "A", false, "List the bindings of all the namespaces")
+	clientset.Add(bindingsCmd, clientset.BINDING, clientset.KUBERNETES)
+	machineoutput.UsedByCommand(bindingsCmd)
+	return bindingsCmd
+}
@@ -168,6 +168,10 @@ func NewCmdList(name, fullName string) *cobra.Command {
 	}
 	clientset.Add(listCmd, clientset.KUBERNETES)
 
+	servicesCmd := NewCmdServices(ServicesRecommendedCommandName, odoutil.GetFullName(fullName, ServicesRecommendedCommandName))
+	bindingsCmd := NewCmdBindings(BindingsRecommendedCommandName, odoutil.GetFullName(fullName, BindingsRecommendedCommandName))
+	listCmd.AddCommand(servicesCmd, bindingsCmd)
+
 	listCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	listCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace for odo to scan for components")
 
@@ -185,31 +189,7 @@ func (lo *ListOptions) HumanReadableOutput(wr io.Writer, components []component.
 	if len(components) != 0 {
 
 		// Create the table and use our own style
-		t := table.NewWriter()
-
-		// Set the style of the table
-		t.SetStyle(table.Style{
-			Box: table.BoxStyle{
-				PaddingLeft:  " ",
-				PaddingRight: " ",
-			},
-			Color: table.ColorOptions{
-				Header: text.Colors{text.FgHiGreen, text.Underline},
-			},
-			Format: table.FormatOptions{
-				Footer: text.FormatUpper,
-				Header: text.FormatUpper,
-				Row:    text.FormatDefault,
-			},
-			Options: table.Options{
-				DrawBorder:      false,
-				SeparateColumns: false,
-				SeparateFooter:  false,
-				SeparateHeader:  false,
-				SeparateRows:    false,
-			},
-		})
-		t.SetOutputMirror(log.GetStdout())
+		t := newTable()
 
 		// Create the header and then sort accordingly
 		t.AppendHeader(table.Row{"NAME", "PROJECT TYPE", "RUNNING IN", "MANAGED"})
@@ -0,0 +1,183 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/binding"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// ServicesRecommendedCommandName is the recommended services sub-command name
+const ServicesRecommendedCommandName = "services"
+
+var listServicesExample = ktemplates.Examples(`  # List the bindable services of the current namespace
+%[1]s
+
+  # List the bindable services of all the namespaces
+%[1]s --all-namespaces
+
+  # List the bindable services in JSON format
+%[1]s -o json
+`)
+
+// ServicesOptions encapsulates the options for the "odo list services" command
+type ServicesOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Local variables
+	devfileObj *parser.DevfileObj
+
+	// Flags
+	allNamespacesFlag bool
+}
+
+// NewServicesOptions creates a new ServicesOptions instance
+func NewServicesOptions() *ServicesOptions {
+	return &ServicesOptions{}
+}
+
+func (o *ServicesOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes ServicesOptions after they've been created
+func (o *ServicesOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.devfileObj, err = getLocalDevfile(cmdline, o.clientset)
+	return err
+}
+
+// Validate validates the ServicesOptions based on completed values
+func (o *ServicesOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for "odo list services" command
+func (o *ServicesOptions) Run(ctx context.Context) error {
+	services, err := o.clientset.BindingClient.ListServices(o.devfileObj, o.allNamespacesFlag)
+	if err != nil {
+		return err
+	}
+	if len(services) == 0 {
+		log.Info("There are no bindable services.")
+		return nil
+	}
+
+	t := newTable()
+	header := table.Row{"NAME", "OPERATOR", "BOUND COMPONENTS", "STATUS"}
+	if o.allNamespacesFlag {
+		header = append(table.Row{"NAMESPACE"}, header...)
+	}
+	t.AppendHeader(header)
+	for _, svc := range services {
+		bound := "None"
+		if len(svc.BoundComponents) > 0 {
+			bound = strings.Join(svc.BoundComponents, ", ")
+		}
+		row := table.Row{text.Colors{text.FgHiYellow}.Sprint(svc.Kind + "/" + svc.Name), svc.Operator, bound, svc.Status}
+		if o.allNamespacesFlag {
+			row = append(table.Row{svc.Namespace}, row...)
+		}
+		t.AppendRow(row)
+	}
+	t.Render()
+	return nil
+}
+
+// RunForJsonOutput contains the logic for "odo list services -o json" command
+func (o *ServicesOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	services, err := o.clientset.BindingClient.ListServices(o.devfileObj, o.allNamespacesFlag)
+	if err != nil {
+		return nil, err
+	}
+	if services == nil {
+		services = []binding.ServiceInstance{}
+	}
+	return services, nil
+}
+
+// NewCmdServices implements the "odo list services" command
+func NewCmdServices(name, fullName string) *cobra.Command {
+	o := NewServicesOptions()
+	servicesCmd := &cobra.Command{
+		Use:     name,
+		Short:   "List the bindable services",
+		Long:    "List the Operator backed services that components can be bound to, with the components bound to them and their status.",
+		Example: fmt.Sprintf(listServicesExample, fullName),
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	servicesCmd.Flags().BoolVarP(&o.allNamespacesFlag, "all-namespaces", "A", false, "List the services of all the namespaces")
+	clientset.Add(servicesCmd, clientset.BINDING, clientset.KUBERNETES)
+	machineoutput.UsedByCommand(servicesCmd)
+	return servicesCmd
+}
+
+// getLocalDevfile returns the devfile of the current directory, or nil if there is no devfile.
+// The namespace of the Kubernetes client is set to the one defined in env.yaml, if any
+func getLocalDevfile(cmdline cmdline.Cmdline, cs *clientset.Clientset) (*parser.DevfileObj, error) {
+	devfilePath := location.DevfileLocation("")
+	if !util.CheckPathExists(devfilePath) {
+		return nil, nil
+	}
+
+	ctx, err := genericclioptions.New(genericclioptions.NewCreateParameters(cmdline))
+	if err != nil {
+		return nil, err
+	}
+	if ctx.GetProject() != "" {
+		// this ensures that the namespace set in env.yaml is used
+		cs.KubernetesClient.SetNamespace(ctx.GetProject())
+	}
+
+	devObj, err := devfile.ParseAndValidateFromFile(devfilePath)
+	if err != nil {
+		return nil, err
+	}
+	return &devObj, nil
+}
+
+// newTable returns a table writing to the standard output, with the style of the odo list commands
+func newTable() table.Writer {
+	t := table.NewWriter()
+	t.SetStyle(table.Style{
+		Box: table.BoxStyle{
+			PaddingLeft:  " ",
+			PaddingRight: " ",
+		},
+		Color: table.ColorOptions{
+			Header: text.Colors{text.FgHiGreen, text.Underline},
+		},
+		Format: table.FormatOptions{
+			Footer: text.FormatUpper,
+			Header: text.FormatUpper,
+			Row:    text.FormatDefault,
+		},
+		Options: table.Options{
+			DrawBorder:      false,
+			SeparateColumns: false,
+			SeparateFooter:  false,
+			SeparateHeader:  false,
+			SeparateRows:    false,
+		},
+	})
+	t.SetOutputMirror(log.GetStdout())
+	return t
+}
@@ -52,9 +52,13 @@ func (o *ViewOptions) Run(ctx context.Context) (err error) {
 	fmt.Fprintln(w, "UpdateNotification", "\t", showBlankIfNil(o.clientset.PreferenceClient.UpdateNotification()))
 	fmt.Fprintln(w, "Timeout", "\t", showBlankIfNil(o.clientset.PreferenceClient.Timeout()))
 	fmt.Fprintln(w, "PushTimeout", "\t", showBlankIfNil(o.clientset.PreferenceClient.PushTimeout()))
+	fmt.Fprintln(w, "DeployTimeout", "\t", showBlankIfNil(o.clientset.PreferenceClient.DeployTimeout()))
 	fmt.Fprintln(w, "RegistryCacheTime", "\t", showBlankIfNil(o.clientset.PreferenceClient.RegistryCacheTime()))
 	fmt.Fprintln(w, "Ephemeral", "\t", showBlankIfNil(o.clientset.PreferenceClient.EphemeralSourceVolume()))
 	fmt.Fprintln(w, "ConsentTelemetry", "\t", showBlankIfNil(o.clientset.PreferenceClient.ConsentTelemetry()))
+	fmt.Fprintln(w, "StorageClass", "\t", showBlankIfNil(o.clientset.PreferenceClient.StorageClass()))
+	fmt.Fprintln(w, "VolumeAccessMode", "\t", showBlankIfNil(o.clientset.PreferenceClient.VolumeAccessMode()))
+	fmt.Fprintln(w, "DependencyCache", "\t", showBlankIfNil(o.clientset.PreferenceClient.DependencyCache()))
 
 	w.Flush()
 	return
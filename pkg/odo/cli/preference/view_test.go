@@ -40,8 +40,12 @@ func TestView(t *testing.T) {
 	prefClient.EXPECT().Timeout().Return(pointer.Int(10))
 	prefClient.EXPECT().RegistryCacheTime().Return(pointer.Int(240))
 	prefClient.EXPECT().PushTimeout().Return(pointer.Int(10))
+	prefClient.EXPECT().DeployTimeout().Return(nil)
 	prefClient.EXPECT().EphemeralSourceVolume().Return(pointer.Bool(false))
 	prefClient.EXPECT().ConsentTelemetry().Return(pointer.Bool(false))
+	prefClient.EXPECT().StorageClass().Return(pointer.String("nfs-client"))
+	prefClient.EXPECT().VolumeAccessMode().Return(nil)
+	prefClient.EXPECT().DependencyCache().Return(pointer.Bool(true))
 
 	err = opts.Run(context.Background())
 	if err != nil {
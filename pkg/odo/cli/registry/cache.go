@@ -0,0 +1,73 @@
+package registry
+
+import (
+	// Built-in packages
+	"fmt"
+	"io"
+	"time"
+
+	// Third-party packages
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	// odo packages
+	"github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/registry"
+)
+
+const cacheCommandName = "cache"
+
+var cacheDesc = ktemplates.LongDesc(`Manage the cache of the devfile registries indexes`)
+
+// NewCmdCache implements the "odo registry cache" command
+func NewCmdCache(name, fullName string) *cobra.Command {
+	cacheStatusCmd := NewCmdCacheStatus(cacheStatusCommandName, util.GetFullName(fullName, cacheStatusCommandName))
+	cacheRefreshCmd := NewCmdCacheRefresh(cacheRefreshCommandName, util.GetFullName(fullName, cacheRefreshCommandName))
+	cacheCleanCmd := NewCmdCacheClean(cacheCleanCommandName, util.GetFullName(fullName, cacheCleanCommandName))
+
+	cacheCmd := &cobra.Command{
+		Use:   name,
+		Short: cacheDesc,
+		Long:  cacheDesc,
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s",
+			cacheStatusCmd.Example,
+			cacheRefreshCmd.Example,
+			cacheCleanCmd.Example,
+		),
+	}
+
+	cacheCmd.AddCommand(cacheStatusCmd, cacheRefreshCmd, cacheCleanCmd)
+	cacheCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return cacheCmd
+}
+
+// printCacheStatuses prints the status of the cached index of each registry
+func printCacheStatuses(w io.Writer, statuses []registry.RegistryCacheStatus) {
+	fmt.Fprintln(w, "NAME", "\t", "URL", "\t", "CACHED", "\t", "SIZE", "\t", "REACHABLE")
+	for _, status := range statuses {
+		cached := "No"
+		size := "-"
+		if status.Cached && status.LastUpdate != nil {
+			cached = fmt.Sprintf("%s ago", duration.HumanDuration(time.Since(*status.LastUpdate)))
+			size = formatSize(status.Size)
+		}
+		reachable := "Yes"
+		if !status.Reachable {
+			reachable = "No: " + status.Error
+		}
+		fmt.Fprintln(w, status.Name, "\t", status.URL, "\t", cached, "\t", size, "\t", reachable)
+	}
+}
+
+// formatSize returns a size in bytes in a human readable format
+func formatSize(size int64) string {
+	if size < 1024 {
+		return fmt.Sprintf("%d B", size)
+	}
+	if size < 1024*1024 {
+		return fmt.Sprintf("%.1f KiB", float64(size)/1024)
+	}
+	return fmt.Sprintf("%.1f MiB", float64(size)/(1024*1024))
+}
@@ -0,0 +1,93 @@
+package registry
+
+import (
+	"context"
+	// Built-in packages
+	"fmt"
+
+	// Third-party packages
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	// odo packages
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+)
+
+const cacheCleanCommandName = "clean"
+
+// "odo registry cache clean" command description and examples
+var (
+	cacheCleanDesc = ktemplates.LongDesc(`Remove the cached index of the devfile registries`)
+
+	cacheCleanExample = ktemplates.Examples(`# Remove the cached index of all the devfile registries
+	%[1]s
+
+	# Remove the cached index of a specific devfile registry
+	%[1]s DefaultDevfileRegistry
+	`)
+)
+
+// CacheCleanOptions encapsulates the options for the "odo registry cache clean" command
+type CacheCleanOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Parameters
+	registryName string
+}
+
+// NewCacheCleanOptions creates a new CacheCleanOptions instance
+func NewCacheCleanOptions() *CacheCleanOptions {
+	return &CacheCleanOptions{}
+}
+
+func (o *CacheCleanOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes CacheCleanOptions after they've been created
+func (o *CacheCleanOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	if len(args) > 0 {
+		o.registryName = args[0]
+	}
+	return nil
+}
+
+// Validate validates the CacheCleanOptions based on completed values
+func (o *CacheCleanOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for "odo registry cache clean" command
+func (o *CacheCleanOptions) Run(ctx context.Context) (err error) {
+	err = o.clientset.RegistryClient.CleanCache(o.registryName)
+	if err != nil {
+		return err
+	}
+	if o.registryName == "" {
+		log.Success("Registries cache cleaned")
+	} else {
+		log.Successf("Cache of registry %q cleaned", o.registryName)
+	}
+	return nil
+}
+
+// NewCmdCacheClean implements the "odo registry cache clean" command
+func NewCmdCacheClean(name, fullName string) *cobra.Command {
+	o := NewCacheCleanOptions()
+	cacheCleanCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s [registry name]", name),
+		Short:   cacheCleanDesc,
+		Long:    cacheCleanDesc,
+		Example: fmt.Sprintf(fmt.Sprint(cacheCleanExample), fullName),
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(cacheCleanCmd, clientset.REGISTRY)
+	return cacheCleanCmd
+}
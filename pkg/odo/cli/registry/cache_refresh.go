@@ -0,0 +1,112 @@
+package registry
+
+import (
+	"context"
+	// Built-in packages
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	// Third-party packages
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	// odo packages
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+)
+
+const cacheRefreshCommandName = "refresh"
+
+// "odo registry cache refresh" command description and examples
+var (
+	cacheRefreshDesc = ktemplates.LongDesc(`Download again and cache the index of the devfile registries`)
+
+	cacheRefreshExample = ktemplates.Examples(`# Refresh the cached index of all the devfile registries
+	%[1]s
+
+	# Refresh the cached index of a specific devfile registry
+	%[1]s DefaultDevfileRegistry
+	`)
+)
+
+// CacheRefreshOptions encapsulates the options for the "odo registry cache refresh" command
+type CacheRefreshOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Parameters
+	registryName string
+}
+
+// NewCacheRefreshOptions creates a new CacheRefreshOptions instance
+func NewCacheRefreshOptions() *CacheRefreshOptions {
+	return &CacheRefreshOptions{}
+}
+
+func (o *CacheRefreshOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes CacheRefreshOptions after they've been created
+func (o *CacheRefreshOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	if len(args) > 0 {
+		o.registryName = args[0]
+	}
+	return nil
+}
+
+// Validate validates the CacheRefreshOptions based on completed values
+func (o *CacheRefreshOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for "odo registry cache refresh" command
+func (o *CacheRefreshOptions) Run(ctx context.Context) (err error) {
+	statuses, err := o.clientset.RegistryClient.RefreshCache(o.registryName)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	printCacheStatuses(w, statuses)
+	w.Flush()
+
+	var failed int
+	for _, status := range statuses {
+		if !status.Reachable {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("unable to refresh %d registry(ies) out of %d", failed, len(statuses))
+	}
+	log.Success("Registries cache refreshed")
+	return nil
+}
+
+// RunForJsonOutput contains the logic for "odo registry cache refresh -o json" command
+func (o *CacheRefreshOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	return o.clientset.RegistryClient.RefreshCache(o.registryName)
+}
+
+// NewCmdCacheRefresh implements the "odo registry cache refresh" command
+func NewCmdCacheRefresh(name, fullName string) *cobra.Command {
+	o := NewCacheRefreshOptions()
+	cacheRefreshCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s [registry name]", name),
+		Short:   cacheRefreshDesc,
+		Long:    cacheRefreshDesc,
+		Example: fmt.Sprintf(fmt.Sprint(cacheRefreshExample), fullName),
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(cacheRefreshCmd, clientset.REGISTRY)
+	machineoutput.UsedByCommand(cacheRefreshCmd)
+	return cacheRefreshCmd
+}
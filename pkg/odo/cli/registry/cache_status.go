@@ -0,0 +1,100 @@
+package registry
+
+import (
+	"context"
+	// Built-in packages
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	// Third-party packages
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	// odo packages
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+)
+
+const cacheStatusCommandName = "status"
+
+// "odo registry cache status" command description and examples
+var (
+	cacheStatusDesc = ktemplates.LongDesc(`Show the age and size of the cached index of the devfile registries, and their reachability`)
+
+	cacheStatusExample = ktemplates.Examples(`# Show the cache status of all the devfile registries
+	%[1]s
+
+	# Show the cache status of a specific devfile registry
+	%[1]s DefaultDevfileRegistry
+	`)
+)
+
+// CacheStatusOptions encapsulates the options for the "odo registry cache status" command
+type CacheStatusOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Parameters
+	registryName string
+}
+
+// NewCacheStatusOptions creates a new CacheStatusOptions instance
+func NewCacheStatusOptions() *CacheStatusOptions {
+	return &CacheStatusOptions{}
+}
+
+func (o *CacheStatusOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes CacheStatusOptions after they've been created
+func (o *CacheStatusOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	if len(args) > 0 {
+		o.registryName = args[0]
+	}
+	return nil
+}
+
+// Validate validates the CacheStatusOptions based on completed values
+func (o *CacheStatusOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for "odo registry cache status" command
+func (o *CacheStatusOptions) Run(ctx context.Context) (err error) {
+	statuses, err := o.clientset.RegistryClient.GetCacheStatus(o.registryName)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	printCacheStatuses(w, statuses)
+	w.Flush()
+	return nil
+}
+
+// RunForJsonOutput contains the logic for "odo registry cache status -o json" command
+func (o *CacheStatusOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	return o.clientset.RegistryClient.GetCacheStatus(o.registryName)
+}
+
+// NewCmdCacheStatus implements the "odo registry cache status" command
+func NewCmdCacheStatus(name, fullName string) *cobra.Command {
+	o := NewCacheStatusOptions()
+	cacheStatusCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s [registry name]", name),
+		Short:   cacheStatusDesc,
+		Long:    cacheStatusDesc,
+		Example: fmt.Sprintf(fmt.Sprint(cacheStatusExample), fullName),
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(cacheStatusCmd, clientset.REGISTRY)
+	machineoutput.UsedByCommand(cacheStatusCmd)
+	return cacheStatusCmd
+}
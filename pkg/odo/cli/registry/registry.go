@@ -0,0 +1,31 @@
+package registry
+
+import (
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended registry command name
+const RecommendedCommandName = "registry"
+
+var registryDesc = ktemplates.LongDesc(`Manage the devfile registries`)
+
+// NewCmdRegistry implements the registry odo command.
+// The devfile registries are configured with the "odo preference registry" commands
+func NewCmdRegistry(name, fullName string) *cobra.Command {
+	registryCmd := &cobra.Command{
+		Use:   name,
+		Short: registryDesc,
+		Long:  registryDesc,
+	}
+
+	cacheCmd := NewCmdCache(cacheCommandName, util.GetFullName(fullName, cacheCommandName))
+	registryCmd.Example = cacheCmd.Example
+	registryCmd.AddCommand(cacheCmd)
+	registryCmd.Annotations = map[string]string{"command": "main"}
+	registryCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return registryCmd
+}
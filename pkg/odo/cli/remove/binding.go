@@ -0,0 +1,92 @@
+package remove
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/binding/backend"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+)
+
+// BindingRecommendedCommandName is the recommended binding sub-command name
+const BindingRecommendedCommandName = "binding"
+
+var removeBindingExample = ktemplates.Examples(`
+# Remove the binding named 'redis-binding' from the devfile in the current directory
+%[1]s --name redis-binding
+`)
+
+type RemoveBindingOptions struct {
+	// name of the binding to remove
+	name string
+
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+// NewRemoveBindingOptions returns new instance of RemoveBindingOptions
+func NewRemoveBindingOptions() *RemoveBindingOptions {
+	return &RemoveBindingOptions{}
+}
+
+func (o *RemoveBindingOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *RemoveBindingOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(""))
+	return err
+}
+
+func (o *RemoveBindingOptions) Validate() (err error) {
+	if o.name == "" {
+		return errors.New("missing --name parameter: please add --name <name> to specify the binding to remove")
+	}
+	return nil
+}
+
+func (o *RemoveBindingOptions) Run(_ context.Context) error {
+	devfileObj, err := o.clientset.BindingClient.RemoveBinding(o.name, o.EnvSpecificInfo.GetDevfileObj())
+	if err != nil {
+		return err
+	}
+
+	err = devfileObj.WriteYamlDevfile()
+	if err != nil {
+		return err
+	}
+
+	log.Successf("Successfully removed the binding %q from the devfile.", o.name)
+	log.Info("Run `odo dev` to delete it from the cluster.")
+	return nil
+}
+
+// NewCmdBinding implements the binding odo sub-command
+func NewCmdBinding(name, fullName string) *cobra.Command {
+	o := NewRemoveBindingOptions()
+
+	var bindingCmd = &cobra.Command{
+		Use:     name,
+		Short:   "Remove Binding",
+		Long:    "Remove a binding between the component and a service instance from the devfile. The binding is deleted from the cluster by \"odo dev\"",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(removeBindingExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	bindingCmd.Flags().StringVar(&o.name, backend.FLAG_NAME, "", "Name of the binding to remove")
+	clientset.Add(bindingCmd, clientset.BINDING)
+
+	return bindingCmd
+}
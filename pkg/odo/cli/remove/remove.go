@@ -0,0 +1,25 @@
+package remove
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended remove command name
+const RecommendedCommandName = "remove"
+
+// NewCmdRemove implements the remove odo command
+func NewCmdRemove(name, fullName string) *cobra.Command {
+	var removeCmd = &cobra.Command{
+		Use:   name,
+		Short: "Remove resources from devfile",
+	}
+
+	bindingCmd := NewCmdBinding(BindingRecommendedCommandName, util.GetFullName(fullName, BindingRecommendedCommandName))
+	removeCmd.AddCommand(bindingCmd)
+	removeCmd.Annotations = map[string]string{"command": "main"}
+	removeCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return removeCmd
+}
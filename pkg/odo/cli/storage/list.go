@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	storagepkg "github.com/redhat-developer/odo/pkg/storage"
+)
+
+// ListRecommendedCommandName is the recommended list sub-command name
+const ListRecommendedCommandName = "list"
+
+var listExample = ktemplates.Examples(`
+# List the volumes of the component, with their PVC, mounts and usage
+%[1]s
+
+# List the volumes of the component in JSON format
+%[1]s -o json
+`)
+
+// ListOptions encapsulates the options for the "odo storage list" command
+type ListOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+}
+
+// NewListOptions creates a new ListOptions instance
+func NewListOptions() *ListOptions {
+	return &ListOptions{}
+}
+
+func (o *ListOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes ListOptions after they've been created
+func (o *ListOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(""))
+	if err != nil {
+		return err
+	}
+	// this ensures that the namespace set in env.yaml is used
+	o.clientset.KubernetesClient.SetNamespace(o.GetProject())
+	return nil
+}
+
+// Validate validates the ListOptions based on completed values
+func (o *ListOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for "odo storage list" command
+func (o *ListOptions) Run(ctx context.Context) (err error) {
+	volumes, err := o.listVolumes()
+	if err != nil {
+		return err
+	}
+	if len(volumes) == 0 {
+		log.Info("The component has no volumes.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	printVolumes(w, volumes)
+	w.Flush()
+
+	for _, volume := range volumes {
+		if volume.Status == storagepkg.StateTypeLocallyDeleted {
+			log.Infof("\nSome PVCs are not used by the volumes of the devfile anymore, run `odo storage prune` to delete them")
+			break
+		}
+	}
+	return nil
+}
+
+// RunForJsonOutput contains the logic for "odo storage list -o json" command
+func (o *ListOptions) RunForJsonOutput(ctx context.Context) (out interface{}, err error) {
+	volumes, err := o.listVolumes()
+	if err != nil {
+		return nil, err
+	}
+	if volumes == nil {
+		volumes = []storagepkg.VolumeInfo{}
+	}
+	return volumes, nil
+}
+
+func (o *ListOptions) listVolumes() ([]storagepkg.VolumeInfo, error) {
+	devfileObj := o.EnvSpecificInfo.GetDevfileObj()
+	return storagepkg.ListVolumes(o.clientset.KubernetesClient, devfileObj, devfileObj.GetMetadataName())
+}
+
+func printVolumes(w io.Writer, volumes []storagepkg.VolumeInfo) {
+	fmt.Fprintln(w, "NAME", "\t", "PVC", "\t", "SIZE", "\t", "EPHEMERAL", "\t", "MOUNTS", "\t", "USAGE", "\t", "STATUS")
+	for _, volume := range volumes {
+		pvc := volume.PVC
+		if pvc == "" {
+			pvc = "-"
+		}
+		var mounts []string
+		for _, mount := range volume.Mounts {
+			mounts = append(mounts, mount.Container+":"+mount.Path)
+		}
+		mountsStr := "-"
+		if len(mounts) > 0 {
+			mountsStr = strings.Join(mounts, ", ")
+		}
+		usage := "-"
+		if volume.Usage != nil {
+			usage = fmt.Sprintf("%s / %s", formatSize(volume.Usage.Used), formatSize(volume.Usage.Total))
+		}
+		fmt.Fprintln(w, volume.Name, "\t", pvc, "\t", volume.Size, "\t", volume.Ephemeral, "\t", mountsStr, "\t", usage, "\t", volume.Status)
+	}
+}
+
+// formatSize returns a size in bytes in a human readable format
+func formatSize(size int64) string {
+	if size < 1024 {
+		return fmt.Sprintf("%d B", size)
+	}
+	if size < 1024*1024 {
+		return fmt.Sprintf("%.1f KiB", float64(size)/1024)
+	}
+	if size < 1024*1024*1024 {
+		return fmt.Sprintf("%.1f MiB", float64(size)/(1024*1024))
+	}
+	return fmt.Sprintf("%.1f GiB", float64(size)/(1024*1024*1024))
+}
+
+// NewCmdList implements the "odo storage list" command
+func NewCmdList(name, fullName string) *cobra.Command {
+	o := NewListOptions()
+	listCmd := &cobra.Command{
+		Use:     name,
+		Short:   "List the volumes of the component",
+		Long:    "List the volumes of the devfile, with their PVC, size, mounts and usage, and the PVCs not used by the devfile anymore",
+		Example: fmt.Sprintf(listExample, fullName),
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(listCmd, clientset.KUBERNETES)
+	machineoutput.UsedByCommand(listCmd)
+	return listCmd
+}
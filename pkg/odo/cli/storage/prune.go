@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	storagepkg "github.com/redhat-developer/odo/pkg/storage"
+	storagelabels "github.com/redhat-developer/odo/pkg/storage/labels"
+)
+
+// PruneRecommendedCommandName is the recommended prune sub-command name
+const PruneRecommendedCommandName = "prune"
+
+var pruneExample = ktemplates.Examples(`
+# Delete the PVCs of the component not used by the volumes of the devfile anymore
+%[1]s
+
+# Delete them without prompting
+%[1]s --force
+`)
+
+// PruneOptions encapsulates the options for the "odo storage prune" command
+type PruneOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	forceFlag bool
+}
+
+// NewPruneOptions creates a new PruneOptions instance
+func NewPruneOptions() *PruneOptions {
+	return &PruneOptions{}
+}
+
+func (o *PruneOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes PruneOptions after they've been created
+func (o *PruneOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(""))
+	if err != nil {
+		return err
+	}
+	// this ensures that the namespace set in env.yaml is used
+	o.clientset.KubernetesClient.SetNamespace(o.GetProject())
+	return nil
+}
+
+// Validate validates the PruneOptions based on completed values
+func (o *PruneOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for "odo storage prune" command
+func (o *PruneOptions) Run(ctx context.Context) (err error) {
+	devfileObj := o.EnvSpecificInfo.GetDevfileObj()
+	orphans, err := storagepkg.ListOrphanPVCs(o.clientset.KubernetesClient, devfileObj, devfileObj.GetMetadataName())
+	if err != nil {
+		return err
+	}
+	if len(orphans) == 0 {
+		log.Info("No PVC to delete")
+		return nil
+	}
+
+	log.Info("The following PVCs are not used by the volumes of the devfile anymore:")
+	for _, pvc := range orphans {
+		size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		log.Printf("%s (volume %q, %s)", pvc.Name, pvc.Labels[storagelabels.DevfileStorageLabel], size.String())
+	}
+
+	if !o.forceFlag && !ui.Proceed("Are you sure you want to delete these PVCs and their data?") {
+		log.Error("Aborting deletion of PVCs")
+		return nil
+	}
+
+	// a PVC failing to be deleted does not prevent the other PVCs from being deleted
+	var failed []string
+	for _, pvc := range orphans {
+		err = o.clientset.KubernetesClient.DeletePVC(pvc.Name)
+		if err != nil {
+			log.Warningf("Failed to delete the PVC %q: %s", pvc.Name, err)
+			failed = append(failed, pvc.Name)
+			continue
+		}
+		log.Successf("Deleted PVC %q", pvc.Name)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("unable to delete %d PVC(s) out of %d: %s", len(failed), len(orphans), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// NewCmdPrune implements the "odo storage prune" command
+func NewCmdPrune(name, fullName string) *cobra.Command {
+	o := NewPruneOptions()
+	pruneCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Delete the PVCs not used by the devfile anymore",
+		Long:    "Delete the PVCs created by odo for the component, not referenced anymore by a volume of the devfile, for example after renaming a volume",
+		Example: fmt.Sprintf(pruneExample, fullName),
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	pruneCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Delete the PVCs without prompting")
+	clientset.Add(pruneCmd, clientset.KUBERNETES)
+	return pruneCmd
+}
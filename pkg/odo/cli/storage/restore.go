@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/sync"
+)
+
+// RestoreRecommendedCommandName is the recommended restore sub-command name
+const RestoreRecommendedCommandName = "restore"
+
+var restoreExample = ktemplates.Examples(`
+# Restore the content of the volume 'db-data' of the running component from a local archive
+%[1]s db-data db-data.tar.gz
+`)
+
+// RestoreOptions encapsulates the options for the "odo storage restore" command
+type RestoreOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+
+	// Parameters
+	volumeName  string
+	archivePath string
+
+	// Local variables
+	compInfo   common.ComponentInfo
+	volumePath string
+}
+
+// NewRestoreOptions creates a new RestoreOptions instance
+func NewRestoreOptions() *RestoreOptions {
+	return &RestoreOptions{}
+}
+
+func (o *RestoreOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes RestoreOptions after they've been created
+func (o *RestoreOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.volumeName, o.archivePath = args[0], args[1]
+
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(""))
+	if err != nil {
+		return err
+	}
+	// this ensures that the namespace set in env.yaml is used
+	o.clientset.KubernetesClient.SetNamespace(o.GetProject())
+
+	o.compInfo, o.volumePath, err = getVolumeLocation(o.Context, o.clientset, o.volumeName)
+	return err
+}
+
+// Validate validates the RestoreOptions based on completed values
+func (o *RestoreOptions) Validate() (err error) {
+	if _, err = os.Stat(o.archivePath); err != nil {
+		return fmt.Errorf("unable to read the archive %q: %w", o.archivePath, err)
+	}
+	return nil
+}
+
+// Run contains the logic for "odo storage restore" command
+func (o *RestoreOptions) Run(ctx context.Context) (err error) {
+	file, err := os.Open(o.archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = sync.ExtractArchiveToContainer(sync.NewKubernetesSyncClient(o.clientset.KubernetesClient), o.compInfo, o.volumePath, file)
+	if err != nil {
+		return err
+	}
+	log.Successf("Restored the content of volume %q from %q", o.volumeName, o.archivePath)
+	return nil
+}
+
+// NewCmdRestore implements the "odo storage restore" command
+func NewCmdRestore(name, fullName string) *cobra.Command {
+	o := NewRestoreOptions()
+	restoreCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <volume> <file.tar.gz>", name),
+		Short:   "Restore the content of a volume from a local archive",
+		Long:    "Extract a local gzip compressed tar archive, created with `odo storage snapshot`, into a volume of the component running in dev mode. The existing files of the volume are kept, unless overwritten by the archive",
+		Example: fmt.Sprintf(restoreExample, fullName),
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(restoreCmd, clientset.KUBERNETES)
+	return restoreCmd
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/sync"
+)
+
+// SnapshotRecommendedCommandName is the recommended snapshot sub-command name
+const SnapshotRecommendedCommandName = "snapshot"
+
+var snapshotExample = ktemplates.Examples(`
+# Save the content of the volume 'db-data' of the running component to a local archive
+%[1]s db-data db-data.tar.gz
+`)
+
+// SnapshotOptions encapsulates the options for the "odo storage snapshot" command
+type SnapshotOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+
+	// Parameters
+	volumeName  string
+	archivePath string
+
+	// Local variables
+	compInfo   common.ComponentInfo
+	volumePath string
+}
+
+// NewSnapshotOptions creates a new SnapshotOptions instance
+func NewSnapshotOptions() *SnapshotOptions {
+	return &SnapshotOptions{}
+}
+
+func (o *SnapshotOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes SnapshotOptions after they've been created
+func (o *SnapshotOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.volumeName, o.archivePath = args[0], args[1]
+
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(""))
+	if err != nil {
+		return err
+	}
+	// this ensures that the namespace set in env.yaml is used
+	o.clientset.KubernetesClient.SetNamespace(o.GetProject())
+
+	o.compInfo, o.volumePath, err = getVolumeLocation(o.Context, o.clientset, o.volumeName)
+	return err
+}
+
+// Validate validates the SnapshotOptions based on completed values
+func (o *SnapshotOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for "odo storage snapshot" command
+func (o *SnapshotOptions) Run(ctx context.Context) (err error) {
+	file, err := os.Create(o.archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = sync.ArchiveFromContainer(sync.NewKubernetesSyncClient(o.clientset.KubernetesClient), o.compInfo, o.volumePath, file)
+	if err != nil {
+		_ = os.Remove(o.archivePath)
+		return err
+	}
+	log.Successf("Saved the content of volume %q to %q", o.volumeName, o.archivePath)
+	return nil
+}
+
+// NewCmdSnapshot implements the "odo storage snapshot" command
+func NewCmdSnapshot(name, fullName string) *cobra.Command {
+	o := NewSnapshotOptions()
+	snapshotCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <volume> <file.tar.gz>", name),
+		Short:   "Save the content of a volume to a local archive",
+		Long:    "Save the content of a volume of the component running in dev mode to a local gzip compressed tar archive",
+		Example: fmt.Sprintf(snapshotExample, fullName),
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(snapshotCmd, clientset.KUBERNETES)
+	return snapshotCmd
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended storage command name
+const RecommendedCommandName = "storage"
+
+// NewCmdStorage implements the storage odo command
+func NewCmdStorage(name, fullName string) *cobra.Command {
+	var storageCmd = &cobra.Command{
+		Use:   name,
+		Short: "Manage the volumes of the component",
+	}
+
+	snapshotCmd := NewCmdSnapshot(SnapshotRecommendedCommandName, util.GetFullName(fullName, SnapshotRecommendedCommandName))
+	restoreCmd := NewCmdRestore(RestoreRecommendedCommandName, util.GetFullName(fullName, RestoreRecommendedCommandName))
+	listCmd := NewCmdList(ListRecommendedCommandName, util.GetFullName(fullName, ListRecommendedCommandName))
+	pruneCmd := NewCmdPrune(PruneRecommendedCommandName, util.GetFullName(fullName, PruneRecommendedCommandName))
+	storageCmd.AddCommand(listCmd, pruneCmd, snapshotCmd, restoreCmd)
+	storageCmd.Annotations = map[string]string{"command": "main"}
+	storageCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return storageCmd
+}
+
+// getVolumeLocation returns the container of the running component mounting the volume, and the path where the volume is mounted
+func getVolumeLocation(ctx *genericclioptions.Context, cs *clientset.Clientset, volumeName string) (common.ComponentInfo, string, error) {
+	storages, err := ctx.EnvSpecificInfo.ListStorage()
+	if err != nil {
+		return common.ComponentInfo{}, "", err
+	}
+	var containerName, path string
+	for _, storage := range storages {
+		if storage.Name == volumeName {
+			containerName, path = storage.Container, storage.Path
+			break
+		}
+	}
+	if containerName == "" {
+		return common.ComponentInfo{}, "", fmt.Errorf("no volume %q mounted by a container found in the devfile", volumeName)
+	}
+
+	componentName := ctx.EnvSpecificInfo.GetDevfileObj().GetMetadataName()
+	pod, err := cs.KubernetesClient.GetPodUsingComponentName(componentName)
+	if err != nil {
+		return common.ComponentInfo{}, "", fmt.Errorf("unable to find the pod of the component %q, please run `odo dev`: %w", componentName, err)
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		return common.ComponentInfo{}, "", fmt.Errorf("the pod of the component %q is not running, please run `odo dev`", componentName)
+	}
+
+	return common.ComponentInfo{
+		ContainerName: containerName,
+		PodName:       pod.GetName(),
+	}, path, nil
+}
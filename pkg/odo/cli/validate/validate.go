@@ -0,0 +1,95 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	devfilevalidate "github.com/redhat-developer/odo/pkg/devfile/validate"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "validate"
+
+// ValidateOptions encapsulates the options for the odo validate command
+type ValidateOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	contextFlag string
+}
+
+var validateExample = templates.Examples(`
+  # Validate the Kubernetes components of the devfile, against the schema of the cluster if it is reachable
+  %[1]s
+`)
+
+// NewValidateOptions creates a new ValidateOptions instance
+func NewValidateOptions() *ValidateOptions {
+	return &ValidateOptions{}
+}
+
+func (o *ValidateOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+// Complete completes ValidateOptions after they've been created
+func (o *ValidateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag).IsOffline())
+	return err
+}
+
+// Validate validates the ValidateOptions based on completed values
+func (o *ValidateOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo validate command
+func (o *ValidateOptions) Run(ctx context.Context) (err error) {
+	devfileObj := o.EnvSpecificInfo.GetDevfileObj()
+	path := filepath.Dir(o.EnvSpecificInfo.GetDevfilePath())
+	err = devfilevalidate.ValidateKubernetesManifests(o.clientset.KubernetesClient, devfileObj, path)
+	if err != nil {
+		return err
+	}
+	log.Success("The Kubernetes components of the devfile are valid")
+	return nil
+}
+
+// NewCmdValidate implements the odo validate command
+func NewCmdValidate(name, fullName string) *cobra.Command {
+	o := NewValidateOptions()
+	validateCmd := &cobra.Command{
+		Use:   name,
+		Short: "Validate the Kubernetes components of the devfile",
+		Long: `Validate the manifests of the Kubernetes components of the devfile, without creating them in the cluster.
+The manifests are validated against the schema of the cluster when it is reachable.
+When the cluster is not reachable, no OpenAPI schema is bundled with odo: the resources of the built-in Kubernetes kinds
+are only checked for unknown fields and values of a wrong type, and the resources of other kinds, including custom resources,
+are only checked for their names and labels.`,
+		Example: fmt.Sprintf(validateExample, fullName),
+		Args:    cobra.MaximumNArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(validateCmd, clientset.KUBERNETES_NULLABLE)
+
+	// Add a defined annotation in order to appear in the help menu
+	validateCmd.Annotations["command"] = "main"
+	validateCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	odoutil.AddContextFlag(validateCmd, &o.contextFlag)
+	return validateCmd
+}
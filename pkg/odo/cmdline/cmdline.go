@@ -12,7 +12,8 @@ type Cmdline interface {
 	// GetWorkingdirectory returns tehe directory on which the command should execute
 	GetWorkingDirectory() (string, error)
 
-	// GetFlags returns a map of flags set
+	// GetFlags returns a map of flags set.
+	// The values of the flags which can be repeated (string arrays) are encoded as JSON arrays
 	GetFlags() map[string]string
 
 	// FlagValue returns the value for a flag
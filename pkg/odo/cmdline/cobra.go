@@ -2,6 +2,7 @@ package cmdline
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -188,6 +189,16 @@ func (o *Cobra) GetKubeClient() (kclient.ClientInterface, error) {
 func (o *Cobra) GetFlags() map[string]string {
 	flags := map[string]string{}
 	o.cmd.Flags().Visit(func(f *pflag.Flag) {
+		if f.Value.Type() == "stringArray" {
+			// the values are read as they were passed, and encoded without ambiguity, whatever characters they contain
+			values, err := o.cmd.Flags().GetStringArray(f.Name)
+			if err == nil {
+				if encoded, err := json.Marshal(values); err == nil {
+					flags[f.Name] = string(encoded)
+					return
+				}
+			}
+		}
 		flags[f.Name] = f.Value.String()
 	})
 	return flags
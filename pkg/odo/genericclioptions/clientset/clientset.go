@@ -13,6 +13,7 @@ package clientset
 
 import (
 	"github.com/redhat-developer/odo/pkg/alizer"
+	"github.com/redhat-developer/odo/pkg/binding"
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/spf13/cobra"
 
@@ -30,6 +31,8 @@ import (
 const (
 	// ALIZER instantiates client for pkg/alizer
 	ALIZER = "DEP_ALIZER"
+	// BINDING instantiates client for pkg/binding
+	BINDING = "DEP_BINDING"
 	// DELETE_COMPONENT instantiates client for pkg/component/delete
 	DELETE_COMPONENT = "DEP_DELETE_COMPONENT"
 	// DEPLOY instantiates client for pkg/deploy
@@ -60,10 +63,11 @@ const (
 // Clients will be created only once and be reused for sub-dependencies
 var subdeps map[string][]string = map[string][]string{
 	ALIZER:           {REGISTRY},
+	BINDING:          {KUBERNETES_NULLABLE},
 	DELETE_COMPONENT: {KUBERNETES},
-	DEPLOY:           {KUBERNETES},
+	DEPLOY:           {KUBERNETES, DELETE_COMPONENT},
 	DEV:              {WATCH},
-	INIT:             {ALIZER, FILESYSTEM, PREFERENCE, REGISTRY},
+	INIT:             {ALIZER, FILESYSTEM, KUBERNETES_NULLABLE, PREFERENCE, REGISTRY},
 	PROJECT:          {KUBERNETES_NULLABLE},
 	REGISTRY:         {FILESYSTEM, PREFERENCE},
 	WATCH:            {DELETE_COMPONENT},
@@ -72,6 +76,7 @@ var subdeps map[string][]string = map[string][]string{
 
 type Clientset struct {
 	AlizerClient     alizer.Client
+	BindingClient    binding.Client
 	DeleteClient     _delete.Client
 	DeployClient     deploy.Client
 	DevClient        dev.Client
@@ -113,9 +118,15 @@ func Fetch(command *cobra.Command) (*Clientset, error) {
 		dep.FS = filesystem.DefaultFs{}
 	}
 	if isDefined(command, KUBERNETES) || isDefined(command, KUBERNETES_NULLABLE) {
-		dep.KubernetesClient, err = kclient.New()
-		if err != nil && isDefined(command, KUBERNETES) {
-			return nil, err
+		var kubeClient *kclient.Client
+		kubeClient, err = kclient.New()
+		if err != nil {
+			if isDefined(command, KUBERNETES) {
+				return nil, err
+			}
+		} else {
+			// keep the interface nil when the client cannot be created, so nullable clients can be checked against nil
+			dep.KubernetesClient = kubeClient
 		}
 	}
 	if isDefined(command, PREFERENCE) {
@@ -132,14 +143,17 @@ func Fetch(command *cobra.Command) (*Clientset, error) {
 	if isDefined(command, ALIZER) {
 		dep.AlizerClient = alizer.NewAlizerClient(dep.RegistryClient)
 	}
+	if isDefined(command, BINDING) {
+		dep.BindingClient = binding.NewBindingClient(dep.KubernetesClient)
+	}
 	if isDefined(command, DELETE_COMPONENT) {
 		dep.DeleteClient = _delete.NewDeleteComponentClient(dep.KubernetesClient)
 	}
 	if isDefined(command, DEPLOY) {
-		dep.DeployClient = deploy.NewDeployClient(dep.KubernetesClient)
+		dep.DeployClient = deploy.NewDeployClient(dep.KubernetesClient, dep.DeleteClient)
 	}
 	if isDefined(command, INIT) {
-		dep.InitClient = _init.NewInitClient(dep.FS, dep.PreferenceClient, dep.RegistryClient, dep.AlizerClient)
+		dep.InitClient = _init.NewInitClient(dep.FS, dep.PreferenceClient, dep.RegistryClient, dep.AlizerClient, dep.KubernetesClient)
 	}
 	if isDefined(command, PROJECT) {
 		dep.ProjectClient = project.NewClient(dep.KubernetesClient)
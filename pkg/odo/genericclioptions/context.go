@@ -47,6 +47,8 @@ type internalCxt struct {
 	outputFlag string
 	// The path of the detected devfile
 	devfilePath string
+	// variables are the values overriding the values of the variables of the devfile
+	variables map[string]string
 	// Kclient can be used to access Kubernetes resources
 	KClient             kclient.ClientInterface
 	EnvSpecificInfo     *envinfo.EnvSpecificInfo
@@ -60,6 +62,9 @@ type CreateParameters struct {
 	devfile          bool
 	offline          bool
 	appIfNeeded      bool
+	// withVariables is true for the commands overriding the variables of the devfile
+	withVariables bool
+	variables     map[string]string
 }
 
 func NewCreateParameters(cmdline cmdline.Cmdline) CreateParameters {
@@ -82,6 +87,15 @@ func (o CreateParameters) CreateAppIfNeeded() CreateParameters {
 	return o
 }
 
+// WithVariables overrides the values of the variables of the devfile by their default values for the project in the env file,
+// and by variables. It must be used only by the commands accepting the --var and --var-file flags, or rendering the manifests
+// of the devfile, the other commands parse the devfile with the values of the variables defined in the devfile
+func (o CreateParameters) WithVariables(variables map[string]string) CreateParameters {
+	o.withVariables = true
+	o.variables = variables
+	return o
+}
+
 // New creates a context based on the given parameters
 func New(parameters CreateParameters) (*Context, error) {
 	ctx := internalCxt{}
@@ -123,11 +137,19 @@ func New(parameters CreateParameters) (*Context, error) {
 	if parameters.devfile {
 		isDevfile := odoutil.CheckPathExists(ctx.devfilePath)
 		if isDevfile {
-			// Parse devfile and validate
-			devObj, err := devfile.ParseAndValidateFromFile(ctx.devfilePath)
+			// Parse devfile and validate, with the default values of the variables for the project overridden by the parameters
+			var variables map[string]string
+			if parameters.withVariables {
+				variables = ctx.EnvSpecificInfo.GetVariables(ctx.project)
+				for name, value := range parameters.variables {
+					variables[name] = value
+				}
+			}
+			devObj, err := devfile.ParseAndValidateFromFileWithVariables(ctx.devfilePath, variables)
 			if err != nil {
 				return nil, fmt.Errorf("failed to parse the devfile %s, with error: %s", ctx.devfilePath, err)
 			}
+			ctx.variables = variables
 			err = validate.ValidateDevfileData(devObj.Data)
 			if err != nil {
 				return nil, err
@@ -196,3 +218,8 @@ func (o *Context) GetOutputFlag() string {
 func (o *Context) GetDevfilePath() string {
 	return o.devfilePath
 }
+
+// GetVariables returns the values overriding the values of the variables of the devfile, when the devfile is parsed
+func (o *Context) GetVariables() map[string]string {
+	return o.variables
+}
@@ -15,6 +15,16 @@ const (
 	OutputFlagName = "o"
 	// ContextFlagName is the name of the flag allowing a user to specify the location of the component settings
 	ContextFlagName = "context"
+	// VarFlagName is the name of the flag allowing a user to override the value of a variable of the devfile
+	VarFlagName = "var"
+	// VarFileFlagName is the name of the flag allowing a user to override the values of the variables of the devfile with a file
+	VarFileFlagName = "var-file"
+	// CacheFromFlagName is the name of the flag allowing a user to specify the external cache sources of the builds of the images
+	CacheFromFlagName = "cache-from"
+	// CacheToFlagName is the name of the flag allowing a user to specify the external destination of the build cache of the images
+	CacheToFlagName = "cache-to"
+	// PlatformFlagName is the name of the flag allowing a user to specify the target platforms of the images
+	PlatformFlagName = "platform"
 )
 
 // AddContextFlag adds `context` flag to given cobra command
@@ -36,3 +46,16 @@ func AddNowFlag(cmd *cobra.Command, setValueTo *bool) {
 		cmd.Flags().Bool("now", false, helpMessage)
 	}
 }
+
+// AddVariablesFlags adds `var` and `var-file` flags to given cobra command
+func AddVariablesFlags(cmd *cobra.Command, varsValueTo *[]string, varFileValueTo *string) {
+	cmd.Flags().StringArrayVar(varsValueTo, VarFlagName, nil, "Override the value of a variable of the devfile, as KEY=VALUE (can be used multiple times)")
+	cmd.Flags().StringVar(varFileValueTo, VarFileFlagName, "", "YAML file containing the values overriding the variables of the devfile")
+}
+
+// AddBuildOptionsFlags adds `cache-from`, `cache-to` and `platform` flags to given cobra command, for the builds of the images
+func AddBuildOptionsFlags(cmd *cobra.Command, cacheFromValueTo *[]string, cacheToValueTo *string, platformValueTo *[]string) {
+	cmd.Flags().StringArrayVar(cacheFromValueTo, CacheFromFlagName, nil, "External cache sources of the builds, for example an image in a registry (can be used multiple times)")
+	cmd.Flags().StringVar(cacheToValueTo, CacheToFlagName, "", "External destination of the build cache, for example an image in a registry")
+	cmd.Flags().StringSliceVar(platformValueTo, PlatformFlagName, nil, "Target platforms of the images, as os/arch[/variant] (comma separated or used multiple times)")
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GetVariables returns the values of the variables of the devfile defined by the file varFile, if not empty,
+// and by the KEY=VALUE pairs of vars, the values of vars overriding the values of the file.
+// The values of the file are kept as written, so that numbers are not reformatted (1000000 does not become 1e+06)
+func GetVariables(vars []string, varFile string) (map[string]string, error) {
+	result := map[string]string{}
+	if varFile != "" {
+		content, err := os.ReadFile(varFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the variables file: %w", err)
+		}
+		var values map[string]yaml.Node
+		err = yaml.Unmarshal(content, &values)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the variables file %q: %w", varFile, err)
+		}
+		for name, value := range values {
+			switch {
+			case value.Kind != yaml.ScalarNode:
+				return nil, fmt.Errorf("invalid value for the variable %q in the file %q: the value must be a string, a number or a boolean", name, varFile)
+			case value.Tag == "!!null":
+				result[name] = ""
+			default:
+				result[name] = value.Value
+			}
+		}
+	}
+	for _, v := range vars {
+		kv := strings.SplitN(v, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid variable %q, the variables must be defined as KEY=VALUE", v)
+		}
+		result[kv[0]] = kv[1]
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGetVariables(t *testing.T) {
+	varFile := filepath.Join(t.TempDir(), "vars.yaml")
+	err := os.WriteFile(varFile, []byte("REPLICAS: 3\nHOST: staging.example.com\nDEBUG: false\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	numbersFile := filepath.Join(t.TempDir(), "numbers.yaml")
+	err = os.WriteFile(numbersFile, []byte("MAX_SIZE: 1000000\nBIG: 12345678901234567890\nRATIO: 0.75\nVERSION: 1.10\nEMPTY:\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalidFile := filepath.Join(t.TempDir(), "invalid.yaml")
+	err = os.WriteFile(invalidFile, []byte("HOSTS:\n- a.example.com\n- b.example.com\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		vars    []string
+		varFile string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "no variable",
+			want: map[string]string{},
+		},
+		{
+			name: "variables from flags",
+			vars: []string{"REPLICAS=2", "URL=http://example.com/?a=b"},
+			want: map[string]string{"REPLICAS": "2", "URL": "http://example.com/?a=b"},
+		},
+		{
+			name:    "variables from file, overridden by flags",
+			vars:    []string{"REPLICAS=1"},
+			varFile: varFile,
+			want:    map[string]string{"REPLICAS": "1", "HOST": "staging.example.com", "DEBUG": "false"},
+		},
+		{
+			name:    "numbers from file, not reformatted",
+			varFile: numbersFile,
+			want:    map[string]string{"MAX_SIZE": "1000000", "BIG": "12345678901234567890", "RATIO": "0.75", "VERSION": "1.10", "EMPTY": ""},
+		},
+		{
+			name:    "list value in file",
+			varFile: invalidFile,
+			wantErr: true,
+		},
+		{
+			name:    "invalid variable",
+			vars:    []string{"REPLICAS"},
+			wantErr: true,
+		},
+		{
+			name:    "file not found",
+			varFile: filepath.Join(t.TempDir(), "missing.yaml"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetVariables(tt.vars, tt.varFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetVariables() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetVariables() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	githubRunner        = "ubuntu-latest"
+	githubCheckout      = "actions/checkout@v3"
+	githubRegistryLogin = "docker/login-action@v2"
+	// githubKubeconfigSecret is the name of the secret of the repository containing the Kubernetes configuration
+	githubKubeconfigSecret = "KUBECONFIG"
+	githubWorkspace        = "${{ github.workspace }}"
+)
+
+// githubActionsFormat renders a pipeline as a GitHub Actions workflow, running a single job with a step for each step of the pipeline.
+// The parameters of the pipeline are environment variables of the workflow
+type githubActionsFormat struct{}
+
+var _ format = githubActionsFormat{}
+
+type githubWorkflow struct {
+	Name string               `yaml:"name"`
+	On   githubTriggers       `yaml:"on"`
+	Env  yaml.Node            `yaml:"env,omitempty"`
+	Jobs map[string]githubJob `yaml:"jobs"`
+}
+
+type githubTriggers struct {
+	Push             githubPushTrigger `yaml:"push"`
+	WorkflowDispatch struct{}          `yaml:"workflow_dispatch"`
+}
+
+type githubPushTrigger struct {
+	Branches []string `yaml:"branches"`
+}
+
+type githubJob struct {
+	RunsOn string       `yaml:"runs-on"`
+	Steps  []githubStep `yaml:"steps"`
+}
+
+type githubStep struct {
+	Name string            `yaml:"name,omitempty"`
+	Uses string            `yaml:"uses,omitempty"`
+	With map[string]string `yaml:"with,omitempty"`
+	Env  map[string]string `yaml:"env,omitempty"`
+	Run  string            `yaml:"run,omitempty"`
+}
+
+func (o githubActionsFormat) reference(name string) string {
+	return fmt.Sprintf("${%s}", name)
+}
+
+func (o githubActionsFormat) render(p pipeline) ([]byte, error) {
+	// the environment variables are kept in the order of the parameters
+	env := yaml.Node{Kind: yaml.MappingNode}
+	var references []string
+	for _, param := range p.parameters {
+		env.Content = append(env.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: param.name},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: param.defaultValue, Style: yaml.DoubleQuotedStyle},
+		)
+		references = append(references, o.reference(param.name))
+	}
+	// the parameters are replaced in the manifests by envsubst, the other environment variables are kept
+	filter := ""
+	if len(references) > 0 {
+		filter = fmt.Sprintf("envsubst '%s'", strings.Join(references, " "))
+	}
+
+	steps := []githubStep{{Uses: githubCheckout}}
+	for _, registry := range p.registries {
+		steps = append(steps, githubStep{
+			Name: fmt.Sprintf("Log in to the registry ${{ env.%s }}", registry),
+			Uses: githubRegistryLogin,
+			With: map[string]string{
+				"registry": fmt.Sprintf("${{ env.%s }}", registry),
+				"username": fmt.Sprintf("${{ secrets.%s_USERNAME }}", registry),
+				"password": fmt.Sprintf("${{ secrets.%s_PASSWORD }}", registry),
+			},
+		})
+	}
+	steps = append(steps, githubStep{
+		Name: "Set up the Kubernetes configuration",
+		Env:  map[string]string{"KUBECONFIG_DATA": fmt.Sprintf("${{ secrets.%s }}", githubKubeconfigSecret)},
+		Run:  "mkdir -p \"$HOME/.kube\"\necho \"$KUBECONFIG_DATA\" > \"$HOME/.kube/config\"\n",
+	})
+
+	namespaceReference := ""
+	if p.namespace != "" {
+		namespaceReference = o.reference(p.namespace)
+	}
+	for _, s := range p.steps {
+		if s.image != nil {
+			steps = append(steps, githubStep{
+				Name: s.name,
+				Env: map[string]string{
+					"PROJECTS_ROOT":  githubWorkspace,
+					"PROJECT_SOURCE": githubWorkspace,
+				},
+				Run: getBuildScript("docker", s.image),
+			})
+			continue
+		}
+		steps = append(steps, githubStep{
+			Name: s.name,
+			Run:  getApplyScript(s.manifest, namespaceReference, filter),
+		})
+	}
+
+	definition := githubWorkflow{
+		Name: fmt.Sprintf("Deploy %s", p.name),
+		On: githubTriggers{
+			Push: githubPushTrigger{Branches: []string{"main"}},
+		},
+		Env: env,
+		Jobs: map[string]githubJob{
+			"deploy": {
+				RunsOn: githubRunner,
+				Steps:  steps,
+			},
+		},
+	}
+	return marshal(definition)
+}
@@ -0,0 +1,390 @@
+// Package pipeline generates the definitions of CI pipelines running the Deploy command of a devfile
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+const (
+	// TektonFormat is the format of a Tekton Pipeline
+	TektonFormat = "tekton"
+	// GitHubActionsFormat is the format of a GitHub Actions workflow
+	GitHubActionsFormat = "github-actions"
+)
+
+// NamespaceParameter is the name of the parameter of the pipeline defining the namespace the resources are applied to,
+// suffixed with a number if a variable of the devfile has the same name
+const NamespaceParameter = "NAMESPACE"
+
+// registryParameter is the name of the parameter of the pipeline defining the registry of the first image,
+// suffixed with a number for the other registries and if a variable of the devfile has the same name
+const registryParameter = "REGISTRY"
+
+// manifestDelimiter is the delimiter of the here-documents containing the Kubernetes manifests in the scripts of the steps
+const manifestDelimiter = "ODO_MANIFEST"
+
+var (
+	invalidParameterChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+	invalidResourceChars  = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// format renders a pipeline in the format of a CI system
+type format interface {
+	// reference returns the reference to the parameter of the pipeline with the given name, in the steps of the pipeline
+	reference(name string) string
+	// render returns the definition of the pipeline
+	render(p pipeline) ([]byte, error)
+}
+
+var formats = map[string]format{
+	TektonFormat:        tektonFormat{},
+	GitHubActionsFormat: githubActionsFormat{},
+}
+
+// Formats returns the names of the supported formats of pipelines
+func Formats() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parameter is a parameter of the pipeline, with its default value
+type parameter struct {
+	name         string
+	defaultValue string
+}
+
+// step is a step of the pipeline, building and pushing an image or applying a Kubernetes resource
+type step struct {
+	name string
+	// image is the image component built and pushed by the step, nil for a step applying a Kubernetes resource
+	image *v1alpha2.ImageComponent
+	// manifest is the Kubernetes resource applied by the step
+	manifest string
+}
+
+// pipeline is a pipeline running the steps of a Deploy command of a devfile
+type pipeline struct {
+	name       string
+	parameters []parameter
+	// registries are the names of the parameters defining the registries of the images
+	registries []string
+	// namespace is the name of the parameter defining the namespace the resources are applied to,
+	// or an empty string if the resources are applied to the current namespace of the Kubernetes configuration
+	namespace string
+	steps     []step
+}
+
+// Generate returns the definition of a pipeline in the format formatName, running the Deploy command
+// of the devfile named commandName, or the default Deploy command if commandName is empty.
+// The variables of the devfile, the registries of the images and the namespace are parameters of the pipeline.
+// The default values of the variables are the values of the variables in devfileObj,
+// the pipeline is generated from the devfile at devfilePath, with its variables replaced by the parameters.
+// The resources are applied to namespace by default, or to the current namespace of the Kubernetes configuration if empty
+func Generate(devfileObj parser.DevfileObj, devfilePath string, commandName string, namespace string, formatName string) ([]byte, error) {
+	f, ok := formats[formatName]
+	if !ok {
+		return nil, fmt.Errorf("unsupported pipeline format %q, supported formats are %v", formatName, Formats())
+	}
+
+	p := pipeline{}
+	references := map[string]string{}
+	variables := map[string]string{}
+	for _, name := range getSortedVariables(devfileObj) {
+		parameterName := getParameterName(name)
+		if other, found := variables[parameterName]; found {
+			return nil, fmt.Errorf("the variables %q and %q of the devfile are both defined by the parameter %q of the pipeline, please rename one of them", other, name, parameterName)
+		}
+		variables[parameterName] = name
+		p.parameters = append(p.parameters, parameter{
+			name:         parameterName,
+			defaultValue: devfileObj.Data.GetDevfileWorkspaceSpec().Variables[name],
+		})
+		references[name] = f.reference(parameterName)
+	}
+	parameterizedObj, err := devfile.ParseAndValidateFromFileWithVariables(devfilePath, references)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceReference := ""
+	if namespace != "" {
+		p.namespace = p.getUnusedParameterName(NamespaceParameter)
+		namespaceReference = f.reference(p.namespace)
+	}
+	handler, err := newPipelineHandler(parameterizedObj, filepath.Dir(devfilePath), namespaceReference)
+	if err != nil {
+		return nil, err
+	}
+	err = libdevfile.Deploy(parameterizedObj, commandName, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	command, err := libdevfile.GetDeployCommand(parameterizedObj, commandName)
+	if err != nil {
+		return nil, err
+	}
+	p.name = getResourceName(devfileObj.GetMetadataName() + "-" + command.Id)
+	p.steps = handler.steps
+	p.parameterizeRegistries(f)
+	if p.namespace != "" {
+		p.parameters = append(p.parameters, parameter{name: p.namespace, defaultValue: namespace})
+	}
+	return f.render(p)
+}
+
+// parameterizeRegistries replaces the registries of the images by parameters of the pipeline, with the registries as default values
+func (p *pipeline) parameterizeRegistries(f format) {
+	var registries []string
+	names := map[string]string{}
+	for _, s := range p.steps {
+		if s.image == nil {
+			continue
+		}
+		registry := getImageRegistry(s.image.ImageName)
+		if registry == "" || names[registry] != "" {
+			continue
+		}
+		name := p.getUnusedParameterName(registryParameter)
+		names[registry] = name
+		registries = append(registries, registry)
+		p.parameters = append(p.parameters, parameter{name: name, defaultValue: registry})
+		p.registries = append(p.registries, name)
+	}
+	// only the names of the built images are replaced in the manifests, the other references to the registries are kept
+	var replacements []imageReplacement
+	for i := range p.steps {
+		if p.steps[i].image == nil {
+			continue
+		}
+		registry := getImageRegistry(p.steps[i].image.ImageName)
+		if registry == "" {
+			continue
+		}
+		image := *p.steps[i].image.DeepCopy()
+		image.ImageName = f.reference(names[registry]) + "/" + strings.TrimPrefix(image.ImageName, registry+"/")
+		replacements = append(replacements, imageReplacement{
+			imageName:     p.steps[i].image.ImageName,
+			parameterized: image.ImageName,
+		})
+		p.steps[i].image = &image
+	}
+	for i := range p.steps {
+		for _, replacement := range replacements {
+			p.steps[i].manifest = replaceImageReferences(p.steps[i].manifest, replacement.imageName, replacement.parameterized)
+		}
+	}
+}
+
+// imageReplacement replaces the references to a built image in the manifests by the name of the image with its registry parameterized
+type imageReplacement struct {
+	imageName     string
+	parameterized string
+}
+
+// getUnusedParameterName returns name, suffixed with the first number from 2 giving a name not used by the parameters of the pipeline
+// if name is already used
+func (p *pipeline) getUnusedParameterName(name string) string {
+	used := map[string]bool{}
+	for _, param := range p.parameters {
+		used[param.name] = true
+	}
+	if !used[name] {
+		return name
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s_%d", name, i)
+		if !used[candidate] {
+			return candidate
+		}
+	}
+}
+
+// pipelineHandler collects the steps of the pipeline, in the order of the Deploy command
+type pipelineHandler struct {
+	devfileObj parser.DevfileObj
+	path       string
+	values     libdevfile.K8sRenderValues
+	// the handler is called concurrently by the parallel composite commands
+	mu    sync.Mutex
+	steps []step
+	names map[string]int
+}
+
+var _ libdevfile.Handler = (*pipelineHandler)(nil)
+
+func newPipelineHandler(devfileObj parser.DevfileObj, path string, namespace string) (*pipelineHandler, error) {
+	values, err := libdevfile.GetK8sRenderValues(devfileObj, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &pipelineHandler{
+		devfileObj: devfileObj,
+		path:       path,
+		values:     values,
+		names:      map[string]int{},
+	}, nil
+}
+
+// ApplyImage adds a step building and pushing the image
+func (o *pipelineHandler) ApplyImage(img v1alpha2.Component) error {
+	if img.Image == nil {
+		return fmt.Errorf("component %q is not an image component", img.Name)
+	}
+	if img.Image.Dockerfile == nil {
+		return fmt.Errorf("the image of the component %q is not built from a Dockerfile", img.Name)
+	}
+	if strings.HasPrefix(img.Image.Dockerfile.Uri, "http") {
+		return errors.New("HTTP URL for uri is not supported")
+	}
+	o.addStep("build-"+img.Name, img.Image, "")
+	return nil
+}
+
+// ApplyKubernetes adds a step applying the inlined Kubernetes resource, the resource referenced by its URI,
+// or a step for each resource rendered from the Helm chart or Kustomize directory referenced by the component
+func (o *pipelineHandler) ApplyKubernetes(kubernetes v1alpha2.Component) error {
+	components, err := libdevfile.RenderK8sComponents([]v1alpha2.Component{kubernetes}, o.path, devfilefs.DefaultFs{}, o.values)
+	if err != nil {
+		return err
+	}
+	for _, component := range components {
+		manifest, err := libdevfile.GetK8sComponentManifest(component.Kubernetes, o.path, devfilefs.DefaultFs{})
+		if err != nil {
+			return err
+		}
+		for name, image := range o.values.Images {
+			manifest = strings.ReplaceAll(manifest, "{{"+name+"}}", image)
+		}
+		o.addStep("apply-"+component.Name, nil, manifest)
+	}
+	return nil
+}
+
+// Execute returns an error, as the exec commands are not supported in the Deploy commands
+func (o *pipelineHandler) Execute(command v1alpha2.Command) error {
+	return errors.New("Exec command is not implemented for Deploy")
+}
+
+// addStep adds a step to the pipeline, with a unique name based on name
+func (o *pipelineHandler) addStep(name string, image *v1alpha2.ImageComponent, manifest string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	name = getResourceName(name)
+	o.names[name]++
+	if o.names[name] > 1 {
+		name = fmt.Sprintf("%s-%d", name, o.names[name])
+	}
+	o.steps = append(o.steps, step{name: name, image: image, manifest: manifest})
+}
+
+// getSortedVariables returns the names of the variables of the devfile, sorted
+func getSortedVariables(devfileObj parser.DevfileObj) []string {
+	variables := devfileObj.Data.GetDevfileWorkspaceSpec().Variables
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getParameterName returns the name of the parameter of the pipeline for the variable of the devfile,
+// usable as the name of an environment variable
+func getParameterName(variable string) string {
+	return invalidParameterChars.ReplaceAllString(variable, "_")
+}
+
+// replaceImageReferences replaces the references to the image imageName in the manifest by replacement,
+// when imageName is not part of a longer name. The references may be followed by a tag or digest
+func replaceImageReferences(manifest string, imageName string, replacement string) string {
+	var result strings.Builder
+	for {
+		i := strings.Index(manifest, imageName)
+		if i < 0 {
+			result.WriteString(manifest)
+			return result.String()
+		}
+		end := i + len(imageName)
+		if (i == 0 || !isImageNameChar(manifest[i-1])) && (end == len(manifest) || !isImageNameChar(manifest[end])) {
+			result.WriteString(manifest[:i])
+			result.WriteString(replacement)
+		} else {
+			result.WriteString(manifest[:end])
+		}
+		manifest = manifest[end:]
+	}
+}
+
+// isImageNameChar returns true if c can be part of the name of an image, before its tag or digest
+func isImageNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.IndexByte("._/-", c) >= 0
+}
+
+// getResourceName returns the name as a valid name of a Kubernetes resource or Tekton step
+func getResourceName(name string) string {
+	name = strings.Trim(invalidResourceChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
+
+// getImageRegistry returns the registry of the image, or an empty string if the name of the image does not start with a registry,
+// as for the images of Docker Hub
+func getImageRegistry(imageName string) string {
+	i := strings.Index(imageName, "/")
+	if i < 0 {
+		return ""
+	}
+	host := imageName[:i]
+	if strings.ContainsAny(host, ".:") || host == "localhost" {
+		return host
+	}
+	return ""
+}
+
+// getBuildScript returns the script building the image with the CLI cli, compatible with the docker CLI, and pushing it.
+// The paths are relative to the directory of the devfile
+func getBuildScript(cli string, image *v1alpha2.ImageComponent) string {
+	buildContext := image.Dockerfile.BuildContext
+	if buildContext == "" {
+		buildContext = "${PROJECT_SOURCE}"
+	}
+	build := fmt.Sprintf(`%s build -t "%s" -f "%s" %s`, cli, image.ImageName, image.Dockerfile.Uri, buildContext)
+	if len(image.Dockerfile.Args) > 0 {
+		build = build + " " + strings.Join(image.Dockerfile.Args, " ")
+	}
+	return fmt.Sprintf("%s\n%s push \"%s\"\n", build, cli, image.ImageName)
+}
+
+// getApplyScript returns the script applying the manifest with kubectl, to the namespace namespaceReference if not empty.
+// The manifest is filtered by filter if not empty
+func getApplyScript(manifest string, namespaceReference string, filter string) string {
+	apply := "kubectl apply"
+	if namespaceReference != "" {
+		apply = fmt.Sprintf(`%s -n "%s"`, apply, namespaceReference)
+	}
+	apply = apply + " -f -"
+	if filter != "" {
+		apply = filter + " | " + apply
+	}
+	return fmt.Sprintf("cat <<'%s' | %s\n%s\n%s\n", manifestDelimiter, apply, strings.TrimRight(manifest, "\n"), manifestDelimiter)
+}
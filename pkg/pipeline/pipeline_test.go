@@ -0,0 +1,197 @@
+package pipeline
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/odo/pkg/devfile"
+)
+
+const devfileWithDeploy = `schemaVersion: 2.2.0
+metadata:
+  name: my-app
+variables:
+  IMAGE_TAG: v1
+  REPLICAS: "1"
+components:
+  - name: runtime
+    container:
+      image: registry.access.redhat.com/ubi8/nodejs-14
+  - name: prod-image
+    image:
+      imageName: "quay.io/user/my-app:{{IMAGE_TAG}}"
+      dockerfile:
+        uri: ./Dockerfile
+        buildContext: ${PROJECT_SOURCE}
+  - name: deployment
+    kubernetes:
+      inlined: |
+        apiVersion: apps/v1
+        kind: Deployment
+        metadata:
+          name: my-app
+        spec:
+          replicas: {{REPLICAS}}
+          template:
+            spec:
+              containers:
+                - name: main
+                  image: "{{prod-image}}"
+                - name: sidecar
+                  image: quay.io/user/my-app-sidecar:v1
+commands:
+  - id: build-image
+    apply:
+      component: prod-image
+  - id: deploy-deployment
+    apply:
+      component: deployment
+  - id: deploy
+    composite:
+      commands:
+        - build-image
+        - deploy-deployment
+      group:
+        kind: deploy
+        isDefault: true
+`
+
+func TestGenerate(t *testing.T) {
+	devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+	err := os.WriteFile(devfilePath, []byte(devfileWithDeploy), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj, err := devfile.ParseAndValidateFromFile(devfilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		format    string
+		namespace string
+		// want are the expected parts of the definition, in this order
+		want    []string
+		notWant []string
+		wantErr bool
+	}{
+		{
+			name:      "tekton",
+			format:    TektonFormat,
+			namespace: "my-ns",
+			want: []string{
+				"kind: Pipeline",
+				"name: my-app-deploy",
+				"- name: IMAGE_TAG\n      type: string\n      default: v1",
+				"- name: REGISTRY\n      type: string\n      default: quay.io",
+				"- name: NAMESPACE\n      type: string\n      default: my-ns",
+				"workspaces:\n    - name: source\n    - name: dockerconfig\n      optional: true",
+				"- name: build-prod-image",
+				`export DOCKER_CONFIG="$(workspaces.dockerconfig.path)"`,
+				`buildah build -t "$(params.REGISTRY)/user/my-app:$(params.IMAGE_TAG)" -f "./Dockerfile" ${PROJECT_SOURCE}`,
+				`buildah push "$(params.REGISTRY)/user/my-app:$(params.IMAGE_TAG)"`,
+				"- name: apply-deployment",
+				`kubectl apply -n "$(params.NAMESPACE)" -f -`,
+				"replicas: $(params.REPLICAS)",
+				`image: "$(params.REGISTRY)/user/my-app:$(params.IMAGE_TAG)"`,
+				"image: quay.io/user/my-app-sidecar:v1",
+			},
+		},
+		{
+			name:   "github actions without namespace",
+			format: GitHubActionsFormat,
+			want: []string{
+				"name: Deploy my-app-deploy",
+				"env:\n  IMAGE_TAG: \"v1\"\n  REPLICAS: \"1\"\n  REGISTRY: \"quay.io\"\n",
+				"uses: actions/checkout@v3",
+				"username: ${{ secrets.REGISTRY_USERNAME }}",
+				"- name: build-prod-image",
+				`docker build -t "${REGISTRY}/user/my-app:${IMAGE_TAG}" -f "./Dockerfile" ${PROJECT_SOURCE}`,
+				`docker push "${REGISTRY}/user/my-app:${IMAGE_TAG}"`,
+				"- name: apply-deployment",
+				`envsubst '${IMAGE_TAG} ${REPLICAS} ${REGISTRY}' | kubectl apply -f -`,
+				"replicas: ${REPLICAS}",
+			},
+			notWant: []string{"NAMESPACE"},
+		},
+		{
+			name:    "unsupported format",
+			format:  "jenkins",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Generate(devfileObj, devfilePath, "", tt.namespace, tt.format)
+			checkDefinition(t, got, err, tt.want, tt.notWant, tt.wantErr)
+		})
+	}
+}
+
+func TestGenerateParameterNames(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables string
+		// want are the expected parts of the definition, in this order
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:      "variables named as the registry and namespace parameters",
+			variables: "  IMAGE_TAG: v1\n  REPLICAS: \"1\"\n  REGISTRY: my-registry\n  NAMESPACE: my-namespace\n",
+			want: []string{
+				"- name: NAMESPACE\n      type: string\n      default: my-namespace",
+				"- name: REGISTRY\n      type: string\n      default: my-registry",
+				"- name: REGISTRY_2\n      type: string\n      default: quay.io",
+				"- name: NAMESPACE_2\n      type: string\n      default: my-ns",
+				`buildah push "$(params.REGISTRY_2)/user/my-app:$(params.IMAGE_TAG)"`,
+				`kubectl apply -n "$(params.NAMESPACE_2)" -f -`,
+			},
+		},
+		{
+			name:      "variables with the same parameter name",
+			variables: "  IMAGE_TAG: v1\n  REPLICAS: \"1\"\n  a-b: \"x\"\n  a_b: \"z\"\n",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfilePath := filepath.Join(t.TempDir(), "devfile.yaml")
+			content := strings.Replace(devfileWithDeploy, "  IMAGE_TAG: v1\n  REPLICAS: \"1\"\n", tt.variables, 1)
+			err := os.WriteFile(devfilePath, []byte(content), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			devfileObj, err := devfile.ParseAndValidateFromFile(devfilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Generate(devfileObj, devfilePath, "", "my-ns", TektonFormat)
+			checkDefinition(t, got, err, tt.want, nil, tt.wantErr)
+		})
+	}
+}
+
+// checkDefinition checks that the parts want are found in the definition in this order, and that the parts notWant are not found
+func checkDefinition(t *testing.T, got []byte, err error, want []string, notWant []string, wantErr bool) {
+	t.Helper()
+	if (err != nil) != wantErr {
+		t.Fatalf("Generate() error = %v, wantErr %v", err, wantErr)
+	}
+	definition := string(got)
+	for _, want := range want {
+		i := strings.Index(definition, want)
+		if i < 0 {
+			t.Fatalf("%q not found in the definition, or not in the expected order:\n%s", want, got)
+		}
+		definition = definition[i+len(want):]
+	}
+	for _, notWant := range notWant {
+		if strings.Contains(string(got), notWant) {
+			t.Errorf("unexpected %q in the definition:\n%s", notWant, got)
+		}
+	}
+}
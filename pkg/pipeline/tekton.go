@@ -0,0 +1,182 @@
+package pipeline
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	tektonAPIVersion = "tekton.dev/v1beta1"
+	// tektonSourceWorkspace is the name of the workspace containing the sources of the component, with the devfile at its root
+	tektonSourceWorkspace = "source"
+	// tektonDockerConfigWorkspace is the name of the optional workspace containing the config.json file
+	// with the credentials of the registries the images are pushed to
+	tektonDockerConfigWorkspace = "dockerconfig"
+	tektonBuildImage            = "quay.io/buildah/stable"
+	tektonKubectlImage          = "bitnami/kubectl"
+)
+
+// tektonFormat renders a pipeline as a Tekton Pipeline, running a single task with a step for each step of the pipeline
+type tektonFormat struct{}
+
+var _ format = tektonFormat{}
+
+type tektonPipeline struct {
+	APIVersion string             `yaml:"apiVersion"`
+	Kind       string             `yaml:"kind"`
+	Metadata   tektonMetadata     `yaml:"metadata"`
+	Spec       tektonPipelineSpec `yaml:"spec"`
+}
+
+type tektonMetadata struct {
+	Name string `yaml:"name"`
+}
+
+type tektonPipelineSpec struct {
+	Params     []tektonParam     `yaml:"params,omitempty"`
+	Workspaces []tektonWorkspace `yaml:"workspaces"`
+	Tasks      []tektonTask      `yaml:"tasks"`
+}
+
+type tektonParam struct {
+	Name    string `yaml:"name"`
+	Type    string `yaml:"type,omitempty"`
+	Default string `yaml:"default,omitempty"`
+	Value   string `yaml:"value,omitempty"`
+}
+
+type tektonWorkspace struct {
+	Name      string `yaml:"name"`
+	Workspace string `yaml:"workspace,omitempty"`
+	Optional  bool   `yaml:"optional,omitempty"`
+}
+
+type tektonTask struct {
+	Name       string            `yaml:"name"`
+	Params     []tektonParam     `yaml:"params,omitempty"`
+	Workspaces []tektonWorkspace `yaml:"workspaces"`
+	TaskSpec   tektonTaskSpec    `yaml:"taskSpec"`
+}
+
+type tektonTaskSpec struct {
+	Params     []tektonParam     `yaml:"params,omitempty"`
+	Workspaces []tektonWorkspace `yaml:"workspaces"`
+	Steps      []tektonStep      `yaml:"steps"`
+}
+
+type tektonStep struct {
+	Name            string                 `yaml:"name"`
+	Image           string                 `yaml:"image"`
+	WorkingDir      string                 `yaml:"workingDir"`
+	Env             []tektonEnv            `yaml:"env,omitempty"`
+	SecurityContext *tektonSecurityContext `yaml:"securityContext,omitempty"`
+	Script          string                 `yaml:"script"`
+}
+
+type tektonEnv struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+type tektonSecurityContext struct {
+	Privileged bool `yaml:"privileged"`
+}
+
+func (o tektonFormat) reference(name string) string {
+	return fmt.Sprintf("$(params.%s)", name)
+}
+
+func (o tektonFormat) render(p pipeline) ([]byte, error) {
+	sourcePath := fmt.Sprintf("$(workspaces.%s.path)", tektonSourceWorkspace)
+
+	var pipelineParams, taskParams, taskSpecParams []tektonParam
+	for _, param := range p.parameters {
+		pipelineParams = append(pipelineParams, tektonParam{Name: param.name, Type: "string", Default: param.defaultValue})
+		taskParams = append(taskParams, tektonParam{Name: param.name, Value: o.reference(param.name)})
+		taskSpecParams = append(taskSpecParams, tektonParam{Name: param.name, Type: "string"})
+	}
+
+	namespaceReference := ""
+	if p.namespace != "" {
+		namespaceReference = o.reference(p.namespace)
+	}
+	// buildah reads the credentials of the registries from the config.json file in DOCKER_CONFIG, when the workspace is bound
+	dockerConfig := fmt.Sprintf("if [ \"$(workspaces.%s.bound)\" = \"true\" ]; then\n  export DOCKER_CONFIG=\"$(workspaces.%[1]s.path)\"\nfi\n", tektonDockerConfigWorkspace)
+
+	var steps []tektonStep
+	withImages := false
+	for _, s := range p.steps {
+		if s.image != nil {
+			withImages = true
+			steps = append(steps, tektonStep{
+				Name:       s.name,
+				Image:      tektonBuildImage,
+				WorkingDir: sourcePath,
+				Env: []tektonEnv{
+					{Name: "PROJECTS_ROOT", Value: sourcePath},
+					{Name: "PROJECT_SOURCE", Value: sourcePath},
+				},
+				// buildah needs a privileged container to build the images
+				SecurityContext: &tektonSecurityContext{Privileged: true},
+				Script:          dockerConfig + getBuildScript("buildah", s.image),
+			})
+			continue
+		}
+		steps = append(steps, tektonStep{
+			Name:       s.name,
+			Image:      tektonKubectlImage,
+			WorkingDir: sourcePath,
+			Script:     getApplyScript(s.manifest, namespaceReference, ""),
+		})
+	}
+
+	pipelineWorkspaces := []tektonWorkspace{{Name: tektonSourceWorkspace}}
+	taskWorkspaces := []tektonWorkspace{{Name: tektonSourceWorkspace, Workspace: tektonSourceWorkspace}}
+	taskSpecWorkspaces := []tektonWorkspace{{Name: tektonSourceWorkspace}}
+	if withImages {
+		pipelineWorkspaces = append(pipelineWorkspaces, tektonWorkspace{Name: tektonDockerConfigWorkspace, Optional: true})
+		taskWorkspaces = append(taskWorkspaces, tektonWorkspace{Name: tektonDockerConfigWorkspace, Workspace: tektonDockerConfigWorkspace})
+		taskSpecWorkspaces = append(taskSpecWorkspaces, tektonWorkspace{Name: tektonDockerConfigWorkspace, Optional: true})
+	}
+
+	definition := tektonPipeline{
+		APIVersion: tektonAPIVersion,
+		Kind:       "Pipeline",
+		Metadata:   tektonMetadata{Name: p.name},
+		Spec: tektonPipelineSpec{
+			Params:     pipelineParams,
+			Workspaces: pipelineWorkspaces,
+			Tasks: []tektonTask{
+				{
+					Name:       "deploy",
+					Params:     taskParams,
+					Workspaces: taskWorkspaces,
+					TaskSpec: tektonTaskSpec{
+						Params:     taskSpecParams,
+						Workspaces: taskSpecWorkspaces,
+						Steps:      steps,
+					},
+				},
+			},
+		},
+	}
+	return marshal(definition)
+}
+
+// marshal returns the YAML definition of the pipeline, indented with 2 spaces
+func marshal(definition interface{}) ([]byte, error) {
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	err := encoder.Encode(definition)
+	if err != nil {
+		return nil, err
+	}
+	err = encoder.Close()
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
@@ -31,6 +31,9 @@ type odoSettings struct {
 	// PushTimeout for OpenShift pod timeout check
 	PushTimeout *int `yaml:"PushTimeout,omitempty"`
 
+	// DeployTimeout for the resources applied by odo deploy to be ready
+	DeployTimeout *int `yaml:"DeployTimeout,omitempty"`
+
 	// RegistryList for telling odo to connect to all the registries in the registry list
 	RegistryList *[]Registry `yaml:"RegistryList,omitempty"`
 
@@ -42,6 +45,15 @@ type odoSettings struct {
 
 	// ConsentTelemetry if true collects telemetry for odo
 	ConsentTelemetry *bool `yaml:"ConsentTelemetry,omitempty"`
+
+	// StorageClass of the PVCs created for the devfile volumes
+	StorageClass *string `yaml:"StorageClass,omitempty"`
+
+	// VolumeAccessMode of the PVCs created for the devfile volumes
+	VolumeAccessMode *string `yaml:"VolumeAccessMode,omitempty"`
+
+	// DependencyCache if true mounts the dependency cache volume in the containers of the components
+	DependencyCache *bool `yaml:"DependencyCache,omitempty"`
 }
 
 // Registry includes the registry metadata
@@ -272,6 +284,16 @@ func (c *preferenceInfo) SetConfiguration(parameter string, value string) error
 			}
 			c.OdoSettings.PushTimeout = &typedval
 
+		case "deploytimeout":
+			typedval, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("unable to set %q to %q, value must be an integer", parameter, value)
+			}
+			if typedval < 0 {
+				return errors.New("cannot set timeout to less than 0")
+			}
+			c.OdoSettings.DeployTimeout = &typedval
+
 		case "registrycachetime":
 			typedval, err := strconv.Atoi(value)
 			if err != nil {
@@ -302,6 +324,22 @@ func (c *preferenceInfo) SetConfiguration(parameter string, value string) error
 				return fmt.Errorf("unable to set %q to %q, value must be a boolean", parameter, value)
 			}
 			c.OdoSettings.ConsentTelemetry = &val
+
+		case "storageclass":
+			c.OdoSettings.StorageClass = &value
+
+		case "volumeaccessmode":
+			if !isValidAccessMode(value) {
+				return fmt.Errorf("unable to set %q to %q, value must be one of %s", parameter, value, strings.Join(accessModes, ", "))
+			}
+			c.OdoSettings.VolumeAccessMode = &value
+
+		case "dependencycache":
+			val, err := strconv.ParseBool(strings.ToLower(value))
+			if err != nil {
+				return fmt.Errorf("unable to set %q to %q, value must be a boolean", parameter, value)
+			}
+			c.OdoSettings.DependencyCache = &val
 		}
 	} else {
 		return fmt.Errorf("unknown parameter : %q is not a parameter in odo preference, run `odo preference -h` to see list of available parameters", parameter)
@@ -351,6 +389,11 @@ func (c *preferenceInfo) GetPushTimeout() int {
 	return util.GetIntOrDefault(c.OdoSettings.PushTimeout, DefaultPushTimeout)
 }
 
+// GetDeployTimeout gets the value set by DeployTimeout
+func (c *preferenceInfo) GetDeployTimeout() int {
+	return util.GetIntOrDefault(c.OdoSettings.DeployTimeout, DefaultDeployTimeout)
+}
+
 // GetRegistryCacheTime gets the value set by RegistryCacheTime
 func (c *preferenceInfo) GetRegistryCacheTime() int {
 	return util.GetIntOrDefault(c.OdoSettings.RegistryCacheTime, DefaultRegistryCacheTime)
@@ -375,6 +418,24 @@ func (c *preferenceInfo) GetConsentTelemetry() bool {
 	return util.GetBoolOrDefault(c.OdoSettings.ConsentTelemetry, DefaultConsentTelemetrySetting)
 }
 
+// GetStorageClass returns the value of StorageClass from preferences
+// and if absent then returns an empty string, to use the default StorageClass of the cluster
+func (c *preferenceInfo) GetStorageClass() string {
+	return util.GetStringOrDefault(c.OdoSettings.StorageClass, "")
+}
+
+// GetVolumeAccessMode returns the value of VolumeAccessMode from preferences
+// and if absent then returns default
+func (c *preferenceInfo) GetVolumeAccessMode() string {
+	return util.GetStringOrDefault(c.OdoSettings.VolumeAccessMode, DefaultVolumeAccessModeSetting)
+}
+
+// GetDependencyCache returns the value of DependencyCache from preferences
+// and if absent then returns default
+func (c *preferenceInfo) GetDependencyCache() bool {
+	return util.GetBoolOrDefault(c.OdoSettings.DependencyCache, DefaultDependencyCacheSetting)
+}
+
 // GetEphemeral returns the value of Ephemeral from preferences
 // and if absent then returns default
 // default value: true, ephemeral is enabled by default
@@ -398,6 +459,10 @@ func (c *preferenceInfo) PushTimeout() *int {
 	return c.OdoSettings.PushTimeout
 }
 
+func (c *preferenceInfo) DeployTimeout() *int {
+	return c.OdoSettings.DeployTimeout
+}
+
 func (c *preferenceInfo) RegistryCacheTime() *int {
 	return c.OdoSettings.RegistryCacheTime
 }
@@ -410,6 +475,18 @@ func (c *preferenceInfo) ConsentTelemetry() *bool {
 	return c.OdoSettings.ConsentTelemetry
 }
 
+func (c *preferenceInfo) StorageClass() *string {
+	return c.OdoSettings.StorageClass
+}
+
+func (c *preferenceInfo) VolumeAccessMode() *string {
+	return c.OdoSettings.VolumeAccessMode
+}
+
+func (c *preferenceInfo) DependencyCache() *bool {
+	return c.OdoSettings.DependencyCache
+}
+
 func (c *preferenceInfo) RegistryList() *[]Registry {
 	return c.OdoSettings.RegistryList
 }
@@ -437,6 +514,18 @@ func asSupportedParameter(param string) (string, bool) {
 	return lower, lowerCaseParameters[lower]
 }
 
+// accessModes are the access modes supported by Kubernetes for the PVCs
+var accessModes = []string{"ReadWriteOnce", "ReadOnlyMany", "ReadWriteMany", "ReadWriteOncePod"}
+
+func isValidAccessMode(mode string) bool {
+	for _, m := range accessModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
 // GetSupportedParameters returns the name of the supported parameters
 func GetSupportedParameters() []string {
 	return dfutil.GetSortedKeys(supportedParameterDescriptions)
@@ -365,6 +365,59 @@ func TestSetConfiguration(t *testing.T) {
 			wantErr: false,
 			want:    false,
 		},
+		{
+			name:           fmt.Sprintf("Case 20: set %s", StorageClassSetting),
+			parameter:      StorageClassSetting,
+			value:          "nfs-client",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "nfs-client",
+		},
+		{
+			name:           fmt.Sprintf("Case 21: set %s to a valid access mode", VolumeAccessModeSetting),
+			parameter:      VolumeAccessModeSetting,
+			value:          "ReadWriteMany",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           "ReadWriteMany",
+		},
+		{
+			name:           fmt.Sprintf("Case 22: set %s to an invalid access mode", VolumeAccessModeSetting),
+			parameter:      VolumeAccessModeSetting,
+			value:          "ReadWriteSometimes",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("Case 23: set %s from nil to true", DependencyCacheSetting),
+			parameter:      DependencyCacheSetting,
+			value:          "true",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           true,
+		},
+		{
+			name:           fmt.Sprintf("Case 24: set %s to non bool value", DependencyCacheSetting),
+			parameter:      DependencyCacheSetting,
+			value:          "yes please",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("Case 25: set %s to 600", DeployTimeoutSetting),
+			parameter:      DeployTimeoutSetting,
+			value:          "600",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           600,
+		},
+		{
+			name:           fmt.Sprintf("Case 26: set %s to a negative value", DeployTimeoutSetting),
+			parameter:      DeployTimeoutSetting,
+			value:          "-1",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -392,6 +445,22 @@ func TestSetConfiguration(t *testing.T) {
 					if *cfg.OdoSettings.RegistryCacheTime != tt.want {
 						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %d\n", *cfg.OdoSettings.RegistryCacheTime, tt.want)
 					}
+				case StorageClassSetting:
+					if *cfg.OdoSettings.StorageClass != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.StorageClass, tt.want)
+					}
+				case DeployTimeoutSetting:
+					if *cfg.OdoSettings.DeployTimeout != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.DeployTimeout, tt.want)
+					}
+				case DependencyCacheSetting:
+					if *cfg.OdoSettings.DependencyCache != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.DependencyCache, tt.want)
+					}
+				case VolumeAccessModeSetting:
+					if *cfg.OdoSettings.VolumeAccessMode != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.VolumeAccessMode, tt.want)
+					}
 				}
 			} else if tt.wantErr && err != nil {
 				// negative cases
@@ -58,6 +58,13 @@ func toPreferenceItems(prefInfo preferenceInfo) []PreferenceItem {
 			Type:        getType(prefInfo.GetPushTimeout()),
 			Description: PushTimeoutSettingDescription,
 		},
+		{
+			Name:        DeployTimeoutSetting,
+			Value:       settings.DeployTimeout,
+			Default:     DefaultDeployTimeout,
+			Type:        getType(prefInfo.GetDeployTimeout()),
+			Description: DeployTimeoutSettingDescription,
+		},
 		{
 			Name:        RegistryCacheTimeSetting,
 			Value:       settings.RegistryCacheTime,
@@ -79,6 +86,27 @@ func toPreferenceItems(prefInfo preferenceInfo) []PreferenceItem {
 			Type:        getType(prefInfo.GetEphemeral()),
 			Description: EphemeralSettingDescription,
 		},
+		{
+			Name:        StorageClassSetting,
+			Value:       settings.StorageClass,
+			Default:     "",
+			Type:        getType(prefInfo.GetStorageClass()),
+			Description: StorageClassSettingDescription,
+		},
+		{
+			Name:        VolumeAccessModeSetting,
+			Value:       settings.VolumeAccessMode,
+			Default:     DefaultVolumeAccessModeSetting,
+			Type:        getType(prefInfo.GetVolumeAccessMode()),
+			Description: VolumeAccessModeSettingDescription,
+		},
+		{
+			Name:        DependencyCacheSetting,
+			Value:       settings.DependencyCache,
+			Default:     DefaultDependencyCacheSetting,
+			Type:        getType(prefInfo.GetDependencyCache()),
+			Description: DependencyCacheSettingDescription,
+		},
 	}
 }
 
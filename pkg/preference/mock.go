@@ -61,6 +61,34 @@ func (mr *MockClientMockRecorder) DeleteConfiguration(parameter interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfiguration", reflect.TypeOf((*MockClient)(nil).DeleteConfiguration), parameter)
 }
 
+// DependencyCache mocks base method.
+func (m *MockClient) DependencyCache() *bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DependencyCache")
+	ret0, _ := ret[0].(*bool)
+	return ret0
+}
+
+// DependencyCache indicates an expected call of DependencyCache.
+func (mr *MockClientMockRecorder) DependencyCache() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DependencyCache", reflect.TypeOf((*MockClient)(nil).DependencyCache))
+}
+
+// DeployTimeout mocks base method.
+func (m *MockClient) DeployTimeout() *int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployTimeout")
+	ret0, _ := ret[0].(*int)
+	return ret0
+}
+
+// DeployTimeout indicates an expected call of DeployTimeout.
+func (mr *MockClientMockRecorder) DeployTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTimeout", reflect.TypeOf((*MockClient)(nil).DeployTimeout))
+}
+
 // EphemeralSourceVolume mocks base method.
 func (m *MockClient) EphemeralSourceVolume() *bool {
 	m.ctrl.T.Helper()
@@ -89,6 +117,34 @@ func (mr *MockClientMockRecorder) GetConsentTelemetry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsentTelemetry", reflect.TypeOf((*MockClient)(nil).GetConsentTelemetry))
 }
 
+// GetDependencyCache mocks base method.
+func (m *MockClient) GetDependencyCache() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependencyCache")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetDependencyCache indicates an expected call of GetDependencyCache.
+func (mr *MockClientMockRecorder) GetDependencyCache() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyCache", reflect.TypeOf((*MockClient)(nil).GetDependencyCache))
+}
+
+// GetDeployTimeout mocks base method.
+func (m *MockClient) GetDeployTimeout() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeployTimeout")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetDeployTimeout indicates an expected call of GetDeployTimeout.
+func (mr *MockClientMockRecorder) GetDeployTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployTimeout", reflect.TypeOf((*MockClient)(nil).GetDeployTimeout))
+}
+
 // GetEphemeralSourceVolume mocks base method.
 func (m *MockClient) GetEphemeralSourceVolume() bool {
 	m.ctrl.T.Helper()
@@ -131,6 +187,20 @@ func (mr *MockClientMockRecorder) GetRegistryCacheTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryCacheTime", reflect.TypeOf((*MockClient)(nil).GetRegistryCacheTime))
 }
 
+// GetStorageClass mocks base method.
+func (m *MockClient) GetStorageClass() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageClass")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetStorageClass indicates an expected call of GetStorageClass.
+func (mr *MockClientMockRecorder) GetStorageClass() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageClass", reflect.TypeOf((*MockClient)(nil).GetStorageClass))
+}
+
 // GetTimeout mocks base method.
 func (m *MockClient) GetTimeout() int {
 	m.ctrl.T.Helper()
@@ -159,6 +229,20 @@ func (mr *MockClientMockRecorder) GetUpdateNotification() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateNotification", reflect.TypeOf((*MockClient)(nil).GetUpdateNotification))
 }
 
+// GetVolumeAccessMode mocks base method.
+func (m *MockClient) GetVolumeAccessMode() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeAccessMode")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetVolumeAccessMode indicates an expected call of GetVolumeAccessMode.
+func (mr *MockClientMockRecorder) GetVolumeAccessMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeAccessMode", reflect.TypeOf((*MockClient)(nil).GetVolumeAccessMode))
+}
+
 // IsSet mocks base method.
 func (m *MockClient) IsSet(parameter string) bool {
 	m.ctrl.T.Helper()
@@ -271,6 +355,20 @@ func (mr *MockClientMockRecorder) SetConfiguration(parameter, value interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfiguration", reflect.TypeOf((*MockClient)(nil).SetConfiguration), parameter, value)
 }
 
+// StorageClass mocks base method.
+func (m *MockClient) StorageClass() *string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorageClass")
+	ret0, _ := ret[0].(*string)
+	return ret0
+}
+
+// StorageClass indicates an expected call of StorageClass.
+func (mr *MockClientMockRecorder) StorageClass() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageClass", reflect.TypeOf((*MockClient)(nil).StorageClass))
+}
+
 // Timeout mocks base method.
 func (m *MockClient) Timeout() *int {
 	m.ctrl.T.Helper()
@@ -298,3 +396,17 @@ func (mr *MockClientMockRecorder) UpdateNotification() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotification", reflect.TypeOf((*MockClient)(nil).UpdateNotification))
 }
+
+// VolumeAccessMode mocks base method.
+func (m *MockClient) VolumeAccessMode() *string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeAccessMode")
+	ret0, _ := ret[0].(*string)
+	return ret0
+}
+
+// VolumeAccessMode indicates an expected call of VolumeAccessMode.
+func (mr *MockClientMockRecorder) VolumeAccessMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeAccessMode", reflect.TypeOf((*MockClient)(nil).VolumeAccessMode))
+}
@@ -8,17 +8,25 @@ type Client interface {
 	GetUpdateNotification() bool
 	GetTimeout() int
 	GetPushTimeout() int
+	GetDeployTimeout() int
 	GetEphemeralSourceVolume() bool
 	GetConsentTelemetry() bool
 	GetRegistryCacheTime() int
+	GetStorageClass() string
+	GetVolumeAccessMode() string
+	GetDependencyCache() bool
 	RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool) error
 
 	UpdateNotification() *bool
 	Timeout() *int
 	PushTimeout() *int
+	DeployTimeout() *int
 	RegistryCacheTime() *int
 	EphemeralSourceVolume() *bool
 	ConsentTelemetry() *bool
+	StorageClass() *string
+	VolumeAccessMode() *string
+	DependencyCache() *bool
 	RegistryList() *[]Registry
 	RegistryNameExists(name string) bool
 
@@ -19,6 +19,9 @@ const (
 	// DefaultPushTimeout is the default timeout for pods (in seconds)
 	DefaultPushTimeout = 240
 
+	// DefaultDeployTimeout is the default timeout for the resources applied by odo deploy to be ready (in seconds)
+	DefaultDeployTimeout = 300
+
 	// UpdateNotificationSetting is the name of the setting controlling update notification
 	UpdateNotificationSetting = "UpdateNotification"
 
@@ -31,6 +34,9 @@ const (
 	// PushTimeoutSetting is the name of the setting controlling PushTimeout
 	PushTimeoutSetting = "PushTimeout"
 
+	// DeployTimeoutSetting is the name of the setting controlling DeployTimeout
+	DeployTimeoutSetting = "DeployTimeout"
+
 	// RegistryCacheTimeSetting is human-readable description for the registrycachetime setting
 	RegistryCacheTimeSetting = "RegistryCacheTime"
 
@@ -57,6 +63,24 @@ const (
 
 	// DefaultConsentTelemetry is a default value for ConsentTelemetry preference
 	DefaultConsentTelemetrySetting = false
+
+	// StorageClassSetting specifies the StorageClass of the PVCs created for the devfile volumes
+	StorageClassSetting = "StorageClass"
+
+	// StorageClassSettingDescription adds a description for StorageClass
+	StorageClassSettingDescription = "StorageClass of the PVCs created for the Devfile volumes, overridden by the dev.odo.storage-class attribute of a volume (Default: the default StorageClass of the cluster)"
+
+	// VolumeAccessModeSetting specifies the access mode of the PVCs created for the devfile volumes
+	VolumeAccessModeSetting = "VolumeAccessMode"
+
+	// DefaultVolumeAccessModeSetting is a default value for VolumeAccessMode preference
+	DefaultVolumeAccessModeSetting = "ReadWriteOnce"
+
+	// DependencyCacheSetting specifies if the dependency cache volume is mounted in the containers of the components
+	DependencyCacheSetting = "DependencyCache"
+
+	// DefaultDependencyCacheSetting is a default value for DependencyCache preference
+	DefaultDependencyCacheSetting = false
 )
 
 // TimeoutSettingDescription is human-readable description for the timeout setting
@@ -65,6 +89,9 @@ var TimeoutSettingDescription = fmt.Sprintf("Timeout (in seconds) for OpenShift
 // PushTimeoutSettingDescription adds a description for PushTimeout
 var PushTimeoutSettingDescription = fmt.Sprintf("PushTimeout (in seconds) for waiting for a Pod to come up (Default: %d)", DefaultPushTimeout)
 
+// DeployTimeoutSettingDescription adds a description for DeployTimeout
+var DeployTimeoutSettingDescription = fmt.Sprintf("DeployTimeout (in seconds) for waiting for the resources applied by odo deploy to be ready (Default: %d)", DefaultDeployTimeout)
+
 // RegistryCacheTimeSettingDescription adds a description for RegistryCacheTime
 var RegistryCacheTimeSettingDescription = fmt.Sprintf("For how long (in minutes) odo will cache information from the Devfile registry (Default: %d)", DefaultRegistryCacheTime)
 
@@ -74,6 +101,12 @@ var EphemeralSettingDescription = fmt.Sprintf("If true, odo will create an empty
 // ConsentTelemetrySettingDescription adds a description for TelemetryConsentSetting
 var ConsentTelemetrySettingDescription = fmt.Sprintf("If true, odo will collect telemetry for the user's odo usage (Default: %t)\n\t\t    For more information: https://developers.redhat.com/article/tool-data-collection", DefaultConsentTelemetrySetting)
 
+// VolumeAccessModeSettingDescription adds a description for VolumeAccessMode
+var VolumeAccessModeSettingDescription = fmt.Sprintf("Access mode of the PVCs created for the Devfile volumes, overridden by the dev.odo.access-modes attribute of a volume (Default: %s)", DefaultVolumeAccessModeSetting)
+
+// DependencyCacheSettingDescription adds a description for DependencyCache
+var DependencyCacheSettingDescription = fmt.Sprintf("If true, odo will mount a dependency cache volume shared by the components of the namespace, based on the language of the Devfile (Default: %t)", DefaultDependencyCacheSetting)
+
 // This value can be provided to set a seperate directory for users 'homedir' resolution
 // note for mocking purpose ONLY
 var customHomeDir = os.Getenv("CUSTOM_HOMEDIR")
@@ -84,9 +117,13 @@ var (
 		UpdateNotificationSetting: UpdateNotificationSettingDescription,
 		TimeoutSetting:            TimeoutSettingDescription,
 		PushTimeoutSetting:        PushTimeoutSettingDescription,
+		DeployTimeoutSetting:      DeployTimeoutSettingDescription,
 		RegistryCacheTimeSetting:  RegistryCacheTimeSettingDescription,
 		EphemeralSetting:          EphemeralSettingDescription,
 		ConsentTelemetrySetting:   ConsentTelemetrySettingDescription,
+		StorageClassSetting:       StorageClassSettingDescription,
+		VolumeAccessModeSetting:   VolumeAccessModeSettingDescription,
+		DependencyCacheSetting:    DependencyCacheSettingDescription,
 	}
 
 	// set-like map to quickly check if a parameter is supported
@@ -0,0 +1,129 @@
+package registry
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// GetCacheStatus returns the status of the cached index of the registry with the given name,
+// or of all the registries if registryName is empty.
+// The reachability of each registry is checked without using nor modifying the cache.
+// A registry not reachable does not make the function fail, the error is reported in the status of the registry
+func (o RegistryClient) GetCacheStatus(registryName string) ([]RegistryCacheStatus, error) {
+	return o.forEachRegistry(registryName, func(registry Registry) RegistryCacheStatus {
+		stacks, err := fetchRegistryStacks(o.preferenceClient, registry, false)
+		return getCacheStatus(o.fsys, registry, stacks, err)
+	})
+}
+
+// RefreshCache downloads again the index of the registry with the given name,
+// or of all the registries if registryName is empty, and stores it in the cache.
+// A registry failing to refresh does not make the function fail, the error is reported in the status of the registry
+func (o RegistryClient) RefreshCache(registryName string) ([]RegistryCacheStatus, error) {
+	return o.forEachRegistry(registryName, func(registry Registry) RegistryCacheStatus {
+		err := cleanRegistryCache(registry)
+		if err != nil {
+			return getCacheStatus(o.fsys, registry, nil, err)
+		}
+		stacks, err := fetchRegistryStacks(o.preferenceClient, registry, true)
+		return getCacheStatus(o.fsys, registry, stacks, err)
+	})
+}
+
+// CleanCache removes the cached index of the registry with the given name,
+// or the whole cache if registryName is empty
+func (o RegistryClient) CleanCache(registryName string) error {
+	if registryName == "" {
+		err := util.CleanDefaultHTTPCacheDir()
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	registries, err := o.getRegistries(registryName)
+	if err != nil {
+		return err
+	}
+	return cleanRegistryCache(registries[0])
+}
+
+// getRegistries returns the registry with the given name, or all the registries if registryName is empty
+func (o RegistryClient) getRegistries(registryName string) ([]Registry, error) {
+	registries, err := o.GetDevfileRegistries(registryName)
+	if err != nil {
+		return nil, err
+	}
+	if len(registries) == 0 {
+		if registryName != "" {
+			return nil, fmt.Errorf("registry %q not found in the list of devfile registries", registryName)
+		}
+		return nil, fmt.Errorf("no devfile registries added to the configuration")
+	}
+	return registries, nil
+}
+
+// forEachRegistry runs fn concurrently for the registry with the given name, or for all the registries if registryName is empty,
+// and returns the statuses in the order of the registries
+func (o RegistryClient) forEachRegistry(registryName string, fn func(Registry) RegistryCacheStatus) ([]RegistryCacheStatus, error) {
+	registries, err := o.getRegistries(registryName)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]RegistryCacheStatus, len(registries))
+	var wg sync.WaitGroup
+	for i, reg := range registries {
+		wg.Add(1)
+		go func(i int, registry Registry) {
+			defer wg.Done()
+			statuses[i] = fn(registry)
+		}(i, reg)
+	}
+	wg.Wait()
+	return statuses, nil
+}
+
+// cleanRegistryCache removes the cached index of a registry. Only the indexes of Github-based registries are cached
+func cleanRegistryCache(registry Registry) error {
+	if !isGithubRegistry(registry) {
+		return nil
+	}
+	indexURL, err := getIndexURL(registry)
+	if err != nil {
+		return err
+	}
+	return util.CleanHTTPCacheFile(indexURL)
+}
+
+// getCacheStatus returns the status of the cached index of a registry,
+// given the result of the download of its index
+func getCacheStatus(fsys filesystem.Filesystem, registry Registry, stacks []DevfileStack, downloadErr error) RegistryCacheStatus {
+	status := RegistryCacheStatus{
+		Name:      registry.Name,
+		URL:       registry.URL,
+		Reachable: downloadErr == nil,
+		Stacks:    len(stacks),
+	}
+	if downloadErr != nil {
+		status.Error = downloadErr.Error()
+	}
+	if !isGithubRegistry(registry) {
+		return status
+	}
+	indexURL, err := getIndexURL(registry)
+	if err != nil {
+		return status
+	}
+	info, err := fsys.Stat(util.GetHTTPCacheFile(indexURL))
+	if err != nil {
+		return status
+	}
+	lastUpdate := info.ModTime()
+	status.Cached = true
+	status.LastUpdate = &lastUpdate
+	status.Size = info.Size()
+	return status
+}
@@ -0,0 +1,121 @@
+package registry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func TestRegistryClient_GetCacheStatus(t *testing.T) {
+	// Start a local HTTP server serving the index of an OCI-based registry
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, err := rw.Write([]byte(`[{"name": "nodejs", "language": "nodejs"}, {"name": "java-maven", "language": "java"}]`))
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	// A server closed immediately, for an unreachable registry
+	downServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+	downServer.Close()
+
+	tests := []struct {
+		name          string
+		registryName  string
+		registryList  []preference.Registry
+		wantErr       bool
+		wantReachable map[string]bool
+		wantStacks    map[string]int
+	}{
+		{
+			name: "all registries, one registry down",
+			registryList: []preference.Registry{
+				{Name: "up", URL: server.URL},
+				{Name: "down", URL: downServer.URL},
+			},
+			wantReachable: map[string]bool{"up": true, "down": false},
+			wantStacks:    map[string]int{"up": 2, "down": 0},
+		},
+		{
+			name:         "specific registry",
+			registryName: "up",
+			registryList: []preference.Registry{
+				{Name: "up", URL: server.URL},
+				{Name: "down", URL: downServer.URL},
+			},
+			wantReachable: map[string]bool{"up": true},
+			wantStacks:    map[string]int{"up": 2},
+		},
+		{
+			name:         "non existing registry",
+			registryName: "unknown",
+			registryList: []preference.Registry{
+				{Name: "up", URL: server.URL},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().RegistryList().Return(&tt.registryList).AnyTimes()
+			o := NewRegistryClient(filesystem.NewFakeFs(), prefClient)
+
+			got, err := o.GetCacheStatus(tt.registryName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RegistryClient.GetCacheStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.wantReachable) {
+				t.Errorf("RegistryClient.GetCacheStatus() returned %d statuses, want %d", len(got), len(tt.wantReachable))
+			}
+			for _, status := range got {
+				if status.Reachable != tt.wantReachable[status.Name] {
+					t.Errorf("RegistryClient.GetCacheStatus() reachable = %v for registry %q, want %v", status.Reachable, status.Name, tt.wantReachable[status.Name])
+				}
+				if status.Reachable == (status.Error != "") {
+					t.Errorf("RegistryClient.GetCacheStatus() error = %q for registry %q", status.Error, status.Name)
+				}
+				if status.Stacks != tt.wantStacks[status.Name] {
+					t.Errorf("RegistryClient.GetCacheStatus() stacks = %d for registry %q, want %d", status.Stacks, status.Name, tt.wantStacks[status.Name])
+				}
+				if status.Cached {
+					t.Errorf("RegistryClient.GetCacheStatus() the index of OCI-based registry %q should not be cached", status.Name)
+				}
+			}
+		})
+	}
+}
+
+func Test_getCacheStatus(t *testing.T) {
+	registry := Registry{Name: "github", URL: "https://github.com/example/registry"}
+	indexURL, err := getIndexURL(registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cacheFile := util.GetHTTPCacheFile(indexURL)
+	fakeFs := filesystem.NewFakeFs()
+
+	// not cached
+	status := getCacheStatus(fakeFs, registry, nil, nil)
+	if status.Cached || status.LastUpdate != nil {
+		t.Errorf("getCacheStatus() = %+v, want a non cached status", status)
+	}
+
+	// cached
+	if err = fakeFs.WriteFile(cacheFile, []byte("0123456789"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	status = getCacheStatus(fakeFs, registry, []DevfileStack{{Name: "nodejs"}}, nil)
+	if !status.Cached || status.LastUpdate == nil || status.Size != 10 || !status.Reachable || status.Stacks != 1 {
+		t.Errorf("getCacheStatus() = %+v, want a cached status", status)
+	}
+}
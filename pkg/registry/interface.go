@@ -13,4 +13,10 @@ type Client interface {
 	DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedToken string, contextDir string, verbose bool) error
 	GetDevfileRegistries(registryName string) ([]Registry, error)
 	ListDevfileStacks(registryName string) (DevfileStackList, error)
+	// GetCacheStatus returns the status of the cached index of the registry with the given name, or of all the registries if registryName is empty
+	GetCacheStatus(registryName string) ([]RegistryCacheStatus, error)
+	// RefreshCache downloads again and caches the index of the registry with the given name, or of all the registries if registryName is empty
+	RefreshCache(registryName string) ([]RegistryCacheStatus, error)
+	// CleanCache removes the cached index of the registry with the given name, or the whole cache if registryName is empty
+	CleanCache(registryName string) error
 }
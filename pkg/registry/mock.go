@@ -36,6 +36,20 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CleanCache mocks base method.
+func (m *MockClient) CleanCache(registryName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanCache", registryName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CleanCache indicates an expected call of CleanCache.
+func (mr *MockClientMockRecorder) CleanCache(registryName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanCache", reflect.TypeOf((*MockClient)(nil).CleanCache), registryName)
+}
+
 // DownloadFileInMemory mocks base method.
 func (m *MockClient) DownloadFileInMemory(params util.HTTPRequestParams) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +79,21 @@ func (mr *MockClientMockRecorder) DownloadStarterProject(starterProject, decrypt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadStarterProject", reflect.TypeOf((*MockClient)(nil).DownloadStarterProject), starterProject, decryptedToken, contextDir, verbose)
 }
 
+// GetCacheStatus mocks base method.
+func (m *MockClient) GetCacheStatus(registryName string) ([]RegistryCacheStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCacheStatus", registryName)
+	ret0, _ := ret[0].([]RegistryCacheStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCacheStatus indicates an expected call of GetCacheStatus.
+func (mr *MockClientMockRecorder) GetCacheStatus(registryName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCacheStatus", reflect.TypeOf((*MockClient)(nil).GetCacheStatus), registryName)
+}
+
 // GetDevfileRegistries mocks base method.
 func (m *MockClient) GetDevfileRegistries(registryName string) ([]Registry, error) {
 	m.ctrl.T.Helper()
@@ -108,3 +137,18 @@ func (mr *MockClientMockRecorder) PullStackFromRegistry(registry, stack, destDir
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullStackFromRegistry", reflect.TypeOf((*MockClient)(nil).PullStackFromRegistry), registry, stack, destDir, options)
 }
+
+// RefreshCache mocks base method.
+func (m *MockClient) RefreshCache(registryName string) ([]RegistryCacheStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCache", registryName)
+	ret0, _ := ret[0].([]RegistryCacheStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshCache indicates an expected call of RefreshCache.
+func (mr *MockClientMockRecorder) RefreshCache(registryName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCache", reflect.TypeOf((*MockClient)(nil).RefreshCache), registryName)
+}
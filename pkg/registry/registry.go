@@ -172,7 +172,13 @@ const indexPath = "/devfiles/index.json"
 
 // getRegistryStacks retrieves the registry's index devfile stack entries
 func getRegistryStacks(preferenceClient preference.Client, registry Registry) ([]DevfileStack, error) {
-	if !strings.Contains(registry.URL, "github") {
+	return fetchRegistryStacks(preferenceClient, registry, true)
+}
+
+// fetchRegistryStacks retrieves the registry's index devfile stack entries.
+// If useCache is true, the index of a Github-based registry is read from and stored in the cache, for the duration set in preferences
+func fetchRegistryStacks(preferenceClient preference.Client, registry Registry, useCache bool) ([]DevfileStack, error) {
+	if !isGithubRegistry(registry) {
 		// OCI-based registry
 		devfileIndex, err := library.GetRegistryIndex(registry.URL, segment.GetRegistryOptions(), indexSchema.StackDevfileType)
 		if err != nil {
@@ -181,15 +187,17 @@ func getRegistryStacks(preferenceClient preference.Client, registry Registry) ([
 		return createRegistryDevfiles(registry, devfileIndex)
 	}
 	// Github-based registry
-	URL, err := convertURL(registry.URL)
+	indexLink, err := getIndexURL(registry)
 	if err != nil {
-		return nil, fmt.Errorf("unable to convert URL %s: %w", registry.URL, err)
+		return nil, err
 	}
-	registry.URL = URL
-	indexLink := registry.URL + indexPath
 	request := dfutil.HTTPRequestParams{
 		URL: indexLink,
 	}
+	cacheTime := 0
+	if useCache {
+		cacheTime = preferenceClient.GetRegistryCacheTime()
+	}
 
 	secure := registryUtil.IsSecure(preferenceClient, registry.Name)
 	if secure {
@@ -200,7 +208,7 @@ func getRegistryStacks(preferenceClient preference.Client, registry Registry) ([
 		request.Token = token
 	}
 
-	jsonBytes, err := dfutil.HTTPGetRequest(request, preferenceClient.GetRegistryCacheTime())
+	jsonBytes, err := dfutil.HTTPGetRequest(request, cacheTime)
 	if err != nil {
 		return nil, fmt.Errorf("unable to download the devfile index.json from %s: %w", indexLink, err)
 	}
@@ -208,11 +216,11 @@ func getRegistryStacks(preferenceClient preference.Client, registry Registry) ([
 	var devfileIndex []indexSchema.Schema
 	err = json.Unmarshal(jsonBytes, &devfileIndex)
 	if err != nil {
-		if err := util.CleanDefaultHTTPCacheDir(); err != nil {
-			log.Warning("Error while cleaning up cache dir.")
+		// the cached index of the registry may be corrupted, remove it and try once again
+		if err := util.CleanHTTPCacheFile(indexLink); err != nil {
+			log.Warningf("Error while cleaning up the cached index of registry %s.", registry.Name)
 		}
-		// we try once again
-		jsonBytes, err := dfutil.HTTPGetRequest(request, preferenceClient.GetRegistryCacheTime())
+		jsonBytes, err := dfutil.HTTPGetRequest(request, cacheTime)
 		if err != nil {
 			return nil, fmt.Errorf("unable to download the devfile index.json from %s: %w", indexLink, err)
 		}
@@ -222,9 +230,24 @@ func getRegistryStacks(preferenceClient preference.Client, registry Registry) ([
 			return nil, fmt.Errorf("unable to unmarshal the devfile index.json from %s: %w", indexLink, err)
 		}
 	}
+	registry.URL = strings.TrimSuffix(indexLink, indexPath)
 	return createRegistryDevfiles(registry, devfileIndex)
 }
 
+// isGithubRegistry returns true if the registry is a Github-based registry, false if it is an OCI-based registry
+func isGithubRegistry(registry Registry) bool {
+	return strings.Contains(registry.URL, "github")
+}
+
+// getIndexURL returns the URL of the index of a Github-based registry
+func getIndexURL(registry Registry) (string, error) {
+	URL, err := convertURL(registry.URL)
+	if err != nil {
+		return "", fmt.Errorf("unable to convert URL %s: %w", registry.URL, err)
+	}
+	return URL + indexPath, nil
+}
+
 func createRegistryDevfiles(registry Registry, devfileIndex []indexSchema.Schema) ([]DevfileStack, error) {
 	registryDevfiles := make([]DevfileStack, 0, len(devfileIndex))
 	for _, devfileIndexEntry := range devfileIndex {
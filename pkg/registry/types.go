@@ -1,5 +1,7 @@
 package registry
 
+import "time"
+
 // Registry is the main struct of devfile registry
 type Registry struct {
 	Name   string
@@ -27,3 +29,22 @@ type DevfileStackList struct {
 
 // TypesWithDetails is the list of project types in devfile registries, and their associated devfiles
 type TypesWithDetails map[string][]DevfileStack
+
+// RegistryCacheStatus is the status of the cached index of a devfile registry
+type RegistryCacheStatus struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Cached indicates if the index of the registry is present in the cache.
+	// Only the indexes of Github-based registries are cached
+	Cached bool `json:"cached"`
+	// LastUpdate is the time at which the index has been cached
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Size of the cached index, in bytes
+	Size int64 `json:"size"`
+	// Reachable indicates if the index of the registry can be downloaded
+	Reachable bool `json:"reachable"`
+	// Stacks is the number of devfile stacks in the index of the registry, when it is reachable
+	Stacks int `json:"stacks"`
+	// Error is the error returned when downloading the index of the registry
+	Error string `json:"error,omitempty"`
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -9,14 +11,27 @@ import (
 )
 
 // BuildCRDFromParams iterates over the parameter maps provided by the user and builds the CRD
+// When the crd schema is provided, the parameters are validated against it and converted to the expected types,
+// and the spec built from the parameters is validated as the specs loaded from a file, for the required properties
 func BuildCRDFromParams(paramMap map[string]string, crd *spec.Schema, group, version, kind string) (map[string]interface{}, error) {
+	// sort the keys so the errors are reproducible
+	keys := make([]string, 0, len(paramMap))
+	for k := range paramMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	spec := map[string]interface{}{}
-	for k, v := range paramMap {
-		err := addParam(spec, crd, k, v)
+	for _, k := range keys {
+		err := addParam(spec, crd, k, paramMap[k])
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("invalid parameter %q: %w", k, err)
 		}
 	}
+	err := ValidateCRDSpec(spec, crd)
+	if err != nil {
+		return nil, err
+	}
 
 	result := map[string]interface{}{}
 	result["apiVersion"] = group + "/" + version
@@ -26,10 +41,25 @@ func BuildCRDFromParams(paramMap map[string]string, crd *spec.Schema, group, ver
 	return result, nil
 }
 
+// ValidateCRDSpec validates the spec of a custom resource against the crd schema of the spec
+func ValidateCRDSpec(crSpec map[string]interface{}, crd *spec.Schema) error {
+	if crd == nil {
+		return nil
+	}
+	return validateValue("spec", crSpec, crd)
+}
+
 func addParam(m map[string]interface{}, crd *spec.Schema, key string, value string) error {
 	if strings.Contains(key, ".") {
 		parts := strings.SplitN(key, ".", 2)
 		property := parts[0]
+		subCRD, err := getPropertySchema(crd, property)
+		if err != nil {
+			return err
+		}
+		if subCRD != nil && len(subCRD.Type) > 0 && !subCRD.Type.Contains("object") {
+			return fmt.Errorf("%q is not an object", property)
+		}
 		_, found := m[property]
 		if !found {
 			m[property] = map[string]interface{}{}
@@ -38,12 +68,7 @@ func addParam(m map[string]interface{}, crd *spec.Schema, key string, value stri
 		if !ok {
 			return errors.New("already defined")
 		}
-		var subCRD *spec.Schema
-		if crd != nil {
-			s := crd.Properties[property]
-			subCRD = &s
-		}
-		err := addParam(submap, subCRD, parts[1], value)
+		err = addParam(submap, subCRD, parts[1], value)
 		if err != nil {
 			return err
 		}
@@ -52,59 +77,167 @@ func addParam(m map[string]interface{}, crd *spec.Schema, key string, value stri
 			return errors.New("already defined")
 		}
 
-		var subCRD *spec.Schema
-		if crd != nil {
-			s := crd.Properties[key]
-			subCRD = &s
+		subCRD, err := getPropertySchema(crd, key)
+		if err != nil {
+			return err
+		}
+		m[key], err = convertType(subCRD, value)
+		if err != nil {
+			return err
 		}
-		m[key] = convertType(subCRD, value)
 	}
 	return nil
 }
 
-func convertType(crd *spec.Schema, value string) interface{} {
+// getPropertySchema returns the schema of the property of an object described by crd.
+// An error is returned if the schema does not accept the property
+func getPropertySchema(crd *spec.Schema, property string) (*spec.Schema, error) {
+	if crd == nil {
+		return nil, nil
+	}
+	if s, found := crd.Properties[property]; found {
+		return &s, nil
+	}
+	if crd.AdditionalProperties != nil {
+		if !crd.AdditionalProperties.Allows {
+			return nil, fmt.Errorf("unknown property %q", property)
+		}
+		if crd.AdditionalProperties.Schema != nil {
+			return crd.AdditionalProperties.Schema, nil
+		}
+		return &spec.Schema{}, nil
+	}
+	if len(crd.Properties) > 0 {
+		return nil, fmt.Errorf("unknown property %q, the valid properties are: %s", property, strings.Join(getPropertyNames(crd), ", "))
+	}
+	// no information about the properties, accept it
+	return &spec.Schema{}, nil
+}
+
+func getPropertyNames(crd *spec.Schema) []string {
+	names := make([]string, 0, len(crd.Properties))
+	for name := range crd.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func convertType(crd *spec.Schema, value string) (interface{}, error) {
 	if crd != nil {
 		// do not use 'else' as the Schema can accept several types
 		// the first matching type will be used
 		if crd.Type.Contains("string") {
-			return value
+			return value, nil
 		}
 		if crd.Type.Contains("integer") {
 			intv, err := strconv.ParseInt(value, 10, 64)
 			if err == nil {
-				return int64(intv)
+				return int64(intv), nil
 			}
 		}
 		if crd.Type.Contains("number") {
 			floatv, err := strconv.ParseFloat(value, 64)
 			if err == nil {
-				return floatv
+				return floatv, nil
 			}
 		}
 		if crd.Type.Contains("boolean") {
 			boolv, err := strconv.ParseBool(value)
 			if err == nil {
-				return boolv
+				return boolv, nil
+			}
+		}
+		if len(crd.Type) > 0 {
+			if crd.Type.Contains("object") || crd.Type.Contains("array") {
+				return nil, fmt.Errorf("a value of type %s cannot be set from a parameter, please use a file instead", strings.Join(crd.Type, " or "))
 			}
+			return nil, fmt.Errorf("value %q is not of type %s", value, strings.Join(crd.Type, " or "))
 		}
 	} else {
 		// no crd information available, guess the type depending on the value
 		intv, err := strconv.ParseInt(value, 10, 64)
 		if err == nil {
-			return int64(intv)
+			return int64(intv), nil
 		}
 
 		floatv, err := strconv.ParseFloat(value, 64)
 		if err == nil {
-			return floatv
+			return floatv, nil
 		}
 
 		boolv, err := strconv.ParseBool(value)
 		if err == nil {
-			return boolv
+			return boolv, nil
 		}
 	}
 
 	// as a last resort return the string value
-	return value
+	return value, nil
+}
+
+// validateValue validates recursively the value found at path against the crd schema
+func validateValue(path string, value interface{}, crd *spec.Schema) error {
+	if crd == nil || value == nil {
+		return nil
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(crd.Type) > 0 && !crd.Type.Contains("object") {
+			return fmt.Errorf("%s: expected type %s, got object", path, strings.Join(crd.Type, " or "))
+		}
+		for _, required := range crd.Required {
+			if _, found := v[required]; !found {
+				return fmt.Errorf("%s: missing required property %q", path, required)
+			}
+		}
+		for key, subValue := range v {
+			subCRD, err := getPropertySchema(crd, key)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			err = validateValue(path+"."+key, subValue, subCRD)
+			if err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if len(crd.Type) > 0 && !crd.Type.Contains("array") {
+			return fmt.Errorf("%s: expected type %s, got array", path, strings.Join(crd.Type, " or "))
+		}
+		if crd.Items == nil || crd.Items.Schema == nil {
+			return nil
+		}
+		for i, item := range v {
+			err := validateValue(fmt.Sprintf("%s[%d]", path, i), item, crd.Items.Schema)
+			if err != nil {
+				return err
+			}
+		}
+	case string:
+		return checkType(path, crd, "string")
+	case bool:
+		return checkType(path, crd, "boolean")
+	case int, int32, int64:
+		return checkType(path, crd, "integer", "number")
+	case float32, float64:
+		if f, ok := v.(float64); ok && f == float64(int64(f)) {
+			return checkType(path, crd, "integer", "number")
+		}
+		return checkType(path, crd, "number")
+	}
+	return nil
+}
+
+// checkType returns an error if the schema is typed and does not accept any of the types
+func checkType(path string, crd *spec.Schema, types ...string) error {
+	if len(crd.Type) == 0 {
+		return nil
+	}
+	for _, t := range types {
+		if crd.Type.Contains(t) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: expected type %s, got %s", path, strings.Join(crd.Type, " or "), types[0])
 }
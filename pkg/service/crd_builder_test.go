@@ -165,6 +165,60 @@ func TestBuildCRDFromParams(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "unknown property with crd",
+			params: map[string]string{
+				"a.unknown": "1",
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+		{
+			name: "invalid type with crd",
+			params: map[string]string{
+				"a.int": "not-a-number",
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+		{
+			name: "scalar value for an object with crd",
+			params: map[string]string{
+				"a": "1",
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+		{
+			name: "nested key under a scalar with crd",
+			params: map[string]string{
+				"a.int.b": "1",
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+		{
+			name: "missing required property with crd",
+			params: map[string]string{
+				"a.bool": "true",
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+		{
+			name: "additional properties with crd",
+			params: map[string]string{
+				"labels.app":  "redis",
+				"labels.tier": "1",
+			},
+			crd: getTestSpecSchema(),
+			want: map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app":  "redis",
+					"tier": "1",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -183,3 +237,111 @@ func TestBuildCRDFromParams(t *testing.T) {
 		})
 	}
 }
+
+func getTestSpecSchema() *spec.Schema {
+	return &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type: spec.StringOrArray{"object"},
+			Properties: map[string]spec.Schema{
+				"a": {
+					SchemaProps: spec.SchemaProps{
+						Type:     spec.StringOrArray{"object"},
+						Required: []string{"int"},
+						Properties: map[string]spec.Schema{
+							"int":  *spec.Int64Property(),
+							"bool": *spec.BooleanProperty(),
+							"list": *spec.ArrayProperty(spec.StringProperty()),
+						},
+					},
+				},
+				"labels": *spec.MapProperty(spec.StringProperty()),
+			},
+		},
+	}
+}
+
+func TestValidateCRDSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    map[string]interface{}
+		crd     *spec.Schema
+		wantErr bool
+	}{
+		{
+			name: "valid spec",
+			spec: map[string]interface{}{
+				"a": map[string]interface{}{
+					"int":  float64(1),
+					"bool": true,
+					"list": []interface{}{"x", "y"},
+				},
+				"labels": map[string]interface{}{
+					"app": "redis",
+				},
+			},
+			crd: getTestSpecSchema(),
+		},
+		{
+			name: "no crd",
+			spec: map[string]interface{}{
+				"unknown": "value",
+			},
+		},
+		{
+			name: "unknown property",
+			spec: map[string]interface{}{
+				"unknown": "value",
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+		{
+			name: "missing required property",
+			spec: map[string]interface{}{
+				"a": map[string]interface{}{
+					"bool": true,
+				},
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+		{
+			name: "invalid type",
+			spec: map[string]interface{}{
+				"a": map[string]interface{}{
+					"int": "one",
+				},
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+		{
+			name: "invalid type of array item",
+			spec: map[string]interface{}{
+				"a": map[string]interface{}{
+					"int":  float64(1),
+					"list": []interface{}{"x", true},
+				},
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+		{
+			name: "float for an integer",
+			spec: map[string]interface{}{
+				"a": map[string]interface{}{
+					"int": 1.5,
+				},
+			},
+			crd:     getTestSpecSchema(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateCRDSpec(tt.spec, tt.crd); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCRDSpec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
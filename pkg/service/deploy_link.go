@@ -0,0 +1,246 @@
+package service
+
+import (
+	"fmt"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/generator"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/ghodss/yaml"
+	v1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	sboApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+	sboPipeline "github.com/redhat-developer/service-binding-operator/pkg/reconcile/pipeline"
+
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// DeployBindingSuffix is appended to the names of the bindings created by `odo deploy`,
+// so they do not conflict with the bindings of the same name created by `odo dev`
+const DeployBindingSuffix = "-deploy"
+
+// DeployLink is a ServiceBinding Kubernetes component of the devfile, resolved for `odo deploy`
+type DeployLink struct {
+	// Name of the Kubernetes component
+	Name    string
+	Binding sboApi.ServiceBinding
+}
+
+// GetDeployLinks returns the ServiceBinding Kubernetes components of the devfile
+func GetDeployLinks(k8sComponents []devfile.Component, context string) ([]DeployLink, error) {
+	var links []DeployLink
+	for _, c := range k8sComponents {
+		u, err := libdevfile.GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		if !IsLinkResource(u.GetKind()) {
+			continue
+		}
+		content, err := yaml.Marshal(u.Object)
+		if err != nil {
+			return nil, err
+		}
+		var serviceBinding sboApi.ServiceBinding
+		err = yaml.Unmarshal(content, &serviceBinding)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, DeployLink{Name: c.Name, Binding: serviceBinding})
+	}
+	return links, nil
+}
+
+// GetDeployLinkTarget returns the deployment applied by `odo deploy` to bind for the link.
+// The bindings generated by `odo add binding` target the deployment of `odo dev`: if the application of the binding is not
+// a deployment applied by `odo deploy`, the binding targets the only deployment applied, if any
+func GetDeployLinkTarget(link DeployLink, deployments []string) (string, error) {
+	for _, deployment := range deployments {
+		if deployment == link.Binding.Spec.Application.Name {
+			return deployment, nil
+		}
+	}
+	if len(deployments) == 1 {
+		return deployments[0], nil
+	}
+	if len(deployments) == 0 {
+		return "", fmt.Errorf("no Deployment deployed by the deploy command to bind with %q", link.Name)
+	}
+	return "", fmt.Errorf("unable to select the Deployment to bind with %q, the application of the binding must be one of %v", link.Name, deployments)
+}
+
+// PushDeployLinks binds the deployments applied by `odo deploy` to the services of the ServiceBinding Kubernetes components.
+// If the Service Binding Operator is installed, the ServiceBinding resources are created on the cluster targeting the deployments,
+// otherwise the binding secrets are generated with the Service Binding library and the deployments are patched to use them,
+// the same way `odo dev` does.
+// The bindings are named with the DeployBindingSuffix, and labelled with labels, which must contain the deploy mode label.
+// The bindings previously created by `odo deploy` and not present in the devfile anymore are deleted
+func PushDeployLinks(client kclient.ClientInterface, links []DeployLink, labels map[string]string, deployments []string) error {
+	serviceBindingSupport, err := client.IsServiceBindingSupported()
+	if err != nil {
+		return err
+	}
+
+	deploymentGVR, err := client.GetDeploymentAPIVersion()
+	if err != nil {
+		return err
+	}
+
+	targets := map[string]*v1.Deployment{}
+	for i := range links {
+		name, err := GetDeployLinkTarget(links[i], deployments)
+		if err != nil {
+			return err
+		}
+		if _, ok := targets[name]; !ok {
+			targets[name], err = client.GetDeploymentByName(name)
+			if err != nil {
+				return err
+			}
+		}
+		links[i].Binding.Name += DeployBindingSuffix
+		links[i].Binding.Spec.Application = sboApi.Application{
+			Ref: sboApi.Ref{
+				Name:     name,
+				Group:    deploymentGVR.Group,
+				Version:  deploymentGVR.Version,
+				Resource: deploymentGVR.Resource,
+			},
+		}
+	}
+
+	if !serviceBindingSupport {
+		klog.V(4).Info("Service Binding Operator is not installed on cluster. Service Binding will be created by odo using SB library.")
+		return pushDeployLinksWithoutOperator(client, links, labels, targets, deploymentGVR)
+	}
+	return pushDeployLinksWithOperator(client, links, labels, targets)
+}
+
+// pushDeployLinksWithOperator creates the ServiceBinding resources on the cluster, and deletes the ones not present in the devfile anymore
+func pushDeployLinksWithOperator(client kclient.ClientInterface, links []DeployLink, labels map[string]string, targets map[string]*v1.Deployment) error {
+	local := map[string]bool{}
+	for _, link := range links {
+		link.Binding.SetLabels(labels)
+		link.Binding.SetOwnerReferences([]metav1.OwnerReference{generator.GetOwnerReference(targets[link.Binding.Spec.Application.Name])})
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&link.Binding)
+		if err != nil {
+			return err
+		}
+		unstructured.RemoveNestedField(content, "status")
+		unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+		u := unstructured.Unstructured{Object: content}
+		u.SetAPIVersion(sboApi.GroupVersion.String())
+		u.SetKind(kclient.ServiceBindingKind)
+
+		err = client.CreateDynamicResource(u)
+		if err != nil {
+			return fmt.Errorf("unable to create binding %q: %w", link.Binding.Name, err)
+		}
+		local[link.Binding.Name] = true
+		log.Successf("Created binding %q using Service Binding Operator on the cluster", link.Binding.Name)
+	}
+
+	gvr := schema.GroupVersionResource{
+		Group:    kclient.ServiceBindingGroup,
+		Version:  kclient.ServiceBindingVersion,
+		Resource: kclient.ServiceBindingResource,
+	}
+	list, err := client.ListDynamicResources(gvr)
+	if err != nil {
+		return err
+	}
+	for _, item := range list.Items {
+		if local[item.GetName()] || !hasModeLabels(item.GetLabels(), labels) {
+			continue
+		}
+		err = client.DeleteDynamicResource(item.GetName(), gvr, false)
+		if err != nil {
+			return err
+		}
+		log.Successf("Deleted binding %q using Service Binding Operator on the cluster", item.GetName())
+	}
+	return nil
+}
+
+// pushDeployLinksWithoutOperator generates the binding secrets and patches the deployments with the Service Binding library.
+// The links are processed at each deploy, as the deployments are applied again by the deploy command
+func pushDeployLinksWithoutOperator(client kclient.ClientInterface, links []DeployLink, labels map[string]string, targets map[string]*v1.Deployment, deploymentGVR metav1.GroupVersionResource) error {
+	csvSupport, err := client.IsCSVSupported()
+	if err != nil {
+		return err
+	}
+
+	clusterLinksMap, err := listLinkSecrets(client, labels)
+	if err != nil {
+		return err
+	}
+
+	serviceCompMap, err := getServiceComponentMap(client)
+	if err != nil {
+		return err
+	}
+
+	var processingPipeline sboPipeline.Pipeline
+	local := map[string]bool{}
+	for _, link := range links {
+		local[link.Name] = true
+		if len(link.Binding.Spec.Services) != 1 {
+			continue
+		}
+		if !csvSupport && !IsLinkResource(link.Binding.Spec.Services[0].Kind) {
+			// ignore service binding objects linked to services if csv support is not present on the cluster
+			continue
+		}
+
+		ownerReference := generator.GetOwnerReference(targets[link.Binding.Spec.Application.Name])
+		secretName, err := bindWithoutOperator(client, &processingPipeline, &link.Binding, link.Binding.Spec.Services[0], link.Name, labels, serviceCompMap, ownerReference)
+		if err != nil {
+			return err
+		}
+		if previous, ok := clusterLinksMap[link.Name]; ok && previous != secretName {
+			// the content of the binding changed, the secret of the previous content is not used anymore
+			err = client.DeleteSecret(previous, client.GetCurrentNamespace())
+			if err != nil {
+				return err
+			}
+		}
+		log.Successf("Created binding %q on the cluster", link.Binding.Name)
+	}
+
+	// delete the links not present on the devfile
+	for linkName, secretName := range clusterLinksMap {
+		if local[linkName] {
+			continue
+		}
+		secret, err := client.GetSecret(secretName, client.GetCurrentNamespace())
+		if err != nil {
+			return err
+		}
+		var deploymentName string
+		for _, owner := range secret.GetOwnerReferences() {
+			if owner.Kind == kclient.DeploymentKind {
+				deploymentName = owner.Name
+			}
+		}
+		err = unbindWithoutOperator(client, &processingPipeline, linkName+DeployBindingSuffix, secretName, deploymentName, deploymentGVR)
+		if err != nil {
+			return err
+		}
+		log.Successf("Deleted binding %q on the cluster", linkName+DeployBindingSuffix)
+	}
+	return nil
+}
+
+// hasModeLabels returns true if the resource labels match the component instance and the mode of the labels
+func hasModeLabels(resourceLabels map[string]string, labels map[string]string) bool {
+	return resourceLabels[componentlabels.KubernetesInstanceLabel] == labels[componentlabels.KubernetesInstanceLabel] &&
+		resourceLabels[componentlabels.OdoModeLabel] == labels[componentlabels.OdoModeLabel]
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"testing"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/golang/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	sboApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+const bindingComponent = `apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+  name: my-nodejs-app-mydb
+spec:
+  application:
+    group: apps
+    name: my-nodejs-app-app
+    resource: deployments
+    version: v1
+  services:
+  - group: postgres-operator.crunchydata.com
+    kind: PostgresCluster
+    name: mydb
+    version: v1beta1
+`
+
+func getDeployLink(application string) DeployLink {
+	link := DeployLink{Name: "my-nodejs-app-mydb"}
+	link.Binding.Name = "my-nodejs-app-mydb"
+	link.Binding.Spec.Application = sboApi.Application{Ref: sboApi.Ref{Name: application}}
+	return link
+}
+
+func TestGetDeployLinks(t *testing.T) {
+	components := []devfile.Component{
+		getSubstitutedComponent("my-nodejs-app-mydb", bindingComponent, nil),
+		getSubstitutedComponent("mydb", postgresCR, nil),
+	}
+	links, err := GetDeployLinks(components, "")
+	if err != nil {
+		t.Fatalf("GetDeployLinks() error = %v", err)
+	}
+	if len(links) != 1 {
+		t.Fatalf("GetDeployLinks() returned %d links, want 1", len(links))
+	}
+	if links[0].Name != "my-nodejs-app-mydb" || links[0].Binding.Spec.Application.Name != "my-nodejs-app-app" {
+		t.Errorf("GetDeployLinks() = %+v", links[0])
+	}
+	if len(links[0].Binding.Spec.Services) != 1 || links[0].Binding.Spec.Services[0].Kind != "PostgresCluster" {
+		t.Errorf("GetDeployLinks() services = %+v", links[0].Binding.Spec.Services)
+	}
+}
+
+func TestGetDeployLinkTarget(t *testing.T) {
+	tests := []struct {
+		name        string
+		application string
+		deployments []string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "application of the binding is deployed",
+			application: "backend",
+			deployments: []string{"frontend", "backend"},
+			want:        "backend",
+		},
+		{
+			name:        "binding targets the dev deployment, a single deployment is deployed",
+			application: "my-nodejs-app-app",
+			deployments: []string{"backend"},
+			want:        "backend",
+		},
+		{
+			name:        "binding targets the dev deployment, several deployments are deployed",
+			application: "my-nodejs-app-app",
+			deployments: []string{"frontend", "backend"},
+			wantErr:     true,
+		},
+		{
+			name:        "no deployment is deployed",
+			application: "my-nodejs-app-app",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetDeployLinkTarget(getDeployLink(tt.application), tt.deployments)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDeployLinkTarget() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetDeployLinkTarget() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPushDeployLinksWithOperator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := kclient.NewMockClientInterface(ctrl)
+
+	labels := componentlabels.GetLabels("my-nodejs-app", "app", true)
+	labels[componentlabels.OdoModeLabel] = componentlabels.ComponentDeployName
+	devLabels := componentlabels.GetLabels("my-nodejs-app", "app", true)
+	devLabels[componentlabels.OdoModeLabel] = componentlabels.ComponentDevName
+
+	client.EXPECT().IsServiceBindingSupported().Return(true, nil)
+	client.EXPECT().GetDeploymentAPIVersion().Return(metav1.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, nil)
+	client.EXPECT().GetDeploymentByName("backend").Return(&appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", UID: "1234"},
+	}, nil)
+
+	var created unstructured.Unstructured
+	client.EXPECT().CreateDynamicResource(gomock.Any()).DoAndReturn(func(u unstructured.Unstructured) error {
+		created = u
+		return nil
+	})
+
+	stale := unstructured.Unstructured{}
+	stale.SetName("my-nodejs-app-redis-deploy")
+	stale.SetLabels(labels)
+	dev := unstructured.Unstructured{}
+	dev.SetName("my-nodejs-app-redis")
+	dev.SetLabels(devLabels)
+	current := unstructured.Unstructured{}
+	current.SetName("my-nodejs-app-mydb-deploy")
+	current.SetLabels(labels)
+	client.EXPECT().ListDynamicResources(gomock.Any()).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{stale, dev, current}}, nil)
+	client.EXPECT().DeleteDynamicResource("my-nodejs-app-redis-deploy", gomock.Any(), false).Return(nil)
+
+	err := PushDeployLinks(client, []DeployLink{getDeployLink("my-nodejs-app-app")}, labels, []string{"backend"})
+	if err != nil {
+		t.Fatalf("PushDeployLinks() error = %v", err)
+	}
+
+	if created.GetName() != "my-nodejs-app-mydb-deploy" {
+		t.Errorf("created binding name = %q, want %q", created.GetName(), "my-nodejs-app-mydb-deploy")
+	}
+	if created.GetKind() != kclient.ServiceBindingKind {
+		t.Errorf("created binding kind = %q", created.GetKind())
+	}
+	if created.GetLabels()[componentlabels.OdoModeLabel] != componentlabels.ComponentDeployName {
+		t.Errorf("created binding labels = %v", created.GetLabels())
+	}
+	if owners := created.GetOwnerReferences(); len(owners) != 1 || owners[0].Name != "backend" {
+		t.Errorf("created binding owner references = %v", owners)
+	}
+	application, _, _ := unstructured.NestedString(created.Object, "spec", "application", "name")
+	if application != "backend" {
+		t.Errorf("created binding application = %q, want %q", application, "backend")
+	}
+}
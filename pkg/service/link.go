@@ -13,6 +13,7 @@ import (
 	applabels "github.com/redhat-developer/odo/pkg/application/labels"
 	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
 	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
 	v1 "k8s.io/api/apps/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,7 +32,9 @@ import (
 // PushLinks updates Link(s) from Kubernetes Inlined component in a devfile by creating new ones or removing old ones
 // returns true if the component needs to be restarted (when a link has been created or deleted)
 // if service binding operator is not present, it will call pushLinksWithoutOperator to create the links without it.
-func PushLinks(client kclient.ClientInterface, k8sComponents []devfile.Component, labels map[string]string, deployment *v1.Deployment, context string) (bool, error) {
+// substitutes contains the secrets of the dev substitutes, indexed by <kind>/<name> of the substituted services,
+// the links to these services are made to the secrets instead.
+func PushLinks(client kclient.ClientInterface, k8sComponents []devfile.Component, substitutes map[string]string, labels map[string]string, deployment *v1.Deployment, context string) (bool, error) {
 	serviceBindingSupport, err := client.IsServiceBindingSupported()
 	if err != nil {
 		return false, err
@@ -39,15 +42,15 @@ func PushLinks(client kclient.ClientInterface, k8sComponents []devfile.Component
 
 	if !serviceBindingSupport {
 		klog.V(4).Info("Service Binding Operator is not installed on cluster. Service Binding will be created by odo using SB library.")
-		return pushLinksWithoutOperator(client, k8sComponents, labels, deployment, context)
+		return pushLinksWithoutOperator(client, k8sComponents, substitutes, labels, deployment, context)
 	}
 
-	return pushLinksWithOperator(client, k8sComponents, labels, deployment, context)
+	return pushLinksWithOperator(client, k8sComponents, substitutes, labels, deployment, context)
 }
 
 // pushLinksWithOperator creates links or deletes links (if service binding operator is installed) between components and services
 // returns true if the component needs to be restarted (a secret was generated and added to the deployment)
-func pushLinksWithOperator(client kclient.ClientInterface, k8sComponents []devfile.Component, labels map[string]string, deployment *v1.Deployment, context string) (bool, error) {
+func pushLinksWithOperator(client kclient.ClientInterface, k8sComponents []devfile.Component, substitutes map[string]string, labels map[string]string, deployment *v1.Deployment, context string) (bool, error) {
 
 	ownerReference := generator.GetOwnerReference(deployment)
 	deployed, err := ListDeployedServices(client, labels)
@@ -80,7 +83,7 @@ func pushLinksWithOperator(client kclient.ClientInterface, k8sComponents []devfi
 			return false, e
 		}
 
-		if !isLinkResource(u.GetKind()) {
+		if !IsLinkResource(u.GetKind()) {
 			// operator hub is not installed on the cluster
 			// or it's a service binding related resource
 			continue
@@ -90,6 +93,11 @@ func pushLinksWithOperator(client kclient.ClientInterface, k8sComponents []devfi
 		u.SetOwnerReferences([]metav1.OwnerReference{ownerReference})
 		u.SetLabels(labels)
 
+		err = substituteServiceRefs(&u, substitutes)
+		if err != nil {
+			return false, err
+		}
+
 		err = createOperatorService(client, u)
 		delete(deployed, u.GetKind()+"/"+crdName)
 		if err != nil {
@@ -102,14 +110,12 @@ func pushLinksWithOperator(client kclient.ClientInterface, k8sComponents []devfi
 			}
 		}
 
-		// uncomment/modify when service linking is enabled in v3
-		//name := u.GetName()
-		//log.Successf("Created link %q using Service Binding Operator on the cluster; component will be restarted", name)
+		log.Successf("Created binding %q using Service Binding Operator on the cluster; component will be restarted", crdName)
 		restartNeeded = true
 	}
 
 	for key, val := range deployed {
-		if !isLinkResource(val.Kind) {
+		if !IsLinkResource(val.Kind) {
 			continue
 		}
 		err = DeleteOperatorService(client, key)
@@ -117,8 +123,7 @@ func pushLinksWithOperator(client kclient.ClientInterface, k8sComponents []devfi
 			return false, err
 
 		}
-		// uncomment/modify when service linking is enabled in v3
-		// log.Successf("Deleted link %q using Service Binding Operator on the cluster; component will be restarted", key)
+		log.Successf("Deleted binding %q using Service Binding Operator on the cluster; component will be restarted", val.Name)
 		restartNeeded = true
 	}
 
@@ -127,7 +132,7 @@ func pushLinksWithOperator(client kclient.ClientInterface, k8sComponents []devfi
 
 // pushLinksWithoutOperator creates links or deletes links (if service binding operator is not installed) between components and services
 // returns true if the component needs to be restarted (a secret was generated and added to the deployment)
-func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []devfile.Component, labels map[string]string, deployment *v1.Deployment, context string) (bool, error) {
+func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []devfile.Component, substitutes map[string]string, labels map[string]string, deployment *v1.Deployment, context string) (bool, error) {
 
 	// check csv support before proceeding
 	csvSupport, err := client.IsCSVSupported()
@@ -135,20 +140,13 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []de
 		return false, err
 	}
 
-	secrets, err := client.ListSecrets(componentlabels.GetSelector(labels[componentlabels.KubernetesInstanceLabel], labels[applabels.ApplicationLabel]))
+	clusterLinksMap, err := listLinkSecrets(client, labels)
 	if err != nil {
 		return false, err
 	}
 
 	ownerReferences := generator.GetOwnerReference(deployment)
 
-	clusterLinksMap := make(map[string]string)
-	for _, secret := range secrets {
-		if value, ok := secret.GetLabels()[LinkLabel]; ok {
-			clusterLinksMap[value] = secret.Name
-		}
-	}
-
 	localLinksMap := make(map[string]string)
 	// create an object on the kubernetes cluster for all the Kubernetes Inlined components
 	for _, c := range k8sComponents {
@@ -167,7 +165,7 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []de
 			return false, e
 		}
 
-		if !isLinkResource(u.GetKind()) {
+		if !IsLinkResource(u.GetKind()) {
 			// not a service binding object, thus continue
 			continue
 		}
@@ -186,47 +184,12 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []de
 	// delete the links not present on the devfile
 	for linkName, secretName := range clusterLinksMap {
 		if _, ok := localLinksMap[linkName]; !ok {
-
-			// recreate parts of the service binding request for deletion
-			var newServiceBinding sboApi.ServiceBinding
-			newServiceBinding.Name = linkName
-			newServiceBinding.Namespace = client.GetCurrentNamespace()
-			newServiceBinding.Spec.Application = sboApi.Application{
-				Ref: sboApi.Ref{
-					Name:     deployment.Name,
-					Group:    deploymentGVR.Group,
-					Version:  deploymentGVR.Version,
-					Resource: deploymentGVR.Resource,
-				},
-			}
-			newServiceBinding.Status.Secret = secretName
-
-			// set the deletion time stamp to trigger deletion
-			timeNow := metav1.Now()
-			newServiceBinding.DeletionTimestamp = &timeNow
-
-			// if the pipeline was created before
-			// skip deletion
-			if processingPipeline == nil {
-				processingPipeline, err = getPipeline(client)
-				if err != nil {
-					return false, err
-				}
-			}
-			_, err = processingPipeline.Process(&newServiceBinding)
-			if err != nil {
-				return false, err
-			}
-
-			// since the library currently doesn't delete the secret after unbinding
-			// delete the secret manually
-			err = client.DeleteSecret(secretName, client.GetCurrentNamespace())
+			err = unbindWithoutOperator(client, &processingPipeline, linkName, secretName, deployment.Name, deploymentGVR)
 			if err != nil {
 				return false, err
 			}
 			restartRequired = true
-			// uncomment/modify when service linking is enabled in v3
-			//log.Successf("Deleted link %q on the cluster; component will be restarted", linkName)
+			log.Successf("Deleted binding %q on the cluster; component will be restarted", linkName)
 		}
 	}
 
@@ -237,15 +200,9 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []de
 		if _, ok := clusterLinksMap[linkName]; !ok {
 			if serviceCompMap == nil {
 				// prevent listing of services unless required
-				services, e := client.ListServices("")
-				if e != nil {
-					return false, e
-				}
-
-				// get the services and get match them against the component
-				serviceCompMap = make(map[string]string)
-				for _, service := range services {
-					serviceCompMap[service.Name] = service.Labels[componentlabels.KubernetesInstanceLabel]
+				serviceCompMap, err = getServiceComponentMap(client)
+				if err != nil {
+					return false, err
 				}
 			}
 
@@ -260,67 +217,21 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []de
 				continue
 			}
 
-			if !csvSupport && !isLinkResource(serviceBinding.Spec.Services[0].Kind) {
+			// the labels of the secret reference the service of the devfile, even if a dev substitute is used
+			boundService := serviceBinding.Spec.Services[0]
+			substituted := substituteServiceRef(&serviceBinding.Spec.Services[0], substitutes)
+
+			if !csvSupport && !substituted && !IsLinkResource(serviceBinding.Spec.Services[0].Kind) {
 				// ignore service binding objects linked to services if csv support is not present on the cluster
 				continue
 			}
 
-			// set the labels and namespace
-			serviceBinding.SetLabels(labels)
-			serviceBinding.Namespace = client.GetCurrentNamespace()
-			ns := client.GetCurrentNamespace()
-			serviceBinding.Spec.Services[0].Namespace = &ns
-
-			_, err = json.MarshalIndent(serviceBinding, " ", " ")
-			if err != nil {
-				return false, err
-			}
-
-			if processingPipeline == nil {
-				processingPipeline, err = getPipeline(client)
-				if err != nil {
-					return false, err
-				}
-			}
-
-			_, err = processingPipeline.Process(&serviceBinding)
-			if err != nil {
-				if kerrors.IsForbidden(err) {
-					// due to https://github.com/redhat-developer/service-binding-operator/issues/1003
-					return false, fmt.Errorf("please install the service binding operator")
-				}
-				return false, err
-			}
-
-			if len(serviceBinding.Status.Secret) == 0 {
-				return false, fmt.Errorf("no secret was provided by service binding's pipleine")
-			}
-
-			// get the generated secret and update it with the labels and owner reference
-			secret, err := client.GetSecret(serviceBinding.Status.Secret, client.GetCurrentNamespace())
-			if err != nil {
-				return false, err
-			}
-			secret.Labels = labels
-			secret.Labels[LinkLabel] = linkName
-			if _, ok := serviceCompMap[serviceBinding.Spec.Services[0].Name]; ok {
-				secret.Labels[ServiceLabel] = serviceCompMap[serviceBinding.Spec.Services[0].Name]
-			} else {
-				secret.Labels[ServiceLabel] = serviceBinding.Spec.Services[0].Name
-			}
-			secret.Labels[ServiceKind] = serviceBinding.Spec.Services[0].Kind
-			if serviceBinding.Spec.Services[0].Kind != "Service" {
-				// the service name is stored as kind-name as `/` is not a valid char for labels of kubernetes secrets
-				secret.Labels[ServiceLabel] = fmt.Sprintf("%v-%v", serviceBinding.Spec.Services[0].Kind, serviceBinding.Spec.Services[0].Name)
-			}
-			secret.SetOwnerReferences([]metav1.OwnerReference{ownerReferences})
-			_, err = client.UpdateSecret(secret, client.GetCurrentNamespace())
+			_, err = bindWithoutOperator(client, &processingPipeline, &serviceBinding, boundService, linkName, labels, serviceCompMap, ownerReferences)
 			if err != nil {
 				return false, err
 			}
 			restartRequired = true
-			// uncomment/modify when service linking is enabled in v3
-			//log.Successf("Created link %q on the cluster; component will be restarted", linkName)
+			log.Successf("Created binding %q on the cluster; component will be restarted", linkName)
 		}
 	}
 
@@ -331,6 +242,150 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []de
 	return false, nil
 }
 
+// listLinkSecrets returns the names of the secrets generated for the links of the component, indexed by link name.
+// Only the secrets of the mode of the labels, if any, are returned
+func listLinkSecrets(client kclient.ClientInterface, labels map[string]string) (map[string]string, error) {
+	selector := componentlabels.GetSelector(labels[componentlabels.KubernetesInstanceLabel], labels[applabels.ApplicationLabel])
+	if mode, ok := labels[componentlabels.OdoModeLabel]; ok {
+		selector = fmt.Sprintf("%s,%s=%s", selector, componentlabels.OdoModeLabel, mode)
+	}
+	secrets, err := client.ListSecrets(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterLinksMap := make(map[string]string)
+	for _, secret := range secrets {
+		if value, ok := secret.GetLabels()[LinkLabel]; ok {
+			clusterLinksMap[value] = secret.Name
+		}
+	}
+	return clusterLinksMap, nil
+}
+
+// getServiceComponentMap returns the names of the components of the services of the namespace, indexed by service name
+func getServiceComponentMap(client kclient.ClientInterface) (map[string]string, error) {
+	services, err := client.ListServices("")
+	if err != nil {
+		return nil, err
+	}
+
+	serviceCompMap := make(map[string]string)
+	for _, service := range services {
+		serviceCompMap[service.Name] = service.Labels[componentlabels.KubernetesInstanceLabel]
+	}
+	return serviceCompMap, nil
+}
+
+// ensurePipeline creates the pipeline processing the service binding requests, if it was not created before
+func ensurePipeline(client kclient.ClientInterface, processingPipeline *sboPipeline.Pipeline) error {
+	if *processingPipeline != nil {
+		return nil
+	}
+	p, err := getPipeline(client)
+	if err != nil {
+		return err
+	}
+	*processingPipeline = p
+	return nil
+}
+
+// unbindWithoutOperator removes the binding linkName from the deployment with the Service Binding library, and deletes its secret
+func unbindWithoutOperator(client kclient.ClientInterface, processingPipeline *sboPipeline.Pipeline, linkName string, secretName string, deploymentName string, deploymentGVR metav1.GroupVersionResource) error {
+	// recreate parts of the service binding request for deletion
+	var newServiceBinding sboApi.ServiceBinding
+	newServiceBinding.Name = linkName
+	newServiceBinding.Namespace = client.GetCurrentNamespace()
+	newServiceBinding.Spec.Application = sboApi.Application{
+		Ref: sboApi.Ref{
+			Name:     deploymentName,
+			Group:    deploymentGVR.Group,
+			Version:  deploymentGVR.Version,
+			Resource: deploymentGVR.Resource,
+		},
+	}
+	newServiceBinding.Status.Secret = secretName
+
+	// set the deletion time stamp to trigger deletion
+	timeNow := metav1.Now()
+	newServiceBinding.DeletionTimestamp = &timeNow
+
+	err := ensurePipeline(client, processingPipeline)
+	if err != nil {
+		return err
+	}
+	_, err = (*processingPipeline).Process(&newServiceBinding)
+	if err != nil {
+		return err
+	}
+
+	// since the library currently doesn't delete the secret after unbinding
+	// delete the secret manually
+	return client.DeleteSecret(secretName, client.GetCurrentNamespace())
+}
+
+// bindWithoutOperator processes the service binding with the Service Binding library, which generates the binding secret
+// and adds it to the application of the binding.
+// The generated secret is labelled with the labels, the link name and the bound service, and is owned by ownerReference.
+// It returns the name of the generated secret
+func bindWithoutOperator(client kclient.ClientInterface, processingPipeline *sboPipeline.Pipeline, serviceBinding *sboApi.ServiceBinding, boundService sboApi.Service, linkName string, labels map[string]string, serviceCompMap map[string]string, ownerReference metav1.OwnerReference) (string, error) {
+	// set the labels and namespace
+	serviceBinding.SetLabels(labels)
+	serviceBinding.Namespace = client.GetCurrentNamespace()
+	ns := client.GetCurrentNamespace()
+	serviceBinding.Spec.Services[0].Namespace = &ns
+
+	_, err := json.MarshalIndent(serviceBinding, " ", " ")
+	if err != nil {
+		return "", err
+	}
+
+	err = ensurePipeline(client, processingPipeline)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = (*processingPipeline).Process(serviceBinding)
+	if err != nil {
+		if kerrors.IsForbidden(err) {
+			// due to https://github.com/redhat-developer/service-binding-operator/issues/1003
+			return "", fmt.Errorf("please install the service binding operator")
+		}
+		return "", err
+	}
+
+	if len(serviceBinding.Status.Secret) == 0 {
+		return "", fmt.Errorf("no secret was provided by service binding's pipleine")
+	}
+
+	// get the generated secret and update it with the labels and owner reference
+	secret, err := client.GetSecret(serviceBinding.Status.Secret, client.GetCurrentNamespace())
+	if err != nil {
+		return "", err
+	}
+	secret.Labels = make(map[string]string, len(labels)+3)
+	for k, v := range labels {
+		secret.Labels[k] = v
+	}
+	secret.Labels[LinkLabel] = linkName
+	if _, ok := serviceCompMap[boundService.Name]; ok {
+		secret.Labels[ServiceLabel] = serviceCompMap[boundService.Name]
+	} else {
+		secret.Labels[ServiceLabel] = boundService.Name
+	}
+	secret.Labels[ServiceKind] = boundService.Kind
+	if boundService.Kind != "Service" {
+		// the service name is stored as kind-name as `/` is not a valid char for labels of kubernetes secrets
+		secret.Labels[ServiceLabel] = fmt.Sprintf("%v-%v", boundService.Kind, boundService.Name)
+	}
+	secret.SetOwnerReferences([]metav1.OwnerReference{ownerReference})
+	_, err = client.UpdateSecret(secret, client.GetCurrentNamespace())
+	if err != nil {
+		return "", err
+	}
+	return secret.Name, nil
+}
+
 // getPipeline gets the pipeline to process service binding requests
 func getPipeline(client kclient.ClientInterface) (sboPipeline.Pipeline, error) {
 	mgr, err := ctrl.NewManager(client.GetClientConfig(), ctrl.Options{
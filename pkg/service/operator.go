@@ -0,0 +1,109 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-openapi/spec"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// GetOperatorCR returns the Operator (CSV) named operatorName and the description of the CR of kind crdKind it provides.
+// The name of the Operator can be passed with or without its version, for example redis-operator.v0.8.0 or redis-operator
+func GetOperatorCR(client kclient.ClientInterface, operatorName string, crdKind string) (*olm.ClusterServiceVersion, *olm.CRDDescription, error) {
+	csvs, err := client.ListClusterServiceVersions()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to list the Operators: %w", err)
+	}
+
+	for i := range csvs.Items {
+		csv := &csvs.Items[i]
+		if csv.Name != operatorName && strings.SplitN(csv.Name, ".", 2)[0] != operatorName {
+			continue
+		}
+		var kinds []string
+		for _, cr := range *client.GetCustomResourcesFromCSV(csv) {
+			customResource := cr
+			if customResource.Kind == crdKind {
+				return csv, &customResource, nil
+			}
+			kinds = append(kinds, customResource.Kind)
+		}
+		return nil, nil, fmt.Errorf("the Operator %q does not provide the CRD %q, the provided CRDs are: %s", csv.Name, crdKind, strings.Join(kinds, ", "))
+	}
+	return nil, nil, fmt.Errorf("unable to find the Operator %q in the namespace, refer to \"kubectl get csv\" to list the installed Operators", operatorName)
+}
+
+// BuildOperatorService builds the custom resource named name, of the kind described by cr,
+// from the parameters or from the YAML content of a file if fileContent is not empty.
+// The spec of the custom resource is validated against the OpenAPI schema of the CRD, if the cluster exposes it
+func BuildOperatorService(client kclient.ClientInterface, cr *olm.CRDDescription, name string, params map[string]string, fileContent []byte) (unstructured.Unstructured, error) {
+	gvr := kclient.GetGVRFromCR(cr)
+
+	crdSpec, err := client.GetResourceSpecDefinition(gvr.Group, gvr.Version, cr.Kind)
+	if err != nil {
+		klog.V(4).Infof("unable to get the OpenAPI schema of %s: %s", cr.Name, err)
+		log.Warningf("Unable to get the definition of %q from the cluster, the service will not be validated", cr.Kind)
+		crdSpec = nil
+	}
+
+	var u unstructured.Unstructured
+	if len(fileContent) > 0 {
+		u, err = buildOperatorServiceFromFile(fileContent, crdSpec, gvr.Group, gvr.Version, cr.Kind)
+	} else {
+		u.Object, err = BuildCRDFromParams(params, crdSpec, gvr.Group, gvr.Version, cr.Kind)
+	}
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+
+	if name != "" {
+		u.SetName(name)
+	}
+	if u.GetName() == "" {
+		u.SetName(strings.ToLower(cr.Kind))
+	}
+	return u, nil
+}
+
+// buildOperatorServiceFromFile builds a custom resource from the YAML content of a file.
+// The file must define a resource of the expected group and kind, with a valid spec
+func buildOperatorServiceFromFile(content []byte, crdSpec *spec.Schema, group, version, kind string) (unstructured.Unstructured, error) {
+	var u unstructured.Unstructured
+	err := yaml.Unmarshal(content, &u.Object)
+	if err != nil {
+		return unstructured.Unstructured{}, fmt.Errorf("unable to parse the file: %w", err)
+	}
+	if u.Object == nil {
+		return unstructured.Unstructured{}, fmt.Errorf("the file is empty")
+	}
+
+	if u.GetKind() == "" {
+		u.SetKind(kind)
+	} else if u.GetKind() != kind {
+		return unstructured.Unstructured{}, fmt.Errorf("the file defines a resource of kind %q, expected %q", u.GetKind(), kind)
+	}
+	if u.GetAPIVersion() == "" {
+		u.SetAPIVersion(group + "/" + version)
+	} else if u.GroupVersionKind().Group != group {
+		return unstructured.Unstructured{}, fmt.Errorf("the file defines a resource of group %q, expected %q", u.GroupVersionKind().Group, group)
+	}
+
+	crSpec, _, err := unstructured.NestedMap(u.Object, "spec")
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	if u.GroupVersionKind().Version == version {
+		err = ValidateCRDSpec(crSpec, crdSpec)
+		if err != nil {
+			return unstructured.Unstructured{}, err
+		}
+	}
+	return u, nil
+}
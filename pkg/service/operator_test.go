@@ -0,0 +1,209 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/golang/mock/gomock"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+func getTestCSVs() *olm.ClusterServiceVersionList {
+	return &olm.ClusterServiceVersionList{
+		Items: []olm.ClusterServiceVersion{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "redis-operator.v0.8.0"},
+				Spec: olm.ClusterServiceVersionSpec{
+					CustomResourceDefinitions: olm.CustomResourceDefinitions{
+						Owned: []olm.CRDDescription{
+							{Name: "redis.redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "Redis"},
+							{Name: "redisclusters.redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "RedisCluster"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetOperatorCR(t *testing.T) {
+	tests := []struct {
+		name         string
+		operatorName string
+		crdKind      string
+		wantErr      bool
+	}{
+		{
+			name:         "operator name with version",
+			operatorName: "redis-operator.v0.8.0",
+			crdKind:      "RedisCluster",
+		},
+		{
+			name:         "operator name without version",
+			operatorName: "redis-operator",
+			crdKind:      "Redis",
+		},
+		{
+			name:         "unknown operator",
+			operatorName: "postgres-operator",
+			crdKind:      "Redis",
+			wantErr:      true,
+		},
+		{
+			name:         "unknown CRD",
+			operatorName: "redis-operator",
+			crdKind:      "Postgres",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := kclient.NewMockClientInterface(ctrl)
+			client.EXPECT().ListClusterServiceVersions().Return(getTestCSVs(), nil)
+			client.EXPECT().GetCustomResourcesFromCSV(gomock.Any()).DoAndReturn(func(csv *olm.ClusterServiceVersion) *[]olm.CRDDescription {
+				return &csv.Spec.CustomResourceDefinitions.Owned
+			}).AnyTimes()
+
+			csv, cr, err := GetOperatorCR(client, tt.operatorName, tt.crdKind)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetOperatorCR() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if csv.Name != "redis-operator.v0.8.0" || cr.Kind != tt.crdKind {
+				t.Errorf("GetOperatorCR() = %s, %s, want redis-operator.v0.8.0, %s", csv.Name, cr.Kind, tt.crdKind)
+			}
+		})
+	}
+}
+
+func TestBuildOperatorService(t *testing.T) {
+	crdSpec := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type: spec.StringOrArray{"object"},
+			Properties: map[string]spec.Schema{
+				"kubernetesConfig": {
+					SchemaProps: spec.SchemaProps{
+						Type: spec.StringOrArray{"object"},
+						Properties: map[string]spec.Schema{
+							"image": *spec.StringProperty(),
+						},
+					},
+				},
+				"size": *spec.Int32Property(),
+			},
+		},
+	}
+	cr := &olm.CRDDescription{Name: "redis.redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "Redis"}
+
+	tests := []struct {
+		name        string
+		serviceName string
+		params      map[string]string
+		fileContent string
+		schemaErr   error
+		wantName    string
+		wantSize    interface{}
+		wantErr     bool
+	}{
+		{
+			name:        "from params",
+			serviceName: "myredis",
+			params:      map[string]string{"kubernetesConfig.image": "redis", "size": "3"},
+			wantName:    "myredis",
+			wantSize:    int64(3),
+		},
+		{
+			name:     "from params with default name",
+			params:   map[string]string{"size": "3"},
+			wantName: "redis",
+			wantSize: int64(3),
+		},
+		{
+			name:    "invalid params",
+			params:  map[string]string{"size": "three"},
+			wantErr: true,
+		},
+		{
+			name:      "params not validated without schema",
+			params:    map[string]string{"unknown": "value"},
+			schemaErr: errors.New("no definition found"),
+			wantName:  "redis",
+		},
+		{
+			name: "from file",
+			fileContent: `apiVersion: redis.redis.opstreelabs.in/v1beta1
+kind: Redis
+metadata:
+  name: fromfile
+spec:
+  size: 2
+`,
+			wantName: "fromfile",
+			wantSize: float64(2),
+		},
+		{
+			name:        "from file with name overridden",
+			serviceName: "myredis",
+			fileContent: `spec:
+  size: 2
+`,
+			wantName: "myredis",
+			wantSize: float64(2),
+		},
+		{
+			name: "from file with another kind",
+			fileContent: `apiVersion: redis.redis.opstreelabs.in/v1beta1
+kind: RedisCluster
+`,
+			wantErr: true,
+		},
+		{
+			name: "from file with invalid spec",
+			fileContent: `spec:
+  size: two
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := kclient.NewMockClientInterface(ctrl)
+			if tt.schemaErr != nil {
+				client.EXPECT().GetResourceSpecDefinition("redis.redis.opstreelabs.in", "v1beta1", "Redis").Return(nil, tt.schemaErr)
+			} else {
+				client.EXPECT().GetResourceSpecDefinition("redis.redis.opstreelabs.in", "v1beta1", "Redis").Return(crdSpec, nil)
+			}
+
+			got, err := BuildOperatorService(client, cr, tt.serviceName, tt.params, []byte(tt.fileContent))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BuildOperatorService() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.GetName() != tt.wantName {
+				t.Errorf("BuildOperatorService() name = %q, want %q", got.GetName(), tt.wantName)
+			}
+			if got.GetAPIVersion() != "redis.redis.opstreelabs.in/v1beta1" || got.GetKind() != "Redis" {
+				t.Errorf("BuildOperatorService() got %s %s", got.GetAPIVersion(), got.GetKind())
+			}
+			if tt.wantSize != nil {
+				size, _, _ := unstructured.NestedFieldNoCopy(got.Object, "spec", "size")
+				if size != tt.wantSize {
+					t.Errorf("BuildOperatorService() size = %#v, want %#v", size, tt.wantSize)
+				}
+			}
+		})
+	}
+}
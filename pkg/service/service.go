@@ -67,22 +67,38 @@ func DeleteOperatorService(client kclient.ClientInterface, serviceName string) e
 	return client.DeleteDynamicResource(name, kclient.GetGVRFromCR(cr), false)
 }
 
+// OperatorBackedService is an instance of a service, with the name of the Operator (CSV) providing it
+type OperatorBackedService struct {
+	Operator string
+	Instance unstructured.Unstructured
+}
+
 // ListOperatorServices lists all operator backed services.
 // It returns list of services, slice of services that it failed (if any) to list and error (if any)
 func ListOperatorServices(client kclient.ClientInterface) ([]unstructured.Unstructured, []string, error) {
-	klog.V(4).Info("Getting list of services")
-
-	// First let's get the list of all the operators in the namespace
-	csvs, err := client.ListClusterServiceVersions()
+	services, failedListingCR, err := ListOperatorServicesWithOperator(client)
 	if err != nil {
 		return nil, nil, err
 	}
+	var allCRInstances []unstructured.Unstructured
+	for _, svc := range services {
+		allCRInstances = append(allCRInstances, svc.Instance)
+	}
+	return allCRInstances, failedListingCR, nil
+}
 
+// ListOperatorServicesWithOperator lists all operator backed services, with the name of the Operator providing them.
+// It returns list of services, slice of services that it failed (if any) to list and error (if any)
+func ListOperatorServicesWithOperator(client kclient.ClientInterface) ([]OperatorBackedService, []string, error) {
+	klog.V(4).Info("Getting list of services")
+
+	// First let's get the list of all the operators in the namespace
+	csvs, err := client.ListClusterServiceVersions()
 	if err != nil {
 		return nil, nil, fmt.Errorf("Unable to list operator backed services: %w", err)
 	}
 
-	var allCRInstances []unstructured.Unstructured
+	var allCRInstances []OperatorBackedService
 	var failedListingCR []string
 
 	// let's get the Services a.k.a Custom Resources (CR) defined by each operator, one by one
@@ -92,7 +108,6 @@ func ListOperatorServices(client kclient.ClientInterface) ([]unstructured.Unstru
 		customResources := client.GetCustomResourcesFromCSV(&clusterServiceVersion)
 
 		// list and write active instances of each service/CR
-		var instances []unstructured.Unstructured
 		for _, cr := range *customResources {
 			customResource := cr
 
@@ -104,13 +119,18 @@ func ListOperatorServices(client kclient.ClientInterface) ([]unstructured.Unstru
 				continue
 			}
 
-			if len(list.Items) > 0 {
-				instances = append(instances, list.Items...)
+			if list == nil {
+				continue
 			}
-		}
 
-		// assuming there are more than one instances of a CR
-		allCRInstances = append(allCRInstances, instances...)
+			// assuming there are more than one instances of a CR
+			for _, instance := range list.Items {
+				allCRInstances = append(allCRInstances, OperatorBackedService{
+					Operator: csv.Name,
+					Instance: instance,
+				})
+			}
+		}
 	}
 
 	return allCRInstances, failedListingCR, nil
@@ -160,11 +180,15 @@ func listDevfileLinks(devfileObj parser.DevfileObj, context string, fs devfilefs
 	}
 	var services []string
 	for _, c := range components {
+		if libdevfile.IsK8sComponentRendered(c.Kubernetes, context, fs) {
+			// the links are not defined in Helm charts or Kustomize directories
+			continue
+		}
 		u, err := libdevfile.GetK8sComponentAsUnstructured(c.Kubernetes, context, fs)
 		if err != nil {
 			return nil, err
 		}
-		if !isLinkResource(u.GetKind()) {
+		if !IsLinkResource(u.GetKind()) {
 			continue
 		}
 		var sbr servicebinding.ServiceBinding
@@ -230,7 +254,7 @@ func PushKubernetesResources(client kclient.ClientInterface, k8sComponents []dev
 
 	if csvSupported {
 		for key, val := range deployed {
-			if isLinkResource(val.Kind) {
+			if IsLinkResource(val.Kind) {
 				continue
 			}
 			err = DeleteOperatorService(client, key)
@@ -247,27 +271,33 @@ func PushKubernetesResources(client kclient.ClientInterface, k8sComponents []dev
 // PushKubernetesResource pushes a Kubernetes resource (u) to the cluster using client
 // adding labels to the resource
 func PushKubernetesResource(client kclient.ClientInterface, u unstructured.Unstructured, labels map[string]string, annotations map[string]string) (bool, error) {
-	if isLinkResource(u.GetKind()) {
+	if IsLinkResource(u.GetKind()) {
 		// it's a service binding related resource
 		return false, nil
 	}
 
-	isOp, err := isOperatorBackedService(client, u)
+	isOp, err := IsOperatorBackedService(client, u)
 	if err != nil {
 		return false, err
 	}
 
+	SetLabelsAndAnnotations(&u, labels, annotations)
+
+	err = createOperatorService(client, u)
+	return isOp, err
+}
+
+// SetLabelsAndAnnotations adds the labels and annotations to the ones of the Kubernetes resource
+func SetLabelsAndAnnotations(u *unstructured.Unstructured, labels map[string]string, annotations map[string]string) {
 	// Add all passed in labels to the k8s resource regardless if it's an operator or not
 	u.SetLabels(mergeMaps(u.GetLabels(), labels))
 
 	// Pass in all annotations to the k8s resource
 	u.SetAnnotations(mergeMaps(u.GetAnnotations(), annotations))
-
-	err = createOperatorService(client, u)
-	return isOp, err
 }
 
-func isOperatorBackedService(client kclient.ClientInterface, u unstructured.Unstructured) (bool, error) {
+// IsOperatorBackedService returns true if the resource is a custom resource provided by an Operator
+func IsOperatorBackedService(client kclient.ClientInterface, u unstructured.Unstructured) (bool, error) {
 	restMapping, err := client.GetRestMappingFromUnstructured(u)
 	if err != nil {
 		return false, err
@@ -323,7 +353,7 @@ func ListDeployedServices(client kclient.ClientInterface, labels map[string]stri
 			deployed[kind+"/"+name] = DeployedInfo{
 				Kind:           kind,
 				Name:           name,
-				isLinkResource: isLinkResource(kind),
+				isLinkResource: IsLinkResource(kind),
 			}
 		}
 	}
@@ -341,7 +371,7 @@ func UpdateServicesWithOwnerReferences(client kclient.ClientInterface, k8sCompon
 			return err
 		}
 
-		if isLinkResource(u.GetKind()) {
+		if IsLinkResource(u.GetKind()) {
 			// ignore service binding resources
 			continue
 		}
@@ -376,7 +406,8 @@ func UpdateServicesWithOwnerReferences(client kclient.ClientInterface, k8sCompon
 	return nil
 }
 
-func isLinkResource(kind string) bool {
+// IsLinkResource returns true if the kind is the kind of a binding resource
+func IsLinkResource(kind string) bool {
 	return kind == "ServiceBinding"
 }
 
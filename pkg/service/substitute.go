@@ -0,0 +1,349 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog"
+
+	sboApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+
+	applabels "github.com/redhat-developer/odo/pkg/application/labels"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// SubstituteAttribute is the attribute of a Kubernetes component describing the container to run in place of the resource
+// during `odo dev`, when the resource is not supported by the cluster (the Operator providing it is not installed)
+const SubstituteAttribute = "dev.odo.substitute"
+
+// SubstituteLabel is the label identifying the resources created to run a dev substitute
+const SubstituteLabel = "odo.dev/substitute"
+
+// SubstituteComponentLabel is the label identifying the component for which a dev substitute is running.
+// The component labels are not used, so the substitute is not considered as part of the component itself
+const SubstituteComponentLabel = "odo.dev/substitute-component"
+
+// Substitute describes the container emulating a service, as defined by the SubstituteAttribute attribute
+type Substitute struct {
+	// Image is the container image to run
+	Image string `json:"image"`
+	// Port is the port exposed by the container, if any
+	Port int `json:"port,omitempty"`
+	// Env is the environment of the container
+	Env map[string]string `json:"env,omitempty"`
+	// Binding is the content of the secret bound to the components, emulating the binding information exposed by the service.
+	// The "host" and "port" entries are added if not defined
+	Binding map[string]string `json:"binding,omitempty"`
+}
+
+// SubstitutedService is a service of the devfile replaced by its dev substitute
+type SubstitutedService struct {
+	Kind       string
+	Name       string
+	Substitute Substitute
+}
+
+// ResourceName returns the name of the resources created on the cluster to run the dev substitute
+func (o SubstitutedService) ResourceName() string {
+	return strings.ToLower(o.Kind) + "-" + o.Name
+}
+
+// GetSubstitute returns the dev substitute defined for a Kubernetes component, or nil if no substitute is defined
+func GetSubstitute(component devfile.Component) (*Substitute, error) {
+	if component.Attributes == nil || !component.Attributes.Exists(SubstituteAttribute) {
+		return nil, nil
+	}
+	var substitute Substitute
+	err := component.Attributes.GetInto(SubstituteAttribute, &substitute)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %q attribute for component %q: %w", SubstituteAttribute, component.Name, err)
+	}
+	if substitute.Image == "" {
+		return nil, fmt.Errorf("invalid %q attribute for component %q: image is required", SubstituteAttribute, component.Name)
+	}
+	return &substitute, nil
+}
+
+// SplitSubstitutedComponents returns the Kubernetes components to push to the cluster,
+// and the services to replace by their dev substitute, because their resource is not supported by the cluster
+func SplitSubstitutedComponents(client kclient.ClientInterface, k8sComponents []devfile.Component, context string) ([]devfile.Component, []SubstitutedService, error) {
+	var toPush []devfile.Component
+	var substituted []SubstitutedService
+	for _, c := range k8sComponents {
+		substitute, err := GetSubstitute(c)
+		if err != nil {
+			return nil, nil, err
+		}
+		if substitute == nil {
+			toPush = append(toPush, c)
+			continue
+		}
+
+		kindErr, err := ValidateResourceExist(client, c, context)
+		if err != nil && kindErr == "" {
+			return nil, nil, err
+		}
+		if kindErr == "" {
+			// the resource is supported by the cluster, no need to substitute it
+			toPush = append(toPush, c)
+			continue
+		}
+
+		u, err := libdevfile.GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, nil, err
+		}
+		klog.V(2).Infof("resource %s/%s is not supported by the cluster, using its dev substitute", u.GetKind(), u.GetName())
+		substituted = append(substituted, SubstitutedService{
+			Kind:       u.GetKind(),
+			Name:       u.GetName(),
+			Substitute: *substitute,
+		})
+	}
+	return toPush, substituted, nil
+}
+
+// PushSubstitutes creates on the cluster the resources running the dev substitutes of a component,
+// and deletes the ones not used anymore.
+// It returns the names of the secrets containing the binding information of the substitutes, indexed by <kind>/<name> of the substituted service
+func PushSubstitutes(client kclient.ClientInterface, substituted []SubstitutedService, componentName string, appName string, ownerReference metav1.OwnerReference) (map[string]string, error) {
+	secrets := map[string]string{}
+	current := map[string]bool{}
+	for _, s := range substituted {
+		resources, err := getSubstituteResources(s, componentName, appName, ownerReference)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range resources {
+			err = client.CreateDynamicResource(u)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create the dev substitute of %s/%s: %w", s.Kind, s.Name, err)
+			}
+		}
+		secrets[s.Kind+"/"+s.Name] = s.ResourceName()
+		current[s.ResourceName()] = true
+		log.Successf("Started the dev substitute of %s/%s", s.Kind, s.Name)
+	}
+
+	err := deleteUnusedSubstitutes(client, componentName, current)
+	if err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// deleteUnusedSubstitutes deletes the resources of the dev substitutes of the component not in current
+func deleteUnusedSubstitutes(client kclient.ClientInterface, componentName string, current map[string]bool) error {
+	selector := util.ConvertLabelsToSelector(map[string]string{SubstituteComponentLabel: componentName})
+
+	deployments, err := client.ListDeployments(selector)
+	if err != nil {
+		return err
+	}
+	deleted := map[string]bool{}
+	for _, d := range deployments.Items {
+		name := d.GetLabels()[SubstituteLabel]
+		if current[name] {
+			continue
+		}
+		err = client.DeleteDeployment(map[string]string{SubstituteLabel: name})
+		if err != nil {
+			return err
+		}
+		deleted[name] = true
+	}
+
+	services, err := client.ListServices(selector)
+	if err != nil {
+		return err
+	}
+	for _, svc := range services {
+		name := svc.GetLabels()[SubstituteLabel]
+		if current[name] {
+			continue
+		}
+		err = client.DeleteService(svc.GetName())
+		if err != nil {
+			return err
+		}
+		deleted[name] = true
+	}
+
+	secrets, err := client.ListSecrets(selector)
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets {
+		name := secret.GetLabels()[SubstituteLabel]
+		if current[name] {
+			continue
+		}
+		err = client.DeleteSecret(secret.GetName(), client.GetCurrentNamespace())
+		if err != nil {
+			return err
+		}
+		deleted[name] = true
+	}
+
+	for name := range deleted {
+		log.Successf("Deleted the dev substitute %q", name)
+	}
+	return nil
+}
+
+// getSubstituteResources returns the Deployment, the Service if a port is exposed, and the Secret containing the binding information,
+// running the dev substitute of a service
+func getSubstituteResources(s SubstitutedService, componentName string, appName string, ownerReference metav1.OwnerReference) ([]unstructured.Unstructured, error) {
+	name := s.ResourceName()
+	labels := applabels.GetLabels(appName, true)
+	labels[SubstituteLabel] = name
+	labels[SubstituteComponentLabel] = componentName
+	selector := map[string]string{SubstituteLabel: name}
+	objectMeta := metav1.ObjectMeta{
+		Name:            name,
+		Labels:          labels,
+		OwnerReferences: []metav1.OwnerReference{ownerReference},
+	}
+
+	var envNames []string
+	for envName := range s.Substitute.Env {
+		envNames = append(envNames, envName)
+	}
+	sort.Strings(envNames)
+	var env []corev1.EnvVar
+	for _, envName := range envNames {
+		env = append(env, corev1.EnvVar{Name: envName, Value: s.Substitute.Env[envName]})
+	}
+
+	container := corev1.Container{
+		Name:  "substitute",
+		Image: s.Substitute.Image,
+		Env:   env,
+	}
+	if s.Substitute.Port != 0 {
+		container.Ports = []corev1.ContainerPort{{ContainerPort: int32(s.Substitute.Port)}}
+	}
+
+	replicas := int32(1)
+	deployment := appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: objectMeta,
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{container},
+				},
+			},
+		},
+	}
+
+	binding := map[string][]byte{}
+	for key, value := range s.Substitute.Binding {
+		binding[key] = []byte(value)
+	}
+
+	objects := []interface{}{&deployment}
+	if s.Substitute.Port != 0 {
+		objects = append(objects, &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: objectMeta,
+			Spec: corev1.ServiceSpec{
+				Selector: selector,
+				Ports: []corev1.ServicePort{{
+					Name:       "port-" + strconv.Itoa(s.Substitute.Port),
+					Port:       int32(s.Substitute.Port),
+					TargetPort: intstr.FromInt(s.Substitute.Port),
+				}},
+			},
+		})
+		if _, ok := binding["host"]; !ok {
+			binding["host"] = []byte(name)
+		}
+		if _, ok := binding["port"]; !ok {
+			binding["port"] = []byte(strconv.Itoa(s.Substitute.Port))
+		}
+	}
+	if len(binding) == 0 {
+		return nil, errors.New("the dev substitute of " + s.Kind + "/" + s.Name + " exposes no binding information, define a port or binding entries")
+	}
+	objects = append(objects, &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: objectMeta,
+		Data:       binding,
+	})
+
+	var result []unstructured.Unstructured
+	for _, obj := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		u := unstructured.Unstructured{Object: content}
+		// status is not applied
+		unstructured.RemoveNestedField(u.Object, "status")
+		unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(u.Object, "spec", "template", "metadata", "creationTimestamp")
+		result = append(result, u)
+	}
+	return result, nil
+}
+
+// substituteServiceRef replaces the reference to a substituted service by a reference to the secret of its dev substitute.
+// It returns true if the reference has been replaced
+func substituteServiceRef(service *sboApi.Service, substitutes map[string]string) bool {
+	secretName, ok := substitutes[service.Kind+"/"+service.Name]
+	if !ok {
+		return false
+	}
+	service.Ref = sboApi.Ref{
+		Version: "v1",
+		Kind:    "Secret",
+		Name:    secretName,
+	}
+	return true
+}
+
+// substituteServiceRefs replaces, in the services of a ServiceBinding, the references to the substituted services
+// by references to the secrets of their dev substitutes
+func substituteServiceRefs(u *unstructured.Unstructured, substitutes map[string]string) error {
+	if len(substitutes) == 0 {
+		return nil
+	}
+	services, found, err := unstructured.NestedSlice(u.Object, "spec", "services")
+	if err != nil || !found {
+		return err
+	}
+	for i := range services {
+		service, ok := services[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		secretName, ok := substitutes[fmt.Sprintf("%v/%v", service["kind"], service["name"])]
+		if !ok {
+			continue
+		}
+		service["group"] = ""
+		service["version"] = "v1"
+		service["kind"] = "Secret"
+		service["name"] = secretName
+		delete(service, "resource")
+	}
+	return unstructured.SetNestedSlice(u.Object, services, "spec", "services")
+}
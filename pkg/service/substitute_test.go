@@ -0,0 +1,268 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/golang/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+const postgresCR = `apiVersion: postgres-operator.crunchydata.com/v1beta1
+kind: PostgresCluster
+metadata:
+  name: mydb
+`
+
+func getSubstitutedComponent(name string, inlined string, substitute interface{}) devfile.Component {
+	component := devfile.Component{
+		Name: name,
+		ComponentUnion: devfile.ComponentUnion{
+			Kubernetes: &devfile.KubernetesComponent{
+				K8sLikeComponent: devfile.K8sLikeComponent{
+					K8sLikeComponentLocation: devfile.K8sLikeComponentLocation{
+						Inlined: inlined,
+					},
+				},
+			},
+		},
+	}
+	if substitute != nil {
+		component.Attributes = attributes.Attributes{}.Put(SubstituteAttribute, substitute, nil)
+	}
+	return component
+}
+
+func getPostgresSubstitute() Substitute {
+	return Substitute{
+		Image: "postgres:13",
+		Port:  5432,
+		Env: map[string]string{
+			"POSTGRES_USER":     "user",
+			"POSTGRES_PASSWORD": "password",
+		},
+		Binding: map[string]string{
+			"type":     "postgresql",
+			"username": "user",
+			"password": "password",
+		},
+	}
+}
+
+func TestGetSubstitute(t *testing.T) {
+	tests := []struct {
+		name       string
+		substitute interface{}
+		want       *Substitute
+		wantErr    bool
+	}{
+		{
+			name: "no substitute",
+		},
+		{
+			name:       "substitute",
+			substitute: getPostgresSubstitute(),
+			want: func() *Substitute {
+				s := getPostgresSubstitute()
+				return &s
+			}(),
+		},
+		{
+			name:       "substitute without image",
+			substitute: map[string]interface{}{"port": 5432},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid substitute",
+			substitute: "postgres:13",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetSubstitute(getSubstitutedComponent("mydb", postgresCR, tt.substitute))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSubstitute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSubstitute() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitSubstitutedComponents(t *testing.T) {
+	tests := []struct {
+		name            string
+		substitute      interface{}
+		supported       bool
+		wantPushed      int
+		wantSubstituted []SubstitutedService
+	}{
+		{
+			name:       "no substitute",
+			wantPushed: 1,
+		},
+		{
+			name:       "substitute for a resource supported by the cluster",
+			substitute: getPostgresSubstitute(),
+			supported:  true,
+			wantPushed: 1,
+		},
+		{
+			name:       "substitute for a resource not supported by the cluster",
+			substitute: getPostgresSubstitute(),
+			wantSubstituted: []SubstitutedService{{
+				Kind:       "PostgresCluster",
+				Name:       "mydb",
+				Substitute: getPostgresSubstitute(),
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := kclient.NewMockClientInterface(ctrl)
+			if tt.substitute != nil {
+				if tt.supported {
+					client.EXPECT().GetRestMappingFromUnstructured(gomock.Any()).Return(&meta.RESTMapping{}, nil)
+				} else {
+					client.EXPECT().GetRestMappingFromUnstructured(gomock.Any()).Return(nil, errors.New("no matches for kind"))
+				}
+			}
+
+			components := []devfile.Component{getSubstitutedComponent("mydb", postgresCR, tt.substitute)}
+			gotPushed, gotSubstituted, err := SplitSubstitutedComponents(client, components, "")
+			if err != nil {
+				t.Fatalf("SplitSubstitutedComponents() error = %v", err)
+			}
+			if len(gotPushed) != tt.wantPushed {
+				t.Errorf("SplitSubstitutedComponents() pushed = %d components, want %d", len(gotPushed), tt.wantPushed)
+			}
+			if !reflect.DeepEqual(gotSubstituted, tt.wantSubstituted) {
+				t.Errorf("SplitSubstitutedComponents() substituted = %+v, want %+v", gotSubstituted, tt.wantSubstituted)
+			}
+		})
+	}
+}
+
+func TestPushSubstitutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := kclient.NewMockClientInterface(ctrl)
+	client.EXPECT().GetCurrentNamespace().Return("project").AnyTimes()
+
+	var created []unstructured.Unstructured
+	client.EXPECT().CreateDynamicResource(gomock.Any()).DoAndReturn(func(u unstructured.Unstructured) error {
+		created = append(created, u)
+		return nil
+	}).Times(3)
+
+	selector := SubstituteComponentLabel + "=my-nodejs-app"
+	stale := map[string]string{SubstituteLabel: "redis-myredis", SubstituteComponentLabel: "my-nodejs-app"}
+	current := map[string]string{SubstituteLabel: "postgrescluster-mydb", SubstituteComponentLabel: "my-nodejs-app"}
+	client.EXPECT().ListDeployments(selector).Return(&appsv1.DeploymentList{Items: []appsv1.Deployment{
+		{ObjectMeta: metav1.ObjectMeta{Name: "redis-myredis", Labels: stale}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "postgrescluster-mydb", Labels: current}},
+	}}, nil)
+	client.EXPECT().DeleteDeployment(map[string]string{SubstituteLabel: "redis-myredis"}).Return(nil)
+	client.EXPECT().ListServices(selector).Return([]corev1.Service{
+		{ObjectMeta: metav1.ObjectMeta{Name: "redis-myredis", Labels: stale}},
+	}, nil)
+	client.EXPECT().DeleteService("redis-myredis").Return(nil)
+	client.EXPECT().ListSecrets(selector).Return([]corev1.Secret{
+		{ObjectMeta: metav1.ObjectMeta{Name: "postgrescluster-mydb", Labels: current}},
+	}, nil)
+
+	substituted := []SubstitutedService{{Kind: "PostgresCluster", Name: "mydb", Substitute: getPostgresSubstitute()}}
+	got, err := PushSubstitutes(client, substituted, "my-nodejs-app", "app", metav1.OwnerReference{Name: "my-nodejs-app-app"})
+	if err != nil {
+		t.Fatalf("PushSubstitutes() error = %v", err)
+	}
+	want := map[string]string{"PostgresCluster/mydb": "postgrescluster-mydb"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PushSubstitutes() = %v, want %v", got, want)
+	}
+
+	var kinds []string
+	for _, u := range created {
+		kinds = append(kinds, u.GetKind())
+		if u.GetName() != "postgrescluster-mydb" {
+			t.Errorf("resource %s has name %q, want %q", u.GetKind(), u.GetName(), "postgrescluster-mydb")
+		}
+		if len(u.GetOwnerReferences()) != 1 {
+			t.Errorf("resource %s should be owned by the component", u.GetKind())
+		}
+	}
+	if !reflect.DeepEqual(kinds, []string{"Deployment", "Service", "Secret"}) {
+		t.Errorf("created resources = %v", kinds)
+	}
+
+	data, _, _ := unstructured.NestedStringMap(created[2].Object, "data")
+	wantData := map[string]string{
+		"type":     "postgresql",
+		"username": "user",
+		"password": "password",
+		"host":     "postgrescluster-mydb",
+		"port":     "5432",
+	}
+	for key, value := range wantData {
+		decoded, err := base64.StdEncoding.DecodeString(data[key])
+		if err != nil || string(decoded) != value {
+			t.Errorf("binding secret entry %q = %q, want %q", key, decoded, value)
+		}
+	}
+}
+
+func TestSubstituteServiceRefs(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"services": []interface{}{
+				map[string]interface{}{
+					"group":   "postgres-operator.crunchydata.com",
+					"version": "v1beta1",
+					"kind":    "PostgresCluster",
+					"name":    "mydb",
+				},
+				map[string]interface{}{
+					"group":   "redis.redis.opstreelabs.in",
+					"version": "v1beta1",
+					"kind":    "Redis",
+					"name":    "myredis",
+				},
+			},
+		},
+	}}
+	err := substituteServiceRefs(&u, map[string]string{"PostgresCluster/mydb": "postgrescluster-mydb"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	services, _, _ := unstructured.NestedSlice(u.Object, "spec", "services")
+	want := []interface{}{
+		map[string]interface{}{
+			"group":   "",
+			"version": "v1",
+			"kind":    "Secret",
+			"name":    "postgrescluster-mydb",
+		},
+		map[string]interface{}{
+			"group":   "redis.redis.opstreelabs.in",
+			"version": "v1beta1",
+			"kind":    "Redis",
+			"name":    "myredis",
+		},
+	}
+	if !reflect.DeepEqual(services, want) {
+		t.Errorf("substituteServiceRefs() services = %v, want %v", services, want)
+	}
+}
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+)
+
+const (
+	// DependencyCacheAttribute is the top-level attribute of the devfile enabling or disabling the dependency cache,
+	// overriding the DependencyCache preference
+	DependencyCacheAttribute = "dev.odo.dependency-cache"
+
+	// OdoDependencyCacheVolume is the name of the volume mounting the dependency cache PVC into the containers
+	OdoDependencyCacheVolume = "odo-dependency-cache"
+
+	// DependencyCacheSize is the size of the dependency cache PVCs
+	DependencyCacheSize = "5Gi"
+
+	// dependencyCacheMountPath is the directory in which the directories of the dependency cache are mounted
+	dependencyCacheMountPath = "/opt/odo/cache"
+)
+
+// DependencyCacheDir is a directory of the dependency cache, used by a build tool through an environment variable
+type DependencyCacheDir struct {
+	// Name of the directory in the dependency cache PVC
+	Name string
+	// Env is the environment variable pointing the build tool to the directory
+	Env string
+	// Value of the environment variable, %s is replaced with the mount path of the directory
+	Value string
+}
+
+// MountPath returns the path where the directory is mounted in the containers
+func (o DependencyCacheDir) MountPath() string {
+	return path.Join(dependencyCacheMountPath, o.Name)
+}
+
+// EnvValue returns the value of the environment variable pointing the build tool to the directory
+func (o DependencyCacheDir) EnvValue() string {
+	return fmt.Sprintf(o.Value, o.MountPath())
+}
+
+// dependencyCacheDirs are the directories of the dependency cache for each language
+var dependencyCacheDirs = map[string][]DependencyCacheDir{
+	"java": {
+		{Name: "m2", Env: "MAVEN_OPTS", Value: "-Dmaven.repo.local=%s"},
+		{Name: "gradle", Env: "GRADLE_USER_HOME", Value: "%s"},
+	},
+	"nodejs": {
+		{Name: "npm", Env: "npm_config_cache", Value: "%s"},
+		{Name: "yarn", Env: "YARN_CACHE_FOLDER", Value: "%s"},
+	},
+	"go": {
+		{Name: "gomod", Env: "GOMODCACHE", Value: "%s"},
+		{Name: "gobuild", Env: "GOCACHE", Value: "%s"},
+	},
+	"python": {
+		{Name: "pip", Env: "PIP_CACHE_DIR", Value: "%s"},
+	},
+}
+
+// languageAliases are the values of the language field of the devfile metadata sharing the same dependency cache
+var languageAliases = map[string]string{
+	"javascript": "nodejs",
+	"typescript": "nodejs",
+	"node":       "nodejs",
+	"node.js":    "nodejs",
+	"golang":     "go",
+}
+
+// GetDependencyCacheDirs returns the directories of the dependency cache of the language
+func GetDependencyCacheDirs(language string) []DependencyCacheDir {
+	return dependencyCacheDirs[language]
+}
+
+// GetDependencyCachePVCName returns the name of the PVC of the dependency cache of the language
+func GetDependencyCachePVCName(language string) string {
+	return fmt.Sprintf("%s-%s", OdoDependencyCacheVolume, language)
+}
+
+// GetDependencyCacheLanguage returns the language of the dependency cache to use for the devfile,
+// detected from the language of the devfile metadata.
+// An empty string is returned if the dependency cache is disabled, or if the language has no dependency cache.
+// The DependencyCacheAttribute of the devfile overrides the enabled value
+func GetDependencyCacheLanguage(devfileObj parser.DevfileObj, enabled bool) (string, error) {
+	// top-level attributes are not supported by the 2.0.0 schema
+	attributes, err := devfileObj.Data.GetAttributes()
+	if err == nil && attributes.Exists(DependencyCacheAttribute) {
+		enabled = attributes.GetBoolean(DependencyCacheAttribute, &err)
+		if err != nil {
+			return "", fmt.Errorf("invalid %q attribute: %w", DependencyCacheAttribute, err)
+		}
+	}
+	if !enabled {
+		return "", nil
+	}
+
+	language := strings.ToLower(devfileObj.Data.GetMetadata().Language)
+	if alias, ok := languageAliases[language]; ok {
+		language = alias
+	}
+	if _, ok := dependencyCacheDirs[language]; !ok {
+		return "", nil
+	}
+	return language, nil
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/devfile"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	v2 "github.com/devfile/library/pkg/devfile/parser/data/v2"
+)
+
+func TestGetDependencyCacheLanguage(t *testing.T) {
+	getDevfileObj := func(schemaVersion string, language string, attribute interface{}) parser.DevfileObj {
+		devfileData, err := data.NewDevfileData(schemaVersion)
+		if err != nil {
+			t.Fatal(err)
+		}
+		devfileData.SetSchemaVersion(schemaVersion)
+		devfileData.SetMetadata(devfile.DevfileMetadata{Name: "my-component", Language: language})
+		if attribute != nil {
+			devfileData.(*v2.DevfileV2).Attributes = attributes.Attributes{}.Put(DependencyCacheAttribute, attribute, nil)
+		}
+		return parser.DevfileObj{Data: devfileData}
+	}
+
+	tests := []struct {
+		name       string
+		devfileObj parser.DevfileObj
+		enabled    bool
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "enabled by the preference",
+			devfileObj: getDevfileObj(string(data.APISchemaVersion200), "Java", nil),
+			enabled:    true,
+			want:       "java",
+		},
+		{
+			name:       "disabled by the preference",
+			devfileObj: getDevfileObj(string(data.APISchemaVersion200), "Java", nil),
+		},
+		{
+			name:       "language alias",
+			devfileObj: getDevfileObj(string(data.APISchemaVersion200), "TypeScript", nil),
+			enabled:    true,
+			want:       "nodejs",
+		},
+		{
+			name:       "language without dependency cache",
+			devfileObj: getDevfileObj(string(data.APISchemaVersion200), "COBOL", nil),
+			enabled:    true,
+		},
+		{
+			name:       "enabled by the devfile attribute",
+			devfileObj: getDevfileObj(string(data.APISchemaVersion220), "Go", true),
+			want:       "go",
+		},
+		{
+			name:       "disabled by the devfile attribute",
+			devfileObj: getDevfileObj(string(data.APISchemaVersion220), "Go", false),
+			enabled:    true,
+		},
+		{
+			name:       "invalid devfile attribute",
+			devfileObj: getDevfileObj(string(data.APISchemaVersion220), "Go", "sometimes"),
+			enabled:    true,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetDependencyCacheLanguage(tt.devfileObj, tt.enabled)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetDependencyCacheLanguage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetDependencyCacheLanguage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
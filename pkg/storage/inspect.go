@@ -0,0 +1,231 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/library/pkg/devfile/generator"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/envinfo"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	storagelabels "github.com/redhat-developer/odo/pkg/storage/labels"
+)
+
+// VolumeMount is the mount of a volume into a container
+type VolumeMount struct {
+	Container string `json:"container"`
+	Path      string `json:"path"`
+}
+
+// VolumeUsage is the disk usage of a volume, in bytes, as reported by `df`
+type VolumeUsage struct {
+	Total     int64 `json:"total"`
+	Used      int64 `json:"used"`
+	Available int64 `json:"available"`
+}
+
+// VolumeInfo describes a volume of the devfile and its state on the cluster
+type VolumeInfo struct {
+	Name      string        `json:"name"`
+	PVC       string        `json:"pvc,omitempty"`
+	Size      string        `json:"size,omitempty"`
+	Ephemeral bool          `json:"ephemeral"`
+	Mounts    []VolumeMount `json:"mounts,omitempty"`
+	Usage     *VolumeUsage  `json:"usage,omitempty"`
+	Status    StorageStatus `json:"status"`
+}
+
+// ListVolumes returns the volumes defined in the devfile, with their PVC and mounts, and their usage if the component is running.
+// The PVCs of the component not referenced anymore by a volume of the devfile are returned with the StateTypeLocallyDeleted status
+func ListVolumes(client kclient.ClientInterface, devfileObj parser.DevfileObj, componentName string) ([]VolumeInfo, error) {
+	components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pvcs, err := listComponentPVCs(client, componentName)
+	if err != nil {
+		return nil, err
+	}
+	pvcByVolume := map[string]corev1.PersistentVolumeClaim{}
+	for _, pvc := range pvcs {
+		pvcByVolume[pvc.Labels[storagelabels.DevfileStorageLabel]] = pvc
+	}
+
+	var pod *corev1.Pod
+	if p, e := client.GetPodUsingComponentName(componentName); e == nil && p.Status.Phase == corev1.PodRunning {
+		pod = p
+	}
+
+	var result []VolumeInfo
+	volumes := map[string]bool{}
+	for _, component := range components {
+		if component.Volume == nil {
+			continue
+		}
+		volumes[component.Name] = true
+		info := VolumeInfo{
+			Name:      component.Name,
+			Size:      component.Volume.Size,
+			Ephemeral: component.Volume.Ephemeral != nil && *component.Volume.Ephemeral,
+			Status:    StateTypeNotPushed,
+		}
+		if info.Size == "" {
+			info.Size = envinfo.DefaultVolumeSize
+		}
+
+		for _, c := range components {
+			if c.Container == nil {
+				continue
+			}
+			for _, volumeMount := range c.Container.VolumeMounts {
+				if volumeMount.Name == component.Name {
+					info.Mounts = append(info.Mounts, VolumeMount{
+						Container: c.Name,
+						Path:      generator.GetVolumeMountPath(volumeMount),
+					})
+				}
+			}
+		}
+
+		if pvc, ok := pvcByVolume[component.Name]; ok && !info.Ephemeral {
+			info.PVC = pvc.Name
+			size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			info.Size = size.String()
+			info.Status = StateTypePushed
+		}
+		if info.Ephemeral && pod != nil {
+			info.Status = StateTypePushed
+		}
+
+		if pod != nil && info.Status == StateTypePushed && len(info.Mounts) > 0 {
+			usage, e := getVolumeUsage(client, pod.Name, info.Mounts[0])
+			if e != nil {
+				klog.V(2).Infof("unable to get the usage of volume %q: %s", info.Name, e)
+			} else {
+				info.Usage = usage
+			}
+		}
+		result = append(result, info)
+	}
+
+	for _, pvc := range pvcs {
+		volumeName := pvc.Labels[storagelabels.DevfileStorageLabel]
+		if volumes[volumeName] {
+			continue
+		}
+		size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		result = append(result, VolumeInfo{
+			Name:   volumeName,
+			PVC:    pvc.Name,
+			Size:   size.String(),
+			Status: StateTypeLocallyDeleted,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// ListOrphanPVCs returns the PVCs created by odo for the component, not referenced anymore by a volume of the devfile.
+// The PVCs still used by the pod of the component are not returned
+func ListOrphanPVCs(client kclient.ClientInterface, devfileObj parser.DevfileObj, componentName string) ([]corev1.PersistentVolumeClaim, error) {
+	components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	volumes := map[string]bool{}
+	for _, component := range components {
+		if component.Volume != nil && (component.Volume.Ephemeral == nil || !*component.Volume.Ephemeral) {
+			volumes[component.Name] = true
+		}
+	}
+
+	pvcs, err := listComponentPVCs(client, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]bool{}
+	if pod, e := client.GetPodUsingComponentName(componentName); e == nil {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				used[volume.PersistentVolumeClaim.ClaimName] = true
+			}
+		}
+	}
+
+	var result []corev1.PersistentVolumeClaim
+	for _, pvc := range pvcs {
+		if volumes[pvc.Labels[storagelabels.DevfileStorageLabel]] {
+			continue
+		}
+		if used[pvc.Name] {
+			klog.V(2).Infof("PVC %q is still used by the component, run `odo dev` to update the component", pvc.Name)
+			continue
+		}
+		result = append(result, pvc)
+	}
+	return result, nil
+}
+
+// listComponentPVCs returns the PVCs created by odo for the volumes of the component, except the source PVC
+func listComponentPVCs(client kclient.ClientInterface, componentName string) ([]corev1.PersistentVolumeClaim, error) {
+	selector := fmt.Sprintf("component=%s,%s!=odo-projects", componentName, storagelabels.SourcePVCLabel)
+	pvcs, err := client.ListPVCs(selector)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get PVC using selector %v: %w", selector, err)
+	}
+	var result []corev1.PersistentVolumeClaim
+	for _, pvc := range pvcs {
+		if _, ok := pvc.Labels[storagelabels.DevfileStorageLabel]; ok {
+			result = append(result, pvc)
+		}
+	}
+	return result, nil
+}
+
+// getVolumeUsage returns the usage of the volume mounted in a container of the pod, by executing `df` in the container
+func getVolumeUsage(client kclient.ClientInterface, podName string, mount VolumeMount) (*VolumeUsage, error) {
+	var stdout, stderr bytes.Buffer
+	err := client.ExecCMDInContainer(mount.Container, podName, []string{"df", "-Pk", mount.Path}, &stdout, &stderr, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, stderr.String())
+	}
+	return parseDfOutput(stdout.String())
+}
+
+// parseDfOutput parses the output of `df -Pk <path>`, in the POSIX format:
+// Filesystem 1024-blocks Used Available Capacity Mounted on
+func parseDfOutput(output string) (*VolumeUsage, error) {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) < 2 {
+		return nil, fmt.Errorf("unexpected df output: %q", output)
+	}
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("unexpected df output: %q", output)
+	}
+	var values [3]int64
+	for i := range values {
+		value, err := strconv.ParseInt(fields[i+1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected df output: %q", output)
+		}
+		values[i] = value * 1024
+	}
+	return &VolumeUsage{
+		Total:     values[0],
+		Used:      values[1],
+		Available: values[2],
+	}, nil
+}
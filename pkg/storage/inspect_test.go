@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	devfileCtx "github.com/devfile/library/pkg/devfile/parser/context"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	storagelabels "github.com/redhat-developer/odo/pkg/storage/labels"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func getInspectDevfileObj(t *testing.T) parser.DevfileObj {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]devfilev1.Component{
+		{
+			Name: "runtime",
+			ComponentUnion: devfilev1.ComponentUnion{
+				Container: &devfilev1.ContainerComponent{
+					Container: devfilev1.Container{
+						Image: "quay.io/nodejs-12",
+						VolumeMounts: []devfilev1.VolumeMount{
+							{Name: "db-data", Path: "/data"},
+							{Name: "cache"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "db-data",
+			ComponentUnion: devfilev1.ComponentUnion{
+				Volume: &devfilev1.VolumeComponent{Volume: devfilev1.Volume{Size: "2Gi"}},
+			},
+		},
+		{
+			Name: "cache",
+			ComponentUnion: devfilev1.ComponentUnion{
+				Volume: &devfilev1.VolumeComponent{Volume: devfilev1.Volume{Ephemeral: util.GetBoolPtr(true)}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parser.DevfileObj{
+		Data: devfileData,
+		Ctx:  devfileCtx.FakeContext(devfilefs.NewFakeFs(), "/devfile.yaml"),
+	}
+}
+
+func getComponentPVC(name string, volumeName string, size string) corev1.PersistentVolumeClaim {
+	return corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"component": "my-component", storagelabels.DevfileStorageLabel: volumeName},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+			},
+		},
+	}
+}
+
+const pvcSelector = "component=my-component,odo-source-pvc!=odo-projects"
+
+func TestListVolumes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := kclient.NewMockClientInterface(ctrl)
+	client.EXPECT().ListPVCs(pvcSelector).Return([]corev1.PersistentVolumeClaim{
+		getComponentPVC("db-data-my-component-app", "db-data", "2Gi"),
+		getComponentPVC("old-data-my-component-app", "old-data", "1Gi"),
+	}, nil)
+	client.EXPECT().GetPodUsingComponentName("my-component").Return(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}, nil)
+	client.EXPECT().ExecCMDInContainer("runtime", "pod", []string{"df", "-Pk", "/data"}, gomock.Any(), gomock.Any(), nil, false).
+		DoAndReturn(func(_, _ string, _ []string, stdout io.Writer, _ io.Writer, _ io.Reader, _ bool) error {
+			_, err := stdout.Write([]byte("Filesystem     1024-blocks  Used Available Capacity Mounted on\n/dev/sdb 2000 500 1500 25% /data\n"))
+			return err
+		})
+	client.EXPECT().ExecCMDInContainer("runtime", "pod", []string{"df", "-Pk", "/cache"}, gomock.Any(), gomock.Any(), nil, false).
+		Return(errors.New("df: not found"))
+
+	got, err := ListVolumes(client, getInspectDevfileObj(t), "my-component")
+	if err != nil {
+		t.Fatalf("ListVolumes() error = %v", err)
+	}
+	want := []VolumeInfo{
+		{
+			Name:      "cache",
+			Size:      "1Gi",
+			Ephemeral: true,
+			Mounts:    []VolumeMount{{Container: "runtime", Path: "/cache"}},
+			Status:    StateTypePushed,
+		},
+		{
+			Name:   "db-data",
+			PVC:    "db-data-my-component-app",
+			Size:   "2Gi",
+			Mounts: []VolumeMount{{Container: "runtime", Path: "/data"}},
+			Usage:  &VolumeUsage{Total: 2000 * 1024, Used: 500 * 1024, Available: 1500 * 1024},
+			Status: StateTypePushed,
+		},
+		{
+			Name:   "old-data",
+			PVC:    "old-data-my-component-app",
+			Size:   "1Gi",
+			Status: StateTypeLocallyDeleted,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListVolumes() = %+v, want %+v", got, want)
+	}
+}
+
+func TestListOrphanPVCs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := kclient.NewMockClientInterface(ctrl)
+	client.EXPECT().ListPVCs(pvcSelector).Return([]corev1.PersistentVolumeClaim{
+		getComponentPVC("db-data-my-component-app", "db-data", "2Gi"),
+		getComponentPVC("old-data-my-component-app", "old-data", "1Gi"),
+		getComponentPVC("mounted-my-component-app", "mounted", "1Gi"),
+	}, nil)
+	client.EXPECT().GetPodUsingComponentName("my-component").Return(&corev1.Pod{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{{
+				Name: "mounted-my-component-app-vol",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "mounted-my-component-app"},
+				},
+			}},
+		},
+	}, nil)
+
+	got, err := ListOrphanPVCs(client, getInspectDevfileObj(t), "my-component")
+	if err != nil {
+		t.Fatalf("ListOrphanPVCs() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "old-data-my-component-app" {
+		t.Errorf("ListOrphanPVCs() = %v, want only old-data-my-component-app", got)
+	}
+}
+
+func TestParseDfOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    *VolumeUsage
+		wantErr bool
+	}{
+		{
+			name:   "posix output",
+			output: "Filesystem     1024-blocks  Used Available Capacity Mounted on\n/dev/sdb          1014656  1234    996000       1% /data\n",
+			want:   &VolumeUsage{Total: 1014656 * 1024, Used: 1234 * 1024, Available: 996000 * 1024},
+		},
+		{
+			name:    "no data",
+			output:  "Filesystem     1024-blocks  Used Available Capacity Mounted on\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid numbers",
+			output:  "Filesystem     1024-blocks  Used Available Capacity Mounted on\n/dev/sdb 1G 1M 1G 1% /data\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDfOutput(tt.output)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseDfOutput() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseDfOutput() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
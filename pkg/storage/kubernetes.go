@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/devfile/library/pkg/devfile/generator"
+	applabels "github.com/redhat-developer/odo/pkg/application/labels"
 	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
 	storagelabels "github.com/redhat-developer/odo/pkg/storage/labels"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -61,6 +63,25 @@ func (k kubernetesClient) Create(storage Storage) error {
 	}
 	pvc := generator.GetPVC(pvcParams)
 
+	storageClass := storage.Spec.StorageClass
+	if storageClass == "" {
+		storageClass = k.storageClass
+	}
+	if storageClass != "" {
+		pvc.Spec.StorageClassName = &storageClass
+	}
+
+	accessModes := storage.Spec.AccessModes
+	if len(accessModes) == 0 && k.accessMode != "" {
+		accessModes = []string{k.accessMode}
+	}
+	if len(accessModes) > 0 {
+		pvc.Spec.AccessModes = nil
+		for _, accessMode := range accessModes {
+			pvc.Spec.AccessModes = append(pvc.Spec.AccessModes, corev1.PersistentVolumeAccessMode(accessMode))
+		}
+	}
+
 	// Create PVC
 	klog.V(2).Infof("Creating a PVC with name %v and labels %v", pvcName, labels)
 	_, err = k.client.CreatePVC(*pvc)
@@ -70,6 +91,93 @@ func (k kubernetesClient) Create(storage Storage) error {
 	return nil
 }
 
+// Resize expands the pvc belonging to the given Storage to the size of the Storage.
+// It returns an error if the StorageClass of the pvc does not allow volume expansion
+func (k kubernetesClient) Resize(storage Storage) error {
+	pvcName, err := getPVCNameFromStorageName(k.client, storage.Name)
+	if err != nil {
+		return err
+	}
+
+	pvc, err := k.client.GetPVCFromName(pvcName)
+	if err != nil {
+		return fmt.Errorf("unable to get PVC %v: %w", pvcName, err)
+	}
+
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return fmt.Errorf("unable to resize storage %v: the PVC %v has no StorageClass, delete the component with `odo delete component` to recreate the storage with the new size", storage.Name, pvcName)
+	}
+	storageClass, err := k.client.GetStorageClass(*pvc.Spec.StorageClassName)
+	if err != nil {
+		return fmt.Errorf("unable to get StorageClass %v: %w", *pvc.Spec.StorageClassName, err)
+	}
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		return fmt.Errorf("unable to resize storage %v: the StorageClass %v does not allow volume expansion, delete the component with `odo delete component` to recreate the storage with the new size", storage.Name, storageClass.Name)
+	}
+
+	quantity, err := resource.ParseQuantity(storage.Spec.Size)
+	if err != nil {
+		return fmt.Errorf("unable to parse size: %v: %w", storage.Spec.Size, err)
+	}
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = quantity
+
+	klog.V(2).Infof("Resizing the PVC %v to %v", pvcName, storage.Spec.Size)
+	return k.client.UpdatePVC(pvc)
+}
+
+// CreateDependencyCache creates the pvc of the dependency cache of the language, shared by all the components of the namespace.
+// The pvc is not labelled with the component labels, so it is not deleted with the components.
+// As the pvc is shared, it is requested with the ReadWriteMany access mode. If the pvc cannot be created with this access mode,
+// it is created with the access mode of the preferences, or ReadWriteOnce, and the cache can be used by a single node at a time.
+// It returns false if the pvc already exists
+func (k kubernetesClient) CreateDependencyCache(language string) (bool, error) {
+	selector := fmt.Sprintf("%s=%s", storagelabels.DependencyCacheLabel, language)
+	pvcs, err := k.client.ListPVCs(selector)
+	if err != nil {
+		return false, fmt.Errorf("unable to get PVC using selector %v: %w", selector, err)
+	}
+	if len(pvcs) > 0 {
+		return false, nil
+	}
+
+	labels := map[string]string{
+		applabels.ManagedBy:                "odo",
+		storagelabels.DependencyCacheLabel: language,
+	}
+	objectMeta := generator.GetObjectMeta(GetDependencyCachePVCName(language), k.client.GetCurrentNamespace(), labels, nil)
+	pvc := generator.GetPVC(generator.PVCParams{
+		ObjectMeta: objectMeta,
+		Quantity:   resource.MustParse(DependencyCacheSize),
+	})
+	if k.storageClass != "" {
+		storageClass := k.storageClass
+		pvc.Spec.StorageClassName = &storageClass
+	}
+	pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+
+	klog.V(2).Infof("Creating the dependency cache PVC %v", pvc.Name)
+	_, err = k.client.CreatePVC(*pvc)
+	if err == nil {
+		return true, nil
+	}
+
+	fallbackMode := corev1.ReadWriteOnce
+	if k.accessMode != "" && k.accessMode != string(corev1.ReadWriteMany) {
+		fallbackMode = corev1.PersistentVolumeAccessMode(k.accessMode)
+	}
+	log.Warningf("Unable to create the dependency cache PVC %v with the %v access mode, creating it with the %v access mode: "+
+		"the components using the cache must run on the same node: %v", pvc.Name, corev1.ReadWriteMany, fallbackMode, err)
+	pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{fallbackMode}
+	_, err = k.client.CreatePVC(*pvc)
+	if err != nil {
+		return false, fmt.Errorf("unable to create dependency cache PVC: %w", err)
+	}
+	return true, nil
+}
+
 // Delete deletes the pvc belonging to the given Storage
 func (k kubernetesClient) Delete(name string) error {
 	pvcName, err := getPVCNameFromStorageName(k.client, name)
@@ -122,7 +230,7 @@ func (k kubernetesClient) ListFromCluster() (StorageList, error) {
 			// and the source volume mount
 			_, initOK := initContainerVolumeMounts[volumeMount.Name]
 			_, ok := containerVolumeMounts[volumeMount.Name]
-			if (!ok && initOK) || volumeMount.Name == OdoSourceVolume || volumeMount.Name == OdoSupervisordVolume {
+			if (!ok && initOK) || volumeMount.Name == OdoSourceVolume || volumeMount.Name == OdoSupervisordVolume || volumeMount.Name == OdoDependencyCacheVolume {
 				continue
 			}
 
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"errors"
 	"reflect"
 	"strings"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/util"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -646,10 +648,12 @@ func Test_kubernetesClient_Create(t *testing.T) {
 		storage Storage
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
+		name             string
+		fields           fields
+		args             args
+		wantErr          bool
+		wantStorageClass string
+		wantAccessModes  []corev1.PersistentVolumeAccessMode
 	}{
 		{
 			name: "case 1: valid storage",
@@ -688,6 +692,43 @@ func Test_kubernetesClient_Create(t *testing.T) {
 				storage: NewStorageWithContainer("odo-projects-vol", "5Gi", "/data", "runtime", util.GetBoolPtr(false)),
 			},
 		},
+		{
+			name: "case 4: storage class and access mode of the preferences",
+			fields: fields{
+				generic: generic{
+					appName:       "app",
+					componentName: "nodejs",
+					storageClass:  "standard",
+					accessMode:    "ReadWriteMany",
+				},
+			},
+			args: args{
+				storage: NewStorageWithContainer("storage-0", "5Gi", "/data", "runtime", util.GetBoolPtr(false)),
+			},
+			wantStorageClass: "standard",
+			wantAccessModes:  []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+		},
+		{
+			name: "case 5: storage class and access modes of the storage override the preferences",
+			fields: fields{
+				generic: generic{
+					appName:       "app",
+					componentName: "nodejs",
+					storageClass:  "standard",
+					accessMode:    "ReadWriteOnce",
+				},
+			},
+			args: args{
+				storage: func() Storage {
+					storage := NewStorageWithContainer("storage-0", "5Gi", "/data", "runtime", util.GetBoolPtr(false))
+					storage.Spec.StorageClass = "nfs-client"
+					storage.Spec.AccessModes = []string{"ReadWriteMany", "ReadOnlyMany"}
+					return storage
+				}(),
+			},
+			wantStorageClass: "nfs-client",
+			wantAccessModes:  []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany, corev1.ReadOnlyMany},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -742,6 +783,152 @@ func Test_kubernetesClient_Create(t *testing.T) {
 			if !reflect.DeepEqual(createdPVC.Name, wantedPVCName) {
 				t.Errorf("name of the PVC is not matching to expected name, expected: %v, got %v", wantedPVCName, createdPVC.Name)
 			}
+
+			gotStorageClass := ""
+			if createdPVC.Spec.StorageClassName != nil {
+				gotStorageClass = *createdPVC.Spec.StorageClassName
+			}
+			if gotStorageClass != tt.wantStorageClass {
+				t.Errorf("storage class of the PVC is not matching, expected: %q, got %q", tt.wantStorageClass, gotStorageClass)
+			}
+			if tt.wantAccessModes != nil && !reflect.DeepEqual(createdPVC.Spec.AccessModes, tt.wantAccessModes) {
+				t.Errorf("access modes of the PVC are not matching, expected: %v, got %v", tt.wantAccessModes, createdPVC.Spec.AccessModes)
+			}
+		})
+	}
+}
+
+func Test_kubernetesClient_Resize(t *testing.T) {
+	storageClass := "standard"
+	getPVC := func() *corev1.PersistentVolumeClaim {
+		pvc := testingutil.FakePVC("pvc-0", "5Gi", getStorageLabels("storage-0", "nodejs", "app"))
+		pvc.Spec.StorageClassName = &storageClass
+		return pvc
+	}
+
+	tests := []struct {
+		name                 string
+		allowVolumeExpansion *bool
+		wantErr              bool
+	}{
+		{
+			name:                 "case 1: storage class allowing volume expansion",
+			allowVolumeExpansion: util.GetBoolPtr(true),
+		},
+		{
+			name:                 "case 2: storage class not allowing volume expansion",
+			allowVolumeExpansion: util.GetBoolPtr(false),
+			wantErr:              true,
+		},
+		{
+			name:    "case 3: storage class without volume expansion setting",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := kclient.NewMockClientInterface(ctrl)
+			client.EXPECT().ListPVCNames(gomock.Any()).Return([]string{"pvc-0"}, nil)
+			client.EXPECT().GetPVCFromName("pvc-0").Return(getPVC(), nil)
+			client.EXPECT().GetStorageClass(storageClass).Return(&storagev1.StorageClass{
+				ObjectMeta:           metav1.ObjectMeta{Name: storageClass},
+				AllowVolumeExpansion: tt.allowVolumeExpansion,
+			}, nil)
+			if !tt.wantErr {
+				client.EXPECT().UpdatePVC(gomock.Any()).DoAndReturn(func(pvc *corev1.PersistentVolumeClaim) error {
+					size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+					if size.String() != "10Gi" {
+						t.Errorf("expected PVC to be resized to 10Gi, got %v", size.String())
+					}
+					return nil
+				})
+			}
+
+			k := kubernetesClient{
+				generic: generic{appName: "app", componentName: "nodejs"},
+				client:  client,
+			}
+			err := k.Resize(NewStorageWithContainer("storage-0", "10Gi", "/data", "runtime", util.GetBoolPtr(false)))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Resize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_kubernetesClient_CreateDependencyCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []corev1.PersistentVolumeClaim
+		// rwxErr is the error returned when creating the PVC with the ReadWriteMany access mode
+		rwxErr      error
+		wantCreated bool
+		wantModes   []corev1.PersistentVolumeAccessMode
+	}{
+		{
+			name:        "case 1: dependency cache not existing",
+			wantCreated: true,
+			wantModes:   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+		},
+		{
+			name: "case 2: dependency cache already existing",
+			existing: []corev1.PersistentVolumeClaim{
+				*testingutil.FakePVC("odo-dependency-cache-java", "5Gi", map[string]string{storageLabels.DependencyCacheLabel: "java"}),
+			},
+		},
+		{
+			name:        "case 3: ReadWriteMany access mode not supported",
+			rwxErr:      errors.New("access mode not supported"),
+			wantCreated: true,
+			wantModes:   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany, corev1.ReadWriteOnce},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := kclient.NewMockClientInterface(ctrl)
+			client.EXPECT().ListPVCs("odo.dev/dependency-cache=java").Return(tt.existing, nil)
+			if tt.wantCreated {
+				client.EXPECT().GetCurrentNamespace().Return("project")
+				var gotModes []corev1.PersistentVolumeAccessMode
+				defer func() {
+					if !reflect.DeepEqual(gotModes, tt.wantModes) {
+						t.Errorf("expected PVC created with access modes %v, got %v", tt.wantModes, gotModes)
+					}
+				}()
+				client.EXPECT().CreatePVC(gomock.Any()).Times(len(tt.wantModes)).DoAndReturn(func(pvc corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+					gotModes = append(gotModes, pvc.Spec.AccessModes...)
+					if pvc.Spec.AccessModes[0] == corev1.ReadWriteMany && tt.rwxErr != nil {
+						return nil, tt.rwxErr
+					}
+					if pvc.Name != "odo-dependency-cache-java" {
+						t.Errorf("expected PVC odo-dependency-cache-java, got %v", pvc.Name)
+					}
+					if _, ok := pvc.Labels["component"]; ok {
+						t.Errorf("the dependency cache PVC should not be labelled with a component")
+					}
+					if pvc.Labels[storageLabels.DependencyCacheLabel] != "java" {
+						t.Errorf("expected PVC to be labelled with the language, got labels %v", pvc.Labels)
+					}
+					if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != "nfs-client" {
+						t.Errorf("expected PVC with StorageClass nfs-client, got %v", pvc.Spec.StorageClassName)
+					}
+					return &pvc, nil
+				})
+			}
+
+			k := kubernetesClient{
+				generic: generic{appName: "app", componentName: "nodejs", storageClass: "nfs-client"},
+				client:  client,
+			}
+			created, err := k.CreateDependencyCache("java")
+			if err != nil {
+				t.Errorf("CreateDependencyCache() unexpected error %v", err)
+			}
+			if created != tt.wantCreated {
+				t.Errorf("CreateDependencyCache() = %v, want %v", created, tt.wantCreated)
+			}
 		})
 	}
 }
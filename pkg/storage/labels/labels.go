@@ -15,6 +15,13 @@ const DevfileStorageLabel = "storage-name"
 // SourceStorageLabel
 const SourcePVCLabel = "odo-source-pvc"
 
+// SeededLabel is the label key that is applied to the storage resources seeded with the content of a local directory
+const SeededLabel = "odo.dev/seeded"
+
+// DependencyCacheLabel is the label key that is applied to the dependency cache storage resources,
+// its value is the language of the cache
+const DependencyCacheLabel = "odo.dev/dependency-cache"
+
 // GetLabels gets the labels to be applied to the given storage besides the
 // component labels and application labels.
 func GetLabels(storageName string, componentName string, applicationName string, additional bool) map[string]string {
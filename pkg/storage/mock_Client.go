@@ -47,6 +47,21 @@ func (mr *MockClientMockRecorder) Create(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockClient)(nil).Create), arg0)
 }
 
+// CreateDependencyCache mocks base method.
+func (m *MockClient) CreateDependencyCache(language string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDependencyCache", language)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDependencyCache indicates an expected call of CreateDependencyCache.
+func (mr *MockClientMockRecorder) CreateDependencyCache(language interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDependencyCache", reflect.TypeOf((*MockClient)(nil).CreateDependencyCache), language)
+}
+
 // Delete mocks base method.
 func (m *MockClient) Delete(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -90,3 +105,17 @@ func (mr *MockClientMockRecorder) ListFromCluster() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFromCluster", reflect.TypeOf((*MockClient)(nil).ListFromCluster))
 }
+
+// Resize mocks base method.
+func (m *MockClient) Resize(arg0 Storage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockClientMockRecorder) Resize(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockClient)(nil).Resize), arg0)
+}
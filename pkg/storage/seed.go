@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"fmt"
+	"path/filepath"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/generator"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+
+	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	storagelabels "github.com/redhat-developer/odo/pkg/storage/labels"
+	"github.com/redhat-developer/odo/pkg/sync"
+)
+
+// SeedAttribute is the attribute of a volume component defining a local directory, relative to the devfile,
+// whose content is copied into the volume the first time the volume is created
+const SeedAttribute = "dev.odo.seed"
+
+// SeedVolumes copies the content of the seed directories into the persistent volumes not seeded yet.
+// The PVCs of the seeded volumes are labelled with SeededLabel, so they are not seeded again
+func SeedVolumes(client kclient.ClientInterface, syncClient sync.SyncClient, devfileObj parser.DevfileObj, componentName string, podName string) error {
+	components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, component := range components {
+		if component.Volume == nil || component.Attributes == nil || !component.Attributes.Exists(SeedAttribute) {
+			continue
+		}
+		seedPath := component.Attributes.GetString(SeedAttribute, &err)
+		if err != nil {
+			return fmt.Errorf("invalid %q attribute for volume %q: %w", SeedAttribute, component.Name, err)
+		}
+		if component.Volume.Ephemeral != nil && *component.Volume.Ephemeral {
+			log.Warningf("The ephemeral volume %q cannot be seeded", component.Name)
+			continue
+		}
+
+		pvcs, err := client.ListPVCs(fmt.Sprintf("component=%s,%s=%s", componentName, storagelabels.DevfileStorageLabel, component.Name))
+		if err != nil {
+			return err
+		}
+		if len(pvcs) != 1 {
+			// the volume is not mounted by any container
+			continue
+		}
+		pvc := pvcs[0]
+		if pvc.Labels[storagelabels.SeededLabel] == "true" {
+			continue
+		}
+
+		containerName, mountPath, found := getVolumeMount(components, component.Name)
+		if !found {
+			continue
+		}
+
+		if !filepath.IsAbs(seedPath) {
+			seedPath = filepath.Join(filepath.Dir(devfileObj.Ctx.GetAbsPath()), seedPath)
+		}
+		compInfo := common.ComponentInfo{
+			ContainerName: containerName,
+			PodName:       podName,
+		}
+		err = sync.CopyDirContent(syncClient, seedPath, compInfo, mountPath)
+		if err != nil {
+			return fmt.Errorf("unable to seed volume %q: %w", component.Name, err)
+		}
+
+		labels := pvc.GetLabels()
+		labels[storagelabels.SeededLabel] = "true"
+		err = client.UpdatePVCLabels(&pvc, labels)
+		if err != nil {
+			return err
+		}
+		log.Successf("Seeded volume %q from %q", component.Name, seedPath)
+	}
+	return nil
+}
+
+// getVolumeMount returns the first container mounting the volume, and the path where it is mounted
+func getVolumeMount(components []devfilev1.Component, volumeName string) (containerName string, path string, found bool) {
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		for _, volumeMount := range component.Container.VolumeMounts {
+			if volumeMount.Name == volumeName {
+				return component.Name, generator.GetVolumeMountPath(volumeMount), true
+			}
+		}
+	}
+	return "", "", false
+}
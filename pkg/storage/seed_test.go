@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/pkg/devfile/parser"
+	devfileCtx "github.com/devfile/library/pkg/devfile/parser/context"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	storagelabels "github.com/redhat-developer/odo/pkg/storage/labels"
+	"github.com/redhat-developer/odo/pkg/sync/mock"
+)
+
+func TestSeedVolumes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "seed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = os.MkdirAll(filepath.Join(dir, "fixtures"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "fixtures", "dump.sql"), []byte("CREATE TABLE t;"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		seeded     bool
+		wantSeeded bool
+	}{
+		{
+			name:       "volume not seeded yet",
+			wantSeeded: true,
+		},
+		{
+			name:   "volume already seeded",
+			seeded: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = devfileData.AddComponents([]devfilev1.Component{
+				{
+					Name: "runtime",
+					ComponentUnion: devfilev1.ComponentUnion{
+						Container: &devfilev1.ContainerComponent{
+							Container: devfilev1.Container{
+								Image:        "quay.io/nodejs-12",
+								VolumeMounts: []devfilev1.VolumeMount{{Name: "db-data", Path: "/data"}},
+							},
+						},
+					},
+				},
+				{
+					Name:       "db-data",
+					Attributes: attributes.Attributes{}.PutString(SeedAttribute, "fixtures"),
+					ComponentUnion: devfilev1.ComponentUnion{
+						Volume: &devfilev1.VolumeComponent{},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			devfileObj := parser.DevfileObj{
+				Data: devfileData,
+				Ctx:  devfileCtx.FakeContext(devfilefs.NewFakeFs(), filepath.Join(dir, "devfile.yaml")),
+			}
+
+			ctrl := gomock.NewController(t)
+			client := kclient.NewMockClientInterface(ctrl)
+			syncClient := mock.NewMockSyncClient(ctrl)
+
+			labels := map[string]string{storagelabels.DevfileStorageLabel: "db-data"}
+			if tt.seeded {
+				labels[storagelabels.SeededLabel] = "true"
+			}
+			pvc := corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "db-data-pvc", Labels: labels}}
+			client.EXPECT().ListPVCs("component=my-component,storage-name=db-data").Return([]corev1.PersistentVolumeClaim{pvc}, nil)
+
+			if tt.wantSeeded {
+				compInfo := common.ComponentInfo{ContainerName: "runtime", PodName: "pod"}
+				syncClient.EXPECT().ExtractProjectToComponent(compInfo, "/data", gomock.Any()).
+					DoAndReturn(func(_ common.ComponentInfo, _ string, stdin io.Reader) error {
+						_, err := ioutil.ReadAll(stdin)
+						return err
+					})
+				client.EXPECT().UpdatePVCLabels(gomock.Any(), map[string]string{
+					storagelabels.DevfileStorageLabel: "db-data",
+					storagelabels.SeededLabel:         "true",
+				}).Return(nil)
+			}
+
+			err = SeedVolumes(client, syncClient, devfileObj, "my-component", "pod")
+			if err != nil {
+				t.Errorf("SeedVolumes() error = %v", err)
+			}
+		})
+	}
+}
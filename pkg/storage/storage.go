@@ -3,6 +3,8 @@ package storage
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	applabels "github.com/redhat-developer/odo/pkg/application/labels"
 	"github.com/redhat-developer/odo/pkg/component/labels"
 	"github.com/redhat-developer/odo/pkg/kclient"
@@ -27,16 +29,27 @@ type generic struct {
 	appName             string
 	componentName       string
 	localConfigProvider localConfigProvider.LocalConfigProvider
+
+	// storageClass and accessMode are used for the PVCs of the storages not defining them
+	storageClass string
+	accessMode   string
 }
 
 type ClientOptions struct {
 	Client              kclient.ClientInterface
 	LocalConfigProvider localConfigProvider.LocalConfigProvider
 	Deployment          *v1.Deployment
+
+	// StorageClass is the StorageClass of the PVCs of the storages not defining one, the default StorageClass of the cluster is used when empty
+	StorageClass string
+	// AccessMode is the access mode of the PVCs of the storages not defining one
+	AccessMode string
 }
 
 type Client interface {
 	Create(Storage) error
+	Resize(Storage) error
+	CreateDependencyCache(language string) (bool, error)
 	Delete(string) error
 	ListFromCluster() (StorageList, error)
 	List() (StorageList, error)
@@ -54,6 +67,9 @@ func NewClient(options ClientOptions) Client {
 		}
 	}
 
+	genericInfo.storageClass = options.StorageClass
+	genericInfo.accessMode = options.AccessMode
+
 	if options.Deployment != nil {
 		genericInfo.appName = options.Deployment.Labels[applabels.ApplicationLabel]
 		genericInfo.componentName = options.Deployment.Labels[labels.KubernetesInstanceLabel]
@@ -67,8 +83,9 @@ func NewClient(options ClientOptions) Client {
 }
 
 // Push creates and deletes the required persistent storages and returns the list of ephemeral storages
-// it compares the local storage against the storage on the cluster
-func Push(client Client, configProvider localConfigProvider.LocalConfigProvider) (ephemerals map[string]Storage, _ error) {
+// it compares the local storage against the storage on the cluster.
+// If dependencyCache is not empty, the dependency cache PVC of this language is created if it does not exist yet
+func Push(client Client, configProvider localConfigProvider.LocalConfigProvider, dependencyCache string) (ephemerals map[string]Storage, _ error) {
 	// list all the storage in the cluster
 	storageClusterList := StorageList{}
 
@@ -109,10 +126,21 @@ func Push(client Client, configProvider localConfigProvider.LocalConfigProvider)
 			}
 			log.Successf("Deleted storage %v from %v", storage.Name, configProvider.GetName())
 			continue
-		} else if storage.Name == val.Name {
-			if val.Spec.Size != storage.Spec.Size {
-				return nil, fmt.Errorf("config mismatch for storage with the same name %s", storage.Name)
+		} else if storage.Name == val.Name && val.Spec.Size != storage.Spec.Size {
+			cmp, err := compareSizes(val.Spec.Size, storage.Spec.Size)
+			if err != nil {
+				return nil, err
+			}
+			if cmp == 0 {
+				continue
+			}
+			if cmp < 0 {
+				return nil, fmt.Errorf("config mismatch for storage with the same name %s, the size of a storage cannot be reduced from %s to %s", storage.Name, storage.Spec.Size, val.Spec.Size)
 			}
+			if e := client.Resize(val); e != nil {
+				return nil, e
+			}
+			log.Successf("Resized storage %v of %v from %v to %v", storage.Name, configProvider.GetName(), storage.Spec.Size, val.Spec.Size)
 		}
 	}
 
@@ -128,5 +156,28 @@ func Push(client Client, configProvider localConfigProvider.LocalConfigProvider)
 		log.Successf("Added storage %v to %v", storage.Name, configProvider.GetName())
 	}
 
+	if dependencyCache != "" {
+		created, err := client.CreateDependencyCache(dependencyCache)
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			log.Successf("Added %v dependency cache %v", dependencyCache, GetDependencyCachePVCName(dependencyCache))
+		}
+	}
+
 	return ephemeralConfigNames, nil
 }
+
+// compareSizes compares the two sizes, it returns 0 if they are equal, 1 if size1 is larger and -1 if size2 is larger
+func compareSizes(size1 string, size2 string) (int, error) {
+	q1, err := resource.ParseQuantity(size1)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse size: %v: %w", size1, err)
+	}
+	q2, err := resource.ParseQuantity(size2)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse size: %v: %w", size2, err)
+	}
+	return q1.Cmp(q2), nil
+}
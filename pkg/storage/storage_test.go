@@ -47,6 +47,8 @@ func TestPush(t *testing.T) {
 		returnedFromLocal   []localConfigProvider.LocalStorage
 		returnedFromCluster StorageList
 		createdItems        []localConfigProvider.LocalStorage
+		resizedItems        []localConfigProvider.LocalStorage
+		dependencyCache     string
 		deletedItems        []string
 		wantErr             bool
 		wantEphemeralNames  []string
@@ -230,6 +232,58 @@ func TestPush(t *testing.T) {
 			},
 			wantEphemeralNames: []string{"ephemeral-storage-0"},
 		},
+		{
+			name: "case 12: size increase",
+			returnedFromLocal: []localConfigProvider.LocalStorage{
+				{
+					Name:      "storage-1",
+					Size:      "10Gi",
+					Path:      "/path",
+					Container: "runtime-1",
+					Ephemeral: util.GetBoolPtr(false),
+				},
+			},
+			returnedFromCluster: StorageList{
+				Items: []Storage{
+					clusterStorage1,
+				},
+			},
+			resizedItems: []localConfigProvider.LocalStorage{
+				{
+					Name:      "storage-1",
+					Size:      "10Gi",
+					Path:      "/path",
+					Container: "runtime-1",
+					Ephemeral: util.GetBoolPtr(false),
+				},
+			},
+			wantEphemeralNames: []string{},
+		},
+		{
+			name:                "case 13: dependency cache",
+			returnedFromLocal:   []localConfigProvider.LocalStorage{localStorage0},
+			returnedFromCluster: StorageList{Items: []Storage{clusterStorage0}},
+			dependencyCache:     "java",
+			wantEphemeralNames:  []string{},
+		},
+		{
+			name: "case 14: same size with a different unit",
+			returnedFromLocal: []localConfigProvider.LocalStorage{
+				{
+					Name:      "storage-1",
+					Size:      "5120Mi",
+					Path:      "/path",
+					Container: "runtime-1",
+					Ephemeral: util.GetBoolPtr(false),
+				},
+			},
+			returnedFromCluster: StorageList{
+				Items: []Storage{
+					clusterStorage1,
+				},
+			},
+			wantEphemeralNames: []string{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -250,11 +304,20 @@ func TestPush(t *testing.T) {
 				fakeStorageClient.EXPECT().Create(convert.Items[i]).Return(nil).Times(1)
 			}
 
+			resized := ConvertListLocalToMachine(tt.resizedItems)
+			for i := range resized.Items {
+				fakeStorageClient.EXPECT().Resize(resized.Items[i]).Return(nil).Times(1)
+			}
+
 			for i := range tt.deletedItems {
 				fakeStorageClient.EXPECT().Delete(tt.deletedItems[i]).Return(nil).Times(1)
 			}
 
-			ephemerals, err := Push(fakeStorageClient, fakeLocalConfig)
+			if tt.dependencyCache != "" {
+				fakeStorageClient.EXPECT().CreateDependencyCache(tt.dependencyCache).Return(true, nil).Times(1)
+			}
+
+			ephemerals, err := Push(fakeStorageClient, fakeLocalConfig, tt.dependencyCache)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Push() error = %v, wantErr %v", err, tt.wantErr)
 			}
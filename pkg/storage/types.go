@@ -36,6 +36,11 @@ type StorageSpec struct {
 	Ephemeral *bool `json:"ephemeral,omitempty"`
 
 	ContainerName string `json:"containerName,omitempty"`
+
+	// StorageClass of the PVC, the default StorageClass is used when empty
+	StorageClass string `json:"storageClass,omitempty"`
+	// AccessModes of the PVC, the default access mode is used when empty
+	AccessModes []string `json:"accessModes,omitempty"`
 }
 
 // StorageList is a list of storages
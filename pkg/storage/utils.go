@@ -50,6 +50,8 @@ func ConvertListLocalToMachine(storageListConfig []localConfigProvider.LocalStor
 	for _, storeLocal := range storageListConfig {
 		s := NewStorage(storeLocal.Name, storeLocal.Size, storeLocal.Path, storeLocal.Ephemeral)
 		s.Spec.ContainerName = storeLocal.Container
+		s.Spec.StorageClass = storeLocal.StorageClass
+		s.Spec.AccessModes = storeLocal.AccessModes
 		storageListLocal = append(storageListLocal, s)
 	}
 
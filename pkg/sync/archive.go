@@ -0,0 +1,86 @@
+package sync
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// kubernetesSyncClient is a SyncClient executing the commands in the containers with a Kubernetes client
+type kubernetesSyncClient struct {
+	client kclient.ClientInterface
+}
+
+var _ SyncClient = kubernetesSyncClient{}
+
+// NewKubernetesSyncClient returns a SyncClient executing the commands in the containers with the Kubernetes client
+func NewKubernetesSyncClient(client kclient.ClientInterface) SyncClient {
+	return kubernetesSyncClient{client: client}
+}
+
+func (o kubernetesSyncClient) ExecCMDInContainer(compInfo common.ComponentInfo, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+	return o.client.ExecCMDInContainer(compInfo.ContainerName, compInfo.PodName, cmd, stdout, stderr, stdin, tty)
+}
+
+func (o kubernetesSyncClient) ExtractProjectToComponent(compInfo common.ComponentInfo, targetPath string, stdin io.Reader) error {
+	return o.client.ExtractProjectToComponent(compInfo.ContainerName, compInfo.PodName, targetPath, stdin)
+}
+
+// CopyDirContent copies the content of the localPath directory to the targetPath directory of the container
+func CopyDirContent(client SyncClient, localPath string, compInfo common.ComponentInfo, targetPath string) error {
+	localPath, err := filepath.Abs(localPath)
+	if err != nil {
+		return err
+	}
+	var files []string
+	err = filepath.Walk(localPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != localPath {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to read %q: %w", localPath, err)
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	// the files are extracted into targetPath with their path relative to localPath
+	return CopyFile(client, localPath, compInfo, targetPath, files, nil, util.IndexerRet{})
+}
+
+// ArchiveFromContainer writes to writer a gzip compressed tar archive of the content of the sourcePath directory of the container
+func ArchiveFromContainer(client SyncClient, compInfo common.ComponentInfo, sourcePath string, writer io.Writer) error {
+	cmd := []string{"tar", "cf", "-", "-C", sourcePath, "."}
+	klog.V(3).Infof("Executing command %v", cmd)
+
+	gzipWriter := gzip.NewWriter(writer)
+	var stderr bytes.Buffer
+	err := client.ExecCMDInContainer(compInfo, cmd, gzipWriter, &stderr, nil, false)
+	if err != nil {
+		return fmt.Errorf("unable to archive %q in container %q: %w: %s", sourcePath, compInfo.ContainerName, err, stderr.String())
+	}
+	return gzipWriter.Close()
+}
+
+// ExtractArchiveToContainer extracts the gzip compressed tar archive read from reader to the targetPath directory of the container
+func ExtractArchiveToContainer(client SyncClient, compInfo common.ComponentInfo, targetPath string, reader io.Reader) error {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return fmt.Errorf("invalid archive: %w", err)
+	}
+	defer gzipReader.Close()
+	return client.ExtractProjectToComponent(compInfo, targetPath, gzipReader)
+}
@@ -0,0 +1,155 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/sync/mock"
+)
+
+// readTar returns the content of the files of a tar archive, indexed by name
+func readTar(t *testing.T, reader io.Reader) map[string]string {
+	result := map[string]string{}
+	tr := taro.NewReader(reader)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result[hdr.Name] = string(content)
+	}
+	return result
+}
+
+func writeTar(t *testing.T, writer io.Writer, files map[string]string) {
+	tw := taro.NewWriter(writer)
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := tw.WriteHeader(&taro.Header{Name: name, Mode: 0600, Size: int64(len(files[name]))})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = tw.Write([]byte(files[name]))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArchiveFromContainer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	syncClient := mock.NewMockSyncClient(ctrl)
+	compInfo := common.ComponentInfo{ContainerName: "runtime", PodName: "pod"}
+	files := map[string]string{"./dump.sql": "CREATE TABLE t;"}
+
+	syncClient.EXPECT().ExecCMDInContainer(compInfo, []string{"tar", "cf", "-", "-C", "/data", "."}, gomock.Any(), gomock.Any(), nil, false).
+		DoAndReturn(func(_ common.ComponentInfo, _ []string, stdout io.Writer, _ io.Writer, _ io.Reader, _ bool) error {
+			writeTar(t, stdout, files)
+			return nil
+		})
+
+	var archive bytes.Buffer
+	err := ArchiveFromContainer(syncClient, compInfo, "/data", &archive)
+	if err != nil {
+		t.Fatalf("ArchiveFromContainer() error = %v", err)
+	}
+	gzipReader, err := gzip.NewReader(&archive)
+	if err != nil {
+		t.Fatalf("the archive is not gzip compressed: %v", err)
+	}
+	got := readTar(t, gzipReader)
+	if got["./dump.sql"] != files["./dump.sql"] {
+		t.Errorf("ArchiveFromContainer() content = %v, want %v", got, files)
+	}
+}
+
+func TestExtractArchiveToContainer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	syncClient := mock.NewMockSyncClient(ctrl)
+	compInfo := common.ComponentInfo{ContainerName: "runtime", PodName: "pod"}
+	files := map[string]string{"./dump.sql": "CREATE TABLE t;"}
+
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	writeTar(t, gzipWriter, files)
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]string
+	syncClient.EXPECT().ExtractProjectToComponent(compInfo, "/data", gomock.Any()).
+		DoAndReturn(func(_ common.ComponentInfo, _ string, stdin io.Reader) error {
+			got = readTar(t, stdin)
+			return nil
+		})
+
+	err := ExtractArchiveToContainer(syncClient, compInfo, "/data", &archive)
+	if err != nil {
+		t.Fatalf("ExtractArchiveToContainer() error = %v", err)
+	}
+	if got["./dump.sql"] != files["./dump.sql"] {
+		t.Errorf("ExtractArchiveToContainer() extracted = %v, want %v", got, files)
+	}
+
+	err = ExtractArchiveToContainer(syncClient, compInfo, "/data", bytes.NewBufferString("not an archive"))
+	if err == nil {
+		t.Errorf("ExtractArchiveToContainer() should fail for an invalid archive")
+	}
+}
+
+func TestCopyDirContent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "seed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = os.MkdirAll(filepath.Join(dir, "fixtures"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "fixtures", "users.json"), []byte("[]"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctrl := gomock.NewController(t)
+	syncClient := mock.NewMockSyncClient(ctrl)
+	compInfo := common.ComponentInfo{ContainerName: "runtime", PodName: "pod"}
+
+	var got map[string]string
+	syncClient.EXPECT().ExtractProjectToComponent(compInfo, "/data", gomock.Any()).
+		DoAndReturn(func(_ common.ComponentInfo, _ string, stdin io.Reader) error {
+			got = readTar(t, stdin)
+			return nil
+		})
+
+	err = CopyDirContent(syncClient, dir, compInfo, "/data")
+	if err != nil {
+		t.Fatalf("CopyDirContent() error = %v", err)
+	}
+	if content, ok := got["fixtures/users.json"]; !ok || content != "[]" {
+		t.Errorf("CopyDirContent() copied = %v", got)
+	}
+}
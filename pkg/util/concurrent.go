@@ -9,15 +9,30 @@ type ConcurrentTask struct {
 	ToRun func(errChannel chan error)
 }
 
-// run encapsulates the work to be done by calling the ToRun function
-func (ct ConcurrentTask) run(errChannel chan error, wg *sync.WaitGroup) {
+// run encapsulates the work to be done by calling the ToRun function,
+// waiting for a slot in the semaphore before if the semaphore is not nil.
+// The task is not run if cancelled is closed before it starts
+func (ct ConcurrentTask) run(errChannel chan error, wg *sync.WaitGroup, semaphore chan struct{}, cancelled chan struct{}) {
 	defer wg.Done()
+	if semaphore != nil {
+		semaphore <- struct{}{}
+		defer func() { <-semaphore }()
+	}
+	select {
+	case <-cancelled:
+		return
+	default:
+	}
 	ct.ToRun(errChannel)
 }
 
 // ConcurrentTasks records tasks to be run concurrently with go-routines
 type ConcurrentTasks struct {
 	tasks []ConcurrentTask
+	// limit is the maximum number of tasks running at the same time, no limit if 0
+	limit int
+	// cancelled is closed by Run on the first error sent by the tasks
+	cancelled chan struct{}
 }
 
 // NewConcurrentTasks creates a new ConcurrentTasks instance, dimensioned to accept at least the specified number of tasks
@@ -25,52 +40,50 @@ func NewConcurrentTasks(taskNumber int) *ConcurrentTasks {
 	return &ConcurrentTasks{tasks: make([]ConcurrentTask, 0, taskNumber)}
 }
 
+// NewConcurrentTasksWithLimit creates a new ConcurrentTasks instance running at most limit tasks at the same time,
+// dimensioned to accept at least the specified number of tasks. The number of tasks is not limited if limit is 0
+func NewConcurrentTasksWithLimit(taskNumber int, limit int) *ConcurrentTasks {
+	return &ConcurrentTasks{tasks: make([]ConcurrentTask, 0, taskNumber), limit: limit}
+}
+
 // Add adds the specified ConcurrentTask to the list of tasks to be run concurrently
 func (ct *ConcurrentTasks) Add(task ConcurrentTask) {
-	if len(ct.tasks) == 0 {
-		ct.tasks = make([]ConcurrentTask, 0, 7)
-	}
 	ct.tasks = append(ct.tasks, task)
 }
 
-// Run concurrently runs the added tasks failing on the first error
-// Based on https://garrypolley.com/2016/02/10/golang-routines-errors/
+// Run concurrently runs the added tasks, and returns the first error sent by the tasks.
+// After the first error, the tasks not started yet are not run, and Run returns once the running tasks are done,
+// so that no task is left running
 func (ct *ConcurrentTasks) Run() error {
 	var wg sync.WaitGroup
-	finished := make(chan bool, 1) // this along with wg.Wait() is why the error handling works and doesn't deadlock
-	errChannel := make(chan error)
+	// the channel is buffered so that the tasks never block when sending their errors
+	errChannel := make(chan error, len(ct.tasks))
+	ct.cancelled = make(chan struct{})
 
+	var semaphore chan struct{}
+	if ct.limit > 0 {
+		semaphore = make(chan struct{}, ct.limit)
+	}
 	for _, task := range ct.tasks {
 		wg.Add(1)
-		go task.run(errChannel, &wg)
+		go task.run(errChannel, &wg, semaphore, ct.cancelled)
 	}
 
-	// Put the wait group in a go routine.
-	// By putting the wait group in the go routine we ensure either all pass
-	// and we close the "finished" channel or we wait forever for the wait group
-	// to finish.
-	//
-	// Waiting forever is okay because of the blocking select below.
+	// the errors are read while the tasks are running, to cancel the tasks not started yet on the first error
+	var firstErr error
+	collected := make(chan struct{})
 	go func() {
-		wg.Wait()
-		close(finished)
-	}()
-
-	// This select will block until one of the two channels returns a value.
-	// This means on the first failure in the go routines above the errChannel will release a
-	// value first. Because there is a "return" statement in the err check this function will
-	// exit when an error occurs.
-	//
-	// Due to the blocking on wg.Wait() the finished channel will not get a value unless all
-	// the go routines before were successful because not all the wg.Done() calls would have
-	// happened.
-	select {
-	case <-finished:
-	case err := <-errChannel:
-		if err != nil {
-			return err
+		defer close(collected)
+		for err := range errChannel {
+			if err != nil && firstErr == nil {
+				firstErr = err
+				close(ct.cancelled)
+			}
 		}
-	}
+	}()
 
-	return nil
+	wg.Wait()
+	close(errChannel)
+	<-collected
+	return firstErr
 }
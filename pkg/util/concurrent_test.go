@@ -0,0 +1,89 @@
+package util
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConcurrentTasksWithLimit(t *testing.T) {
+	var mu sync.Mutex
+	running, maxRunning, done := 0, 0, 0
+	tasks := NewConcurrentTasksWithLimit(6, 2)
+	for i := 0; i < 6; i++ {
+		tasks.Add(ConcurrentTask{ToRun: func(errChannel chan error) {
+			mu.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			running--
+			done++
+			mu.Unlock()
+		}})
+	}
+	if err := tasks.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if done != 6 {
+		t.Errorf("%d tasks run, want 6", done)
+	}
+	if maxRunning > 2 {
+		t.Errorf("%d tasks run at the same time, want at most 2", maxRunning)
+	}
+}
+
+func TestConcurrentTasksError(t *testing.T) {
+	tasks := NewConcurrentTasksWithLimit(2, 1)
+	tasks.Add(ConcurrentTask{ToRun: func(errChannel chan error) {
+		errChannel <- errors.New("task failed")
+	}})
+	if err := tasks.Run(); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestConcurrentTasksSeveralErrors(t *testing.T) {
+	var mu sync.Mutex
+	started := 0
+	// startedTasks receives a value when a task starts, the running tasks wait for release to be closed before ending
+	startedTasks := make(chan struct{}, 6)
+	release := make(chan struct{})
+	tasks := NewConcurrentTasksWithLimit(6, 2)
+	for i := 0; i < 6; i++ {
+		tasks.Add(ConcurrentTask{ToRun: func(errChannel chan error) {
+			mu.Lock()
+			started++
+			mu.Unlock()
+			startedTasks <- struct{}{}
+			errChannel <- errors.New("task failed")
+			<-release
+		}})
+	}
+	result := make(chan error)
+	go func() {
+		result <- tasks.Run()
+	}()
+	// the running tasks end only once the first error has cancelled the tasks not started yet
+	<-startedTasks
+	<-tasks.cancelled
+	close(release)
+	select {
+	case err := <-result:
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	// at most the 2 tasks running when the first error is sent have been started
+	if started > 2 {
+		t.Errorf("%d tasks started, the tasks not started before the first error should not be run", started)
+	}
+}
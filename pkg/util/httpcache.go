@@ -1,9 +1,12 @@
 package util
 
 import (
+	"os"
 	"path/filepath"
 
 	"github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/gregjones/httpcache/diskcache"
+	"github.com/peterbourgon/diskv"
 	"k8s.io/klog"
 )
 
@@ -27,3 +30,34 @@ func cleanDefaultHTTPCacheDir(fs filesystem.Filesystem) error {
 	}
 	return nil
 }
+
+// GetHTTPCacheFile returns the path of the file caching the response of an HTTP GET request to URL.
+// The key used by the cache is the URL of the request, and the name of the file is computed by the disk cache
+// used by HTTPGetRequest: it is captured from the transform function of the storage of the cache, on a read of the key
+func GetHTTPCacheFile(URL string) string {
+	var file string
+	storage := diskv.New(diskv.Options{
+		BasePath: httpCacheDir,
+		Transform: func(key string) []string {
+			file = key
+			return []string{}
+		},
+	})
+	_, _ = diskcache.NewWithDiskv(storage).Get(URL)
+	return filepath.Join(httpCacheDir, file)
+}
+
+// CleanHTTPCacheFile removes the cached response of an HTTP GET request to URL, if any
+func CleanHTTPCacheFile(URL string) error {
+	return cleanHTTPCacheFile(filesystem.DefaultFs{}, URL)
+}
+
+func cleanHTTPCacheFile(fs filesystem.Filesystem, URL string) error {
+	cacheFile := GetHTTPCacheFile(URL)
+	klog.V(4).Infof("Removing cache file %s", cacheFile)
+	err := fs.Remove(cacheFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/devfile/library/pkg/testingutil/filesystem"
 	dfutil "github.com/devfile/library/pkg/util"
+	"github.com/gregjones/httpcache/diskcache"
 )
 
 func TestCleanDefaultHTTPCacheDir(t *testing.T) {
@@ -41,3 +42,43 @@ func TestCleanDefaultHTTPCacheDir(t *testing.T) {
 	}
 
 }
+
+func TestCleanHTTPCacheFile(t *testing.T) {
+	fakeFs := filesystem.NewFakeFs()
+	urls := []string{"https://registry.example.com/devfiles/index.json", "https://other.example.com/devfiles/index.json"}
+	for _, url := range urls {
+		err := fakeFs.WriteFile(GetHTTPCacheFile(url), []byte(dfutil.GenerateRandomString(10)), os.ModePerm)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	err := cleanHTTPCacheFile(fakeFs, urls[0])
+	if err != nil {
+		t.Error(err)
+	}
+	if _, err = fakeFs.Stat(GetHTTPCacheFile(urls[0])); !os.IsNotExist(err) {
+		t.Error("cache file of the cleaned URL still exists")
+	}
+	if _, err = fakeFs.Stat(GetHTTPCacheFile(urls[1])); err != nil {
+		t.Error("cache file of another URL has been removed")
+	}
+
+	// the disk cache finds the response in the file
+	cacheDir := httpCacheDir
+	httpCacheDir = t.TempDir()
+	defer func() {
+		httpCacheDir = cacheDir
+	}()
+	diskcache.New(httpCacheDir).Set(urls[0], []byte("response"))
+	content, err := os.ReadFile(GetHTTPCacheFile(urls[0]))
+	if err != nil || string(content) != "response" {
+		t.Errorf("GetHTTPCacheFile() does not return the file of the cached response: %q, %v", content, err)
+	}
+
+	// cleaning a URL without cached response is not an error
+	err = cleanHTTPCacheFile(fakeFs, urls[0])
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -570,6 +570,11 @@ func copyFileWithFs(src, dst string, fs filesystem.Filesystem) error {
 	return fs.Chmod(dst, srcinfo.Mode())
 }
 
+// CopyDir copies a whole directory recursively using the provided filesystem
+func CopyDir(src string, dst string, fs filesystem.Filesystem) error {
+	return copyDirWithFS(src, dst, fs)
+}
+
 // copyDirWithFS copies a whole directory recursively
 func copyDirWithFS(src string, dst string, fs filesystem.Filesystem) error {
 	var err error
@@ -746,6 +751,21 @@ func GetGitOriginPath(path string) string {
 	return ""
 }
 
+// GetGitCommit gets the commit checked out in the git repo containing the given path
+// if the path is not in a git repo, the error is ignored
+func GetGitCommit(path string) string {
+	open, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return ""
+	}
+
+	head, err := open.Head()
+	if err != nil {
+		return ""
+	}
+	return head.Hash().String()
+}
+
 // BoolPtr returns pointer to passed boolean
 func GetBoolPtr(b bool) *bool {
 	return &b
@@ -74,3 +74,11 @@ mockgen -source=pkg/dev/interface.go \
 mockgen -source=pkg/alizer/interface.go \
     -package alizer \
     -destination pkg/alizer/mock.go
+
+mockgen -source=pkg/binding/interface.go \
+    -package binding \
+    -destination pkg/binding/mock.go
+
+mockgen -source=pkg/binding/asker/interface.go \
+    -package asker \
+    -destination pkg/binding/asker/mock.go
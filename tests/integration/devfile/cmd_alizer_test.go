@@ -35,6 +35,8 @@ var _ = Describe("odo alizer command tests", func() {
 			Expect(helper.IsJSON(stdout)).To(BeTrue())
 			Expect(helper.JsonPathContentIs(stdout, "devfile", "nodejs"))
 			Expect(helper.JsonPathContentIs(stdout, "devfile-registry", "DefaultDevfileRegistry"))
+			Expect(helper.JsonPathContentIs(stdout, "candidates.0.devfile", "nodejs"))
+			Expect(helper.JsonPathContentIs(stdout, "candidates.0.paths.0", "."))
 		})
 	})
 